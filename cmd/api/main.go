@@ -4,11 +4,176 @@ func main() {
     hub := ws.NewHub()
     go hub.Run()
 
+    // 역할 권한 (DB 저장소를 domain.HasPermission에 연결)
+    roleRepo := repository.NewRoleRepository(db)
+    grantRepo := repository.NewTemporaryGrantRepository(db)
+    permissionStore := service.NewPermissionStore(roleRepo, grantRepo, cache.NewInvalidator(), 5*time.Minute)
+    if err := permissionStore.Seed(context.Background()); err != nil {
+        log.Fatalf("역할 권한 초기화 실패: %v", err)
+    }
+    permissionStore.Listen(context.Background())
+    domain.SetPermissionSource(permissionStore)
+
+    // 접근 정책 (auth.Checker.Can)
+    policyEngine, err := config.LoadPolicyEngine()
+    if err != nil {
+        log.Fatalf("접근 정책 로드 실패: %v", err)
+    }
+    auth.SetPolicyEngine(policyEngine)
+
+    // 권한 거부 기록 (RBAC 미들웨어와 auth.Require*에서 남기고, 보관 기간이 지난 기록은 주기 작업으로 정리)
+    authzAuditLog := service.NewAuthzAuditLog(repository.NewAuthzDenialRepository(db), service.DefaultAuthzAuditConfig())
+    go authzAuditLog.Run(context.Background())
+    middleware.SetDenialRecorder(authzAuditLog)
+
+    // 이용 제재 (인증 미들웨어가 이용 정지 사용자를 거부하고, 제재가 시작되면 모든 인스턴스에서 실시간 연결을 끊는다)
+    sanctionRepo := repository.NewSanctionRepository(db)
+    sanctionStore := service.NewSanctionStore(sanctionRepo, cache.NewInvalidator(), 5*time.Minute)
+    sanctionStore.Listen(context.Background())
+    middleware.SetSanctionChecker(sanctionStore.Active)
+    hub.SetWriteGuard(service.ChatWriteGuard(sanctionStore, userRepo))
+    sanctionDisconnector := service.NewClusterDisconnector(cache.NewInvalidator(), hub)
+    sanctionDisconnector.Listen(context.Background())
+
     // 서비스 생성
-    notifService := service.NewNotificationService(hub, notifRepo)
+    blockService := service.NewBlockService(db, userRepo, blockRepo, feedRepo)
+    hub.SetDeliveryFilter(blockService)
+    notifService := service.NewNotificationService(hub, notifRepo, userRepo, blockRepo)
+    messageService := service.NewMessageService(hub, cache.NewCounterStore(), convRepo, userRepo, blockRepo, sanctionStore, service.DefaultMessageConfig())
+    service.RegisterMessageHandlers(hub, messageService)
+    temporaryGrantService := service.NewTemporaryGrantService(grantRepo, roleRepo, userRepo, boardRepo, permissionStore, notifService, service.DefaultTemporaryGrantConfig())
+
+    // 스팸 판정 (의심 글/댓글은 숨긴 채 보류 큐로 보내고, 모더레이션 큐의 판정으로 주기적으로 다시 학습)
+    spamConfig, err := config.LoadSpamConfig()
+    if err != nil {
+        log.Fatalf("스팸 판정 설정 오류: %v", err)
+    }
+    spamClassifier := service.NewBuiltinSpamClassifier(repository.NewSpamRepository(db), userRepo, spamConfig)
+    reportRepo := repository.NewReportRepository(db)
+    spamGate := service.NewSpamGate(spamClassifier, reportRepo)
+
+    // 금칙어 (관리자가 바꾸면 모든 인스턴스의 검사기를 다시 만든다)
+    wordFilterService := service.NewWordFilterService(repository.NewBannedWordRepository(db), reportRepo, cache.NewInvalidator(), 5*time.Minute)
+    wordFilterService.Listen(context.Background())
+
+    // 백그라운드 태스크 (팔로워 피드 반영, 개인 데이터 내보내기)
+    queue := worker.NewMemoryQueue(1000)
+    tasks := worker.NewDispatcher(queue)
+    emailService := service.NewEmailService(queue, "Go Board")
+    sanctionService := service.NewSanctionService(db, sanctionRepo, userRepo, sanctionStore, emailService, sanctionDisconnector, service.DefaultSanctionConfig())
+
+    feedService := service.NewFeedService(feedRepo, repository.NewFollowRepository(db), postRepo, userRepo, queue, service.DefaultFeedConfig())
+    tasks.Register(worker.TaskFanOutPost, handlers.NewFeedHandler(feedService).Handle)
+
+    // 개인 데이터 내보내기 (ZIP은 워커가 만들고, 만료된 파일은 주기 작업으로 정리)
+    dataExportService := service.NewDataExportService(repository.NewDataExportRepository(db), userRepo, config.LoadUploadStorage(), config.LoadDataExportStorage(), queue, emailService, config.LoadDataExportConfig())
+    tasks.Register(worker.TaskExportData, handlers.NewDataExportHandler(dataExportService).Handle)
+    go tasks.Run(context.Background())
+
+    postWriteService := service.NewPostWriteService(postRepo, userRepo, feedService, spamGate, wordFilterService, sanctionStore)
+    commentService := service.NewCommentService(commentRepo, postRepo, userRepo, notifService, blockService, spamGate, wordFilterService, sanctionStore)
+
+    // 신고와 모더레이션 큐
+    moderationConfig, err := config.LoadModerationConfig()
+    if err != nil {
+        log.Fatalf("모더레이션 설정 오류: %v", err)
+    }
+    moderationService := service.NewModerationService(reportRepo, postRepo, commentRepo, notifService, moderationConfig)
+
+    // 회원 탈퇴 (복구 기간이 지난 계정은 주기 작업으로 영구 삭제)
+    passwordHasher, err := config.LoadPasswordHasher()
+    if err != nil {
+        log.Fatalf("비밀번호 해셔 설정 오류: %v", err)
+    }
+    withdrawalConfig, err := config.LoadWithdrawalConfig()
+    if err != nil {
+        log.Fatalf("회원 탈퇴 설정 오류: %v", err)
+    }
+    withdrawalService := service.NewWithdrawalService(db, userRepo, repository.NewWithdrawalRepository(db), config.LoadUploadStorage(), passwordHasher, hub, withdrawalConfig)
+
+    // 휴면 전환 (오래 로그인하지 않은 계정에 안내 메일을 보내고 기한이 지나면 휴면 처리)
+    dormancyConfig, err := config.LoadDormancyConfig()
+    if err != nil {
+        log.Fatalf("휴면 계정 설정 오류: %v", err)
+    }
+    dormancyService := service.NewDormancyService(db, userRepo, repository.NewDormantAccountRepository(db), emailService, hub, dormancyConfig)
+    batchService := service.NewBatchService(userRepo, repository.NewBatchCheckpointRepository(db), dormancyService, dormancyConfig)
+
+    // 주기 작업
+    jobs := scheduler.New()
+    jobs.AddJob(&scheduler.Job{
+        Name:     "temporary-grant-expiry",
+        Schedule: time.Minute,
+        Handler: func(ctx context.Context) error {
+            _, err := temporaryGrantService.ExpireDue(ctx)
+            return err
+        },
+    })
+    jobs.AddJob(&scheduler.Job{
+        Name:     "sanction-apply",
+        Schedule: time.Minute,
+        Handler: func(ctx context.Context) error {
+            _, err := sanctionService.ApplyDue(ctx)
+            return err
+        },
+    })
+    jobs.AddJob(&scheduler.Job{
+        Name:     "authz-audit-cleanup",
+        Schedule: 24 * time.Hour,
+        Handler: func(ctx context.Context) error {
+            _, err := authzAuditLog.Cleanup(ctx)
+            return err
+        },
+    })
+    jobs.AddJob(&scheduler.Job{
+        Name:     "spam-model-train",
+        Schedule: time.Hour,
+        Handler: func(ctx context.Context) error {
+            _, err := spamClassifier.Train(ctx)
+            return err
+        },
+    })
+    jobs.AddJob(&scheduler.Job{
+        Name:     "spam-fingerprint-cleanup",
+        Schedule: time.Hour,
+        Handler: func(ctx context.Context) error {
+            _, err := spamClassifier.Cleanup(ctx)
+            return err
+        },
+    })
+    jobs.AddJob(&scheduler.Job{
+        Name:     "feed-cleanup",
+        Schedule: 24 * time.Hour,
+        Handler: func(ctx context.Context) error {
+            _, err := feedService.Cleanup(ctx)
+            return err
+        },
+    })
+    jobs.AddJob(&scheduler.Job{
+        Name:     "data-export-cleanup",
+        Schedule: time.Hour,
+        Handler: func(ctx context.Context) error {
+            _, err := dataExportService.Cleanup(ctx)
+            return err
+        },
+    })
+    jobs.AddJob(&scheduler.Job{
+        Name:     "withdrawal-purge",
+        Schedule: time.Hour,
+        Handler: func(ctx context.Context) error {
+            _, err := withdrawalService.Purge(ctx)
+            return err
+        },
+    })
+    jobs.AddJob(&scheduler.Job{
+        Name:     "inactive-users",
+        Schedule: 24 * time.Hour,
+        Handler:  batchService.ProcessInactiveUsers,
+    })
+    jobs.Start(context.Background())
 
     // 라우터 설정
-    r := router.SetupRouter(hub, notifService)
+    r := router.SetupRouter(hub, notifService, postWriteService, commentService, moderationService)
 
     r.Run(":8080")
 }
@@ -0,0 +1,56 @@
+// breachdb 유출 비밀번호 목록을 조회용 파일로 변환
+//
+// 사용법:
+//
+//	go run ./cmd/breachdb -in pwned-passwords-sha1.txt -out data/breached.bin -min-count 10
+//	go run ./cmd/breachdb -in common-passwords.txt -out data/breached.bin
+package main
+
+import (
+    "flag"
+    "fmt"
+    "io"
+    "log"
+    "os"
+
+    "goboardapi/internal/breach"
+)
+
+func main() {
+    in := flag.String("in", "-", "입력 파일 (평문 비밀번호 또는 SHA1[:횟수] 한 줄씩, -는 표준 입력)")
+    out := flag.String("out", "breached.bin", "출력 파일")
+    minCount := flag.Int("min-count", 0, "SHA1:횟수 입력에서 이 횟수 미만인 항목 제외")
+    flag.Parse()
+
+    var reader io.Reader = os.Stdin
+    if *in != "-" {
+        f, err := os.Open(*in)
+        if err != nil {
+            log.Fatalf("입력 파일 열기 실패: %v", err)
+        }
+        defer f.Close()
+        reader = f
+    }
+
+    // 빌드 도중 실패해도 기존 파일이 깨지지 않도록 임시 파일에 쓴 뒤 교체
+    tmp := *out + ".tmp"
+    f, err := os.Create(tmp)
+    if err != nil {
+        log.Fatalf("출력 파일 생성 실패: %v", err)
+    }
+
+    count, err := breach.Build(reader, f, breach.BuildOptions{MinCount: *minCount})
+    if closeErr := f.Close(); err == nil {
+        err = closeErr
+    }
+    if err != nil {
+        os.Remove(tmp)
+        log.Fatalf("빌드 실패: %v", err)
+    }
+
+    if err := os.Rename(tmp, *out); err != nil {
+        log.Fatalf("출력 파일 교체 실패: %v", err)
+    }
+
+    fmt.Printf("%s: %d건\n", *out, count)
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+    "crypto/rand"
+    "crypto/subtle"
+    "encoding/base64"
+    "errors"
+    "fmt"
+    "strings"
+
+    "golang.org/x/crypto/argon2"
+)
+
+var ErrInvalidHash = errors.New("invalid password hash format")
+
+const argon2idPrefix = "$argon2id$"
+
+// Argon2Params Argon2id 비용 설정
+type Argon2Params struct {
+    Memory      uint32 `mapstructure:"memory"` // KiB
+    Iterations  uint32 `mapstructure:"iterations"`
+    Parallelism uint8  `mapstructure:"parallelism"`
+    SaltLength  uint32 `mapstructure:"salt_length"`
+    KeyLength   uint32 `mapstructure:"key_length"`
+}
+
+// DefaultArgon2Params 기본 설정 (64MiB, 3회, 병렬 2)
+func DefaultArgon2Params() Argon2Params {
+    return Argon2Params{
+        Memory:      64 * 1024,
+        Iterations:  3,
+        Parallelism: 2,
+        SaltLength:  16,
+        KeyLength:   32,
+    }
+}
+
+// Validate argon2.IDKey가 패닉하지 않는 설정인지 확인 (설정 파일을 읽을 때 호출)
+func (p Argon2Params) Validate() error {
+    switch {
+    case p.Iterations == 0:
+        return errors.New("argon2: iterations must be positive")
+    case p.Parallelism == 0:
+        return errors.New("argon2: parallelism must be positive")
+    case p.Memory < 8*uint32(p.Parallelism):
+        return fmt.Errorf("argon2: memory must be at least %d KiB (8 * parallelism)", 8*uint32(p.Parallelism))
+    case p.SaltLength == 0:
+        return errors.New("argon2: salt_length must be positive")
+    case p.KeyLength == 0:
+        return errors.New("argon2: key_length must be positive")
+    }
+    return nil
+}
+
+type argon2idHasher struct {
+    params Argon2Params
+}
+
+func NewArgon2idHasher(params Argon2Params) PasswordHasher {
+    return &argon2idHasher{params: params}
+}
+
+// Hash PHC 문자열 형식으로 해싱
+// 예: $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash> (base64, 패딩 없음)
+func (h *argon2idHasher) Hash(password string) (string, error) {
+    salt := make([]byte, h.params.SaltLength)
+    if _, err := rand.Read(salt); err != nil {
+        return "", err
+    }
+
+    key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+    return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+        argon2idPrefix,
+        argon2.Version,
+        h.params.Memory,
+        h.params.Iterations,
+        h.params.Parallelism,
+        base64.RawStdEncoding.EncodeToString(salt),
+        base64.RawStdEncoding.EncodeToString(key),
+    ), nil
+}
+
+// Compare 해시에 기록된 설정으로 다시 계산해 비교 (설정이 바뀐 뒤에도 기존 해시 검증 가능)
+func (h *argon2idHasher) Compare(hashedPassword, password string) bool {
+    params, salt, key, err := decodeArgon2idHash(hashedPassword)
+    if err != nil {
+        return false
+    }
+
+    other := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+    return subtle.ConstantTimeCompare(key, other) == 1
+}
+
+func (h *argon2idHasher) Supports(hashedPassword string) bool {
+    return strings.HasPrefix(hashedPassword, argon2idPrefix)
+}
+
+func (h *argon2idHasher) NeedsRehash(hashedPassword string) bool {
+    params, _, _, err := decodeArgon2idHash(hashedPassword)
+    if err != nil {
+        return true
+    }
+    return params != h.params
+}
+
+// decodeArgon2idHash PHC 문자열에서 설정, 솔트, 해시 추출
+func decodeArgon2idHash(encoded string) (Argon2Params, []byte, []byte, error) {
+    var params Argon2Params
+
+    // "", "argon2id", "v=19", "m=...,t=...,p=...", salt, hash
+    parts := strings.Split(encoded, "$")
+    if len(parts) != 6 || parts[1] != "argon2id" {
+        return params, nil, nil, ErrInvalidHash
+    }
+
+    var version int
+    if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+        return params, nil, nil, ErrInvalidHash
+    }
+
+    if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+        return params, nil, nil, ErrInvalidHash
+    }
+    if params.Memory == 0 || params.Iterations == 0 || params.Parallelism == 0 {
+        return params, nil, nil, ErrInvalidHash
+    }
+
+    salt, err := base64.RawStdEncoding.Strict().DecodeString(parts[4])
+    if err != nil || len(salt) == 0 {
+        return params, nil, nil, ErrInvalidHash
+    }
+    key, err := base64.RawStdEncoding.Strict().DecodeString(parts[5])
+    if err != nil || len(key) == 0 {
+        return params, nil, nil, ErrInvalidHash
+    }
+
+    params.SaltLength = uint32(len(salt))
+    params.KeyLength = uint32(len(key))
+    return params, salt, key, nil
+}
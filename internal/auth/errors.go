@@ -26,6 +26,7 @@ func RequireOwner(ctx context.Context, ownerID uint) error {
         return ErrNotAuthenticated
     }
     if !checker.IsOwner(ctx, ownerID) {
+        recordDenial(ctx, "auth.RequireOwner", "", nil, "not the owner")
         return ErrNotOwner
     }
     return nil
@@ -38,6 +39,7 @@ func RequireOwnerOrAdmin(ctx context.Context, ownerID uint) error {
         return ErrNotAuthenticated
     }
     if !checker.CanModify(ctx, ownerID) {
+        recordDenial(ctx, "auth.RequireOwnerOrAdmin", "", nil, "neither the owner nor an admin")
         return ErrNoPermission
     }
     return nil
@@ -50,18 +52,20 @@ func RequireAdmin(ctx context.Context) error {
         return ErrNotAuthenticated
     }
     if !checker.IsAdmin(ctx) {
+        recordDenial(ctx, "auth.RequireAdmin", "", nil, "not an admin")
         return ErrNoPermission
     }
     return nil
 }
 
-// RequirePermission 특정 권한 필수 확인
-func RequirePermission(ctx context.Context, permission domain.Permission) error {
+// RequirePermission 특정 권한 필수 확인 (scopes를 주면 해당 범위에 부여된 역할도 인정)
+func RequirePermission(ctx context.Context, permission domain.Permission, scopes ...domain.Scope) error {
     checker := NewChecker()
     if !checker.IsAuthenticated(ctx) {
         return ErrNotAuthenticated
     }
-    if !checker.HasPermission(ctx, permission) {
+    if !checker.HasPermission(ctx, permission, scopes...) {
+        recordDenial(ctx, "auth.RequirePermission", string(permission), scopes, "permission not granted")
         return ErrNoPermission
     }
     return nil
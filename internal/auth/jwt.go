@@ -0,0 +1,86 @@
+package auth
+
+import (
+    "errors"
+    "strconv"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/middleware"
+
+    "github.com/golang-jwt/jwt/v5"
+)
+
+// challengeAudience 2단계 인증 대기 토큰 용도
+const challengeAudience = "2fa_challenge"
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// TokenProvider JWT 발급/검증
+type TokenProvider struct {
+    secret       []byte
+    accessTTL    time.Duration
+    challengeTTL time.Duration
+}
+
+func NewTokenProvider(secret string, accessTTL time.Duration) *TokenProvider {
+    return &TokenProvider{
+        secret:       []byte(secret),
+        accessTTL:    accessTTL,
+        challengeTTL: 5 * time.Minute,
+    }
+}
+
+// AccessTTL 액세스 토큰 유효 기간
+func (p *TokenProvider) AccessTTL() time.Duration {
+    return p.accessTTL
+}
+
+// GenerateAccessToken 액세스 토큰 발급
+// sessionID는 토큰이 속한 로그인 세션, mfa는 2단계 인증까지 마친 로그인인지 여부다.
+func (p *TokenProvider) GenerateAccessToken(user *domain.User, sessionID uint, mfa bool) (string, error) {
+    now := time.Now()
+    claims := &middleware.Claims{
+        UserID:    user.ID,
+        Email:     user.Email,
+        Role:      string(user.Role),
+        MFA:       mfa,
+        SessionID: sessionID,
+        RegisteredClaims: jwt.RegisteredClaims{
+            Subject:   strconv.FormatUint(uint64(user.ID), 10),
+            Audience:  jwt.ClaimStrings{middleware.AccessTokenAudience},
+            IssuedAt:  jwt.NewNumericDate(now),
+            ExpiresAt: jwt.NewNumericDate(now.Add(p.accessTTL)),
+        },
+    }
+    return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(p.secret)
+}
+
+// GenerateChallengeToken 비밀번호 확인 후 2단계 인증을 기다리는 짧은 수명의 토큰 발급
+func (p *TokenProvider) GenerateChallengeToken(userID uint) (string, error) {
+    now := time.Now()
+    claims := jwt.RegisteredClaims{
+        Subject:   strconv.FormatUint(uint64(userID), 10),
+        Audience:  jwt.ClaimStrings{challengeAudience},
+        IssuedAt:  jwt.NewNumericDate(now),
+        ExpiresAt: jwt.NewNumericDate(now.Add(p.challengeTTL)),
+    }
+    return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(p.secret)
+}
+
+// ParseChallengeToken 2단계 인증 대기 토큰 검증 후 사용자 ID 반환
+func (p *TokenProvider) ParseChallengeToken(tokenString string) (uint, error) {
+    claims := &jwt.RegisteredClaims{}
+    token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+        return p.secret, nil
+    }, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithAudience(challengeAudience))
+    if err != nil || !token.Valid {
+        return 0, ErrInvalidToken
+    }
+
+    userID, err := strconv.ParseUint(claims.Subject, 10, 32)
+    if err != nil {
+        return 0, ErrInvalidToken
+    }
+    return uint(userID), nil
+}
@@ -0,0 +1,49 @@
+package auth
+
+// multiHasher 새 해시는 primary로 만들고, 기존 해시는 형식에 맞는 해셔로 검증
+type multiHasher struct {
+    primary PasswordHasher
+    legacy  []PasswordHasher
+}
+
+// NewMultiHasher 알고리즘 전환용 해셔
+// 예: NewMultiHasher(NewArgon2idHasher(DefaultArgon2Params()), NewBcryptHasher(bcrypt.DefaultCost))
+func NewMultiHasher(primary PasswordHasher, legacy ...PasswordHasher) PasswordHasher {
+    return &multiHasher{primary: primary, legacy: legacy}
+}
+
+func (h *multiHasher) Hash(password string) (string, error) {
+    return h.primary.Hash(password)
+}
+
+func (h *multiHasher) Compare(hashedPassword, password string) bool {
+    hasher := h.find(hashedPassword)
+    if hasher == nil {
+        return false
+    }
+    return hasher.Compare(hashedPassword, password)
+}
+
+func (h *multiHasher) Supports(hashedPassword string) bool {
+    return h.find(hashedPassword) != nil
+}
+
+// NeedsRehash primary가 아닌 알고리즘이면 항상 다시 해싱
+func (h *multiHasher) NeedsRehash(hashedPassword string) bool {
+    if !h.primary.Supports(hashedPassword) {
+        return true
+    }
+    return h.primary.NeedsRehash(hashedPassword)
+}
+
+func (h *multiHasher) find(hashedPassword string) PasswordHasher {
+    if h.primary.Supports(hashedPassword) {
+        return h.primary
+    }
+    for _, hasher := range h.legacy {
+        if hasher.Supports(hashedPassword) {
+            return hasher
+        }
+    }
+    return nil
+}
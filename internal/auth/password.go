@@ -1,11 +1,19 @@
 package auth
 
-import "golang.org/x/crypto/bcrypt"
+import (
+    "strings"
+
+    "golang.org/x/crypto/bcrypt"
+)
 
 // PasswordHasher 비밀번호 해싱 인터페이스
 type PasswordHasher interface {
     Hash(password string) (string, error)
     Compare(hashedPassword, password string) bool
+    // Supports 이 해셔가 만든 형식의 해시인지 (해시 문자열 접두사로 판별)
+    Supports(hashedPassword string) bool
+    // NeedsRehash 현재 알고리즘/설정으로 다시 해싱해야 하는지
+    NeedsRehash(hashedPassword string) bool
 }
 
 type bcryptHasher struct {
@@ -25,3 +33,18 @@ func (h *bcryptHasher) Compare(hashedPassword, password string) bool {
     err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
     return err == nil
 }
+
+// Supports bcrypt 모듈러 크립트 형식 ($2a$, $2b$, $2y$)
+func (h *bcryptHasher) Supports(hashedPassword string) bool {
+    return strings.HasPrefix(hashedPassword, "$2a$") ||
+        strings.HasPrefix(hashedPassword, "$2b$") ||
+        strings.HasPrefix(hashedPassword, "$2y$")
+}
+
+func (h *bcryptHasher) NeedsRehash(hashedPassword string) bool {
+    cost, err := bcrypt.Cost([]byte(hashedPassword))
+    if err != nil {
+        return true
+    }
+    return cost < h.cost
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+    "strings"
+    "testing"
+
+    "golang.org/x/crypto/bcrypt"
+)
+
+// 테스트 속도를 위한 낮은 비용 설정
+var testArgon2Params = Argon2Params{
+    Memory:      1024,
+    Iterations:  1,
+    Parallelism: 1,
+    SaltLength:  16,
+    KeyLength:   32,
+}
+
+func TestArgon2idHasher(t *testing.T) {
+    hasher := NewArgon2idHasher(testArgon2Params)
+
+    hash, err := hasher.Hash("correct horse")
+    if err != nil {
+        t.Fatalf("Hash() error = %v", err)
+    }
+
+    if !strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$") {
+        t.Errorf("Hash() = %q, want PHC argon2id string", hash)
+    }
+    if !hasher.Compare(hash, "correct horse") {
+        t.Error("Compare() with correct password = false")
+    }
+    if hasher.Compare(hash, "wrong horse") {
+        t.Error("Compare() with wrong password = true")
+    }
+
+    other, _ := hasher.Hash("correct horse")
+    if other == hash {
+        t.Error("Hash() must use a random salt")
+    }
+
+    if hasher.NeedsRehash(hash) {
+        t.Error("NeedsRehash() with current params = true")
+    }
+
+    stronger := testArgon2Params
+    stronger.Iterations = 2
+    upgraded := NewArgon2idHasher(stronger)
+    if !upgraded.NeedsRehash(hash) {
+        t.Error("NeedsRehash() after raising iterations = false")
+    }
+    // 설정이 바뀌어도 기존 해시는 검증되어야 한다
+    if !upgraded.Compare(hash, "correct horse") {
+        t.Error("Compare() with old params hash = false")
+    }
+}
+
+func TestArgon2idHasherInvalidHash(t *testing.T) {
+    hasher := NewArgon2idHasher(testArgon2Params)
+
+    invalid := []string{
+        "",
+        "$argon2id$",
+        "$argon2i$v=19$m=1024,t=1,p=1$c2FsdA$aGFzaA",
+        "$argon2id$v=18$m=1024,t=1,p=1$c2FsdA$aGFzaA",
+        "$argon2id$v=19$m=0,t=1,p=1$c2FsdA$aGFzaA",
+        "$argon2id$v=19$m=1024,t=1,p=1$!!!$aGFzaA",
+    }
+    for _, hash := range invalid {
+        if hasher.Compare(hash, "password") {
+            t.Errorf("Compare(%q) = true", hash)
+        }
+        if !hasher.NeedsRehash(hash) {
+            t.Errorf("NeedsRehash(%q) = false", hash)
+        }
+    }
+}
+
+func TestMultiHasher(t *testing.T) {
+    bcryptHasher := NewBcryptHasher(bcrypt.MinCost)
+    argonHasher := NewArgon2idHasher(testArgon2Params)
+    hasher := NewMultiHasher(argonHasher, bcryptHasher)
+
+    legacy, err := bcryptHasher.Hash("secret")
+    if err != nil {
+        t.Fatalf("bcrypt Hash() error = %v", err)
+    }
+
+    if !hasher.Compare(legacy, "secret") {
+        t.Error("Compare() with legacy bcrypt hash = false")
+    }
+    if !hasher.NeedsRehash(legacy) {
+        t.Error("NeedsRehash() with legacy bcrypt hash = false")
+    }
+
+    current, err := hasher.Hash("secret")
+    if err != nil {
+        t.Fatalf("Hash() error = %v", err)
+    }
+    if !argonHasher.Supports(current) {
+        t.Errorf("Hash() = %q, want argon2id hash", current)
+    }
+    if hasher.NeedsRehash(current) {
+        t.Error("NeedsRehash() with current hash = true")
+    }
+
+    if hasher.Compare("plaintext", "plaintext") {
+        t.Error("Compare() with unknown format = true")
+    }
+}
+
+func TestBcryptHasherNeedsRehash(t *testing.T) {
+    low, _ := NewBcryptHasher(bcrypt.MinCost).Hash("secret")
+
+    if NewBcryptHasher(bcrypt.MinCost).NeedsRehash(low) {
+        t.Error("NeedsRehash() with same cost = true")
+    }
+    if !NewBcryptHasher(bcrypt.MinCost + 1).NeedsRehash(low) {
+        t.Error("NeedsRehash() with raised cost = false")
+    }
+}
+
+func TestArgon2ParamsValidate(t *testing.T) {
+    if err := DefaultArgon2Params().Validate(); err != nil {
+        t.Errorf("DefaultArgon2Params().Validate() error = %v", err)
+    }
+
+    tests := []struct {
+        name   string
+        modify func(p *Argon2Params)
+    }{
+        {"iterations 0", func(p *Argon2Params) { p.Iterations = 0 }},
+        {"parallelism 0", func(p *Argon2Params) { p.Parallelism = 0 }},
+        {"memory below 8*parallelism", func(p *Argon2Params) { p.Memory = 8*uint32(p.Parallelism) - 1 }},
+        {"key_length 0", func(p *Argon2Params) { p.KeyLength = 0 }},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            params := testArgon2Params
+            tt.modify(&params)
+            if err := params.Validate(); err == nil {
+                t.Error("Validate() error = nil, want error")
+            }
+        })
+    }
+}
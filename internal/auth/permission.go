@@ -2,6 +2,7 @@ package auth
 
 import (
     "context"
+    "strings"
 
     "goboardapi/internal/domain"
     "goboardapi/internal/middleware"
@@ -70,11 +71,32 @@ func (c *Checker) HasRole(ctx context.Context, requiredRole domain.Role) bool {
     return role.HasPermission(requiredRole)
 }
 
-// HasPermission 특정 권한이 있는지 확인
-func (c *Checker) HasPermission(ctx context.Context, permission domain.Permission) bool {
-    role, ok := c.GetCurrentRole(ctx)
+// HasPermission 특정 권한이 있는지 확인 (개인 액세스 토큰은 토큰 범위로 제한)
+// 역할 권한은 domain.SetPermissionSource로 등록된 DB 저장소에서 읽는다.
+// scopes를 주면 전역 권한이 없어도 그중 한 범위에 부여된 역할(게시판 모더레이터 등)로 통과한다.
+func (c *Checker) HasPermission(ctx context.Context, permission domain.Permission, scopes ...domain.Scope) bool {
+    claims, ok := middleware.GetUserFromContext(ctx)
     if !ok {
         return false
     }
-    return domain.HasPermission(role, permission)
+    if claims.HasPermission(permission) {
+        return true
+    }
+    for _, scope := range scopes {
+        if claims.HasPermissionIn(ctx, permission, scope) {
+            return true
+        }
+    }
+    return false
+}
+
+// recordDenial 권한 거부를 감사 로그에 남긴다 (explain API로 원인을 추적할 수 있도록)
+func recordDenial(ctx context.Context, check, action string, scopes []domain.Scope, reason string) {
+    resources := make([]string, 0, len(scopes))
+    for _, scope := range scopes {
+        if !scope.IsZero() {
+            resources = append(resources, scope.String())
+        }
+    }
+    middleware.RecordDenial(ctx, check, action, strings.Join(resources, ","), reason)
 }
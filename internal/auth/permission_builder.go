@@ -46,10 +46,10 @@ func (b *PermissionBuilder) IsAdmin() *PermissionBuilder {
     return b
 }
 
-// HasPermission 권한 조건 추가
-func (b *PermissionBuilder) HasPermission(permission domain.Permission) *PermissionBuilder {
+// HasPermission 권한 조건 추가 (scopes를 주면 해당 범위에 부여된 역할도 인정)
+func (b *PermissionBuilder) HasPermission(permission domain.Permission, scopes ...domain.Scope) *PermissionBuilder {
     b.conditions = append(b.conditions, func() bool {
-        return NewChecker().HasPermission(b.ctx, permission)
+        return NewChecker().HasPermission(b.ctx, permission, scopes...)
     })
     return b
 }
@@ -0,0 +1,107 @@
+package auth
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/policy"
+)
+
+// policyEngine 정책이 등록되기 전에는 모든 요청을 거부한다
+var policyEngine, _ = policy.NewEngine(nil)
+
+// SetPolicyEngine 정책 엔진 등록 (서버 시작 시 정책 파일로 만든 엔진으로 교체)
+func SetPolicyEngine(engine *policy.Engine) {
+    policyEngine = engine
+}
+
+// scopedResource 게시판 등 범위에 속한 리소스 (has_permission이 범위 한정 역할도 인정)
+type scopedResource interface {
+    PolicyScope() domain.Scope
+}
+
+// Can 현재 사용자가 resource에 action을 할 수 있는지 정책 엔진으로 판단
+// 조건식에서는 subject(id, role, authenticated), resource 속성, context(now, request_id)와
+// has_permission("post:manage") 함수를 쓸 수 있다.
+func (c *Checker) Can(ctx context.Context, action string, resource policy.Resource) bool {
+    return c.Decide(ctx, action, resource).Allowed
+}
+
+// Decide Can과 같은 판단 (결정을 내린 정책과 이유 포함)
+func (c *Checker) Decide(ctx context.Context, action string, resource policy.Resource) policy.Decision {
+    return policyEngine.Evaluate(c.policyRequest(ctx, action, resource))
+}
+
+// Explain 모든 정책의 평가 기록과 함께 판단 (관리자 explain API용, 거부를 기록하지 않음)
+func (c *Checker) Explain(ctx context.Context, action string, resource policy.Resource) (policy.Decision, []policy.Trace) {
+    return policyEngine.Explain(c.policyRequest(ctx, action, resource))
+}
+
+func (c *Checker) policyRequest(ctx context.Context, action string, resource policy.Resource) policy.Request {
+    subject := map[string]any{"id": uint(0), "role": "", "authenticated": false}
+    if claims, ok := middleware.GetUserFromContext(ctx); ok {
+        subject = map[string]any{"id": claims.UserID, "role": claims.Role, "authenticated": true}
+    }
+
+    var scopes []domain.Scope
+    if scoped, ok := resource.(scopedResource); ok {
+        if scope := scoped.PolicyScope(); !scope.IsZero() {
+            scopes = append(scopes, scope)
+        }
+    }
+
+    return policy.Request{
+        Action:   action,
+        Subject:  subject,
+        Resource: resource,
+        Context: map[string]any{
+            "now":        time.Now(),
+            "request_id": middleware.RequestIDFromContext(ctx),
+        },
+        Functions: map[string]policy.Function{
+            "has_permission": func(args ...any) (any, error) {
+                if len(args) != 1 {
+                    return nil, fmt.Errorf("has_permission() takes 1 argument")
+                }
+                permission, ok := args[0].(string)
+                if !ok {
+                    return nil, fmt.Errorf("has_permission() argument must be string")
+                }
+                return c.HasPermission(ctx, domain.Permission(permission), scopes...), nil
+            },
+        },
+    }
+}
+
+// RequireCan 정책 확인 (에러 반환)
+func RequireCan(ctx context.Context, action string, resource policy.Resource) error {
+    checker := NewChecker()
+    decision := checker.Decide(ctx, action, resource)
+    if decision.Allowed {
+        return nil
+    }
+    if !checker.IsAuthenticated(ctx) {
+        return ErrNotAuthenticated
+    }
+
+    reason := decision.Reason
+    if decision.Policy != "" {
+        reason = decision.Policy + ": " + reason
+    }
+    middleware.RecordDenial(ctx, "auth.RequireCan", action, resourceName(resource), reason)
+    return ErrNoPermission
+}
+
+// resourceName 로그용 대상 표기 (예: post:12)
+func resourceName(resource policy.Resource) string {
+    if resource == nil {
+        return ""
+    }
+    if id, ok := resource.PolicyAttributes()["id"]; ok {
+        return fmt.Sprintf("%s:%v", resource.PolicyType(), id)
+    }
+    return resource.PolicyType()
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+    "crypto/rand"
+    "math/big"
+    "strings"
+)
+
+// recoveryCodeAlphabet 헷갈리기 쉬운 문자(0/o, 1/i/l)를 뺀 문자 집합
+const recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// GenerateRecoveryCodes 2단계 인증 복구 코드 생성 (xxxxx-xxxxx 형식)
+func GenerateRecoveryCodes(n int) ([]string, error) {
+    max := big.NewInt(int64(len(recoveryCodeAlphabet)))
+
+    codes := make([]string, n)
+    for i := range codes {
+        var sb strings.Builder
+        for j := 0; j < 10; j++ {
+            if j == 5 {
+                sb.WriteByte('-')
+            }
+            idx, err := rand.Int(rand.Reader, max)
+            if err != nil {
+                return nil, err
+            }
+            sb.WriteByte(recoveryCodeAlphabet[idx.Int64()])
+        }
+        codes[i] = sb.String()
+    }
+    return codes, nil
+}
+
+// NormalizeRecoveryCode 입력된 복구 코드를 저장 형식으로 정규화
+func NormalizeRecoveryCode(code string) string {
+    code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), " ", ""))
+    if len(code) == 10 && !strings.Contains(code, "-") {
+        code = code[:5] + "-" + code[5:]
+    }
+    return code
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+    "crypto/rand"
+    "crypto/sha256"
+    "encoding/base64"
+    "encoding/hex"
+)
+
+// tokenBytes 일회용 토큰의 난수 길이 (256bit)
+const tokenBytes = 32
+
+// GenerateToken 일회용 토큰 생성
+// 사용자에게 전달할 원본 토큰과 DB에 저장할 해시를 함께 반환한다.
+func GenerateToken() (raw string, hash string, err error) {
+    buf := make([]byte, tokenBytes)
+    if _, err := rand.Read(buf); err != nil {
+        return "", "", err
+    }
+
+    raw = base64.RawURLEncoding.EncodeToString(buf)
+    return raw, HashToken(raw), nil
+}
+
+// HashToken 토큰을 저장용 SHA-256 해시로 변환
+func HashToken(raw string) string {
+    sum := sha256.Sum256([]byte(raw))
+    return hex.EncodeToString(sum[:])
+}
+
+// GeneratePrefixedToken 접두사가 붙은 토큰 생성 (해시는 접두사를 포함한 전체 문자열 기준)
+func GeneratePrefixedToken(prefix string) (raw string, hash string, err error) {
+    token, _, err := GenerateToken()
+    if err != nil {
+        return "", "", err
+    }
+
+    raw = prefix + token
+    return raw, HashToken(raw), nil
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+    "strings"
+    "testing"
+)
+
+func TestGenerateToken(t *testing.T) {
+    raw, hash, err := GenerateToken()
+    if err != nil {
+        t.Fatalf("GenerateToken() error = %v", err)
+    }
+
+    if raw == "" || hash == "" {
+        t.Fatal("GenerateToken() returned empty token")
+    }
+    if raw == hash {
+        t.Error("raw token must not be stored as-is")
+    }
+    if got := HashToken(raw); got != hash {
+        t.Errorf("HashToken(raw) = %q, want %q", got, hash)
+    }
+
+    other, _, _ := GenerateToken()
+    if other == raw {
+        t.Error("GenerateToken() returned duplicate token")
+    }
+}
+
+func TestGeneratePrefixedToken(t *testing.T) {
+    raw, hash, err := GeneratePrefixedToken("gbpat_")
+    if err != nil {
+        t.Fatalf("GeneratePrefixedToken() error = %v", err)
+    }
+
+    if !strings.HasPrefix(raw, "gbpat_") {
+        t.Errorf("raw = %q, want prefix gbpat_", raw)
+    }
+    if got := HashToken(raw); got != hash {
+        t.Errorf("HashToken(raw) = %q, want %q", got, hash)
+    }
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+    "crypto/hmac"
+    "crypto/rand"
+    "crypto/sha1"
+    "crypto/subtle"
+    "encoding/base32"
+    "encoding/binary"
+    "fmt"
+    "net/url"
+    "strings"
+    "time"
+)
+
+// TOTP 기본 설정 (RFC 6238, Google Authenticator 호환)
+const (
+    totpPeriod     = 30 // 초
+    totpDigits     = 6
+    totpSecretSize = 20 // 160bit, RFC 4226 권장 길이
+    totpSkew       = 1  // 앞뒤로 허용하는 시간 단계 수 (시계 오차 보정)
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret Base32로 인코딩된 TOTP 비밀키 생성
+func GenerateTOTPSecret() (string, error) {
+    buf := make([]byte, totpSecretSize)
+    if _, err := rand.Read(buf); err != nil {
+        return "", err
+    }
+    return base32NoPadding.EncodeToString(buf), nil
+}
+
+// TOTPURI 인증 앱 등록용 otpauth URI 생성
+func TOTPURI(issuer, account, secret string) string {
+    label := url.PathEscape(issuer + ":" + account)
+
+    params := url.Values{}
+    params.Set("secret", secret)
+    params.Set("issuer", issuer)
+    params.Set("algorithm", "SHA1")
+    params.Set("digits", fmt.Sprint(totpDigits))
+    params.Set("period", fmt.Sprint(totpPeriod))
+
+    return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// TOTPStep 주어진 시각의 시간 단계 (RFC 6238의 T)
+func TOTPStep(t time.Time) int64 {
+    return t.Unix() / totpPeriod
+}
+
+// TOTPCode 주어진 시각의 TOTP 코드 생성
+func TOTPCode(secret string, t time.Time) (string, error) {
+    key, err := decodeTOTPSecret(secret)
+    if err != nil {
+        return "", err
+    }
+    return hotp(key, TOTPStep(t), totpDigits), nil
+}
+
+// ValidateTOTP 코드를 검증하고 일치한 시간 단계를 반환
+// 같은 코드의 재사용을 막으려면 호출자가 lastStep 이하의 단계를 거부해야 한다.
+func ValidateTOTP(secret, code string, t time.Time, lastStep int64) (int64, bool) {
+    key, err := decodeTOTPSecret(secret)
+    if err != nil {
+        return 0, false
+    }
+
+    code = strings.TrimSpace(code)
+    if len(code) != totpDigits {
+        return 0, false
+    }
+
+    current := TOTPStep(t)
+    for i := -totpSkew; i <= totpSkew; i++ {
+        step := current + int64(i)
+        if step <= lastStep {
+            continue
+        }
+        expected := hotp(key, step, totpDigits)
+        if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+            return step, true
+        }
+    }
+
+    return 0, false
+}
+
+// hotp RFC 4226 HOTP 계산
+func hotp(key []byte, counter int64, digits int) string {
+    var msg [8]byte
+    binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+    mac := hmac.New(sha1.New, key)
+    mac.Write(msg[:])
+    sum := mac.Sum(nil)
+
+    // 동적 절단 (dynamic truncation)
+    offset := sum[len(sum)-1] & 0x0f
+    value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+    mod := uint32(1)
+    for i := 0; i < digits; i++ {
+        mod *= 10
+    }
+
+    return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+func decodeTOTPSecret(secret string) ([]byte, error) {
+    secret = strings.ToUpper(strings.TrimRight(strings.ReplaceAll(secret, " ", ""), "="))
+    return base32NoPadding.DecodeString(secret)
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+    "encoding/base32"
+    "strings"
+    "testing"
+    "time"
+)
+
+// RFC 6238 Appendix B 테스트 벡터 (SHA1, 8자리)
+func TestHOTP_RFC6238Vectors(t *testing.T) {
+    key := []byte("12345678901234567890")
+
+    tests := []struct {
+        unix int64
+        want string
+    }{
+        {59, "94287082"},
+        {1111111109, "07081804"},
+        {1111111111, "14050471"},
+        {1234567890, "89005924"},
+        {2000000000, "69279037"},
+        {20000000000, "65353130"},
+    }
+
+    for _, tt := range tests {
+        step := TOTPStep(time.Unix(tt.unix, 0))
+        if got := hotp(key, step, 8); got != tt.want {
+            t.Errorf("hotp(T=%d) = %s, want %s", tt.unix, got, tt.want)
+        }
+    }
+}
+
+func TestValidateTOTP(t *testing.T) {
+    secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+    now := time.Unix(1111111111, 0)
+
+    code, err := TOTPCode(secret, now)
+    if err != nil {
+        t.Fatalf("TOTPCode() error = %v", err)
+    }
+    if code != "050471" {
+        t.Fatalf("TOTPCode() = %s, want 050471", code)
+    }
+
+    tests := []struct {
+        name     string
+        code     string
+        at       time.Time
+        lastStep int64
+        want     bool
+    }{
+        {"current step", code, now, 0, true},
+        {"previous step within skew", code, now.Add(totpPeriod * time.Second), 0, true},
+        {"outside skew", code, now.Add(3 * totpPeriod * time.Second), 0, false},
+        {"replayed step", code, now, TOTPStep(now), false},
+        {"wrong code", "000000", now, 0, false},
+        {"wrong length", "12345", now, 0, false},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            _, ok := ValidateTOTP(secret, tt.code, tt.at, tt.lastStep)
+            if ok != tt.want {
+                t.Errorf("ValidateTOTP() = %v, want %v", ok, tt.want)
+            }
+        })
+    }
+}
+
+func TestTOTPURI(t *testing.T) {
+    uri := TOTPURI("Go Board", "user@example.com", "JBSWY3DPEHPK3PXP")
+
+    if !strings.HasPrefix(uri, "otpauth://totp/Go%20Board:user@example.com?") {
+        t.Errorf("unexpected uri prefix: %s", uri)
+    }
+    for _, want := range []string{"secret=JBSWY3DPEHPK3PXP", "issuer=Go+Board", "digits=6", "period=30"} {
+        if !strings.Contains(uri, want) {
+            t.Errorf("uri %q does not contain %q", uri, want)
+        }
+    }
+}
+
+func TestRecoveryCodes(t *testing.T) {
+    codes, err := GenerateRecoveryCodes(10)
+    if err != nil {
+        t.Fatalf("GenerateRecoveryCodes() error = %v", err)
+    }
+
+    seen := make(map[string]bool)
+    for _, code := range codes {
+        if len(code) != 11 || code[5] != '-' {
+            t.Errorf("unexpected code format: %q", code)
+        }
+        if seen[code] {
+            t.Errorf("duplicate code: %q", code)
+        }
+        seen[code] = true
+
+        compact := strings.ToUpper(strings.ReplaceAll(code, "-", ""))
+        if got := NormalizeRecoveryCode(" " + compact + " "); got != code {
+            t.Errorf("NormalizeRecoveryCode(%q) = %q, want %q", compact, got, code)
+        }
+    }
+}
@@ -0,0 +1,122 @@
+// Package avatar 프로필 이미지 정규화
+//
+// 업로드된 JPEG/PNG/GIF를 가운데 기준 정사각형으로 자르고 지정 크기로 줄여
+// JPEG로 다시 인코딩한다. 재인코딩 과정에서 EXIF 등 메타데이터는 모두 제거된다.
+package avatar
+
+import (
+    "bytes"
+    "errors"
+    "image"
+    "image/color"
+    _ "image/gif"
+    "image/jpeg"
+    _ "image/png"
+)
+
+const (
+    // DefaultSize 출력 이미지 한 변의 길이(px)
+    DefaultSize = 256
+    // MaxSourcePixels 디코딩을 허용하는 원본 최대 픽셀 수 (압축 폭탄 방지)
+    MaxSourcePixels = 40_000_000
+
+    jpegQuality = 85
+)
+
+var (
+    ErrUnsupportedFormat = errors.New("avatar: unsupported image format")
+    ErrImageTooLarge     = errors.New("avatar: image dimensions too large")
+)
+
+// ContentType 출력 이미지 형식
+const ContentType = "image/jpeg"
+
+// Process 원본 이미지를 size x size JPEG로 변환
+func Process(data []byte, size int) ([]byte, error) {
+    if size <= 0 {
+        size = DefaultSize
+    }
+
+    cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+    if err != nil {
+        return nil, ErrUnsupportedFormat
+    }
+    switch format {
+    case "jpeg", "png", "gif":
+    default:
+        return nil, ErrUnsupportedFormat
+    }
+    if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > MaxSourcePixels {
+        return nil, ErrImageTooLarge
+    }
+
+    src, _, err := image.Decode(bytes.NewReader(data))
+    if err != nil {
+        return nil, ErrUnsupportedFormat
+    }
+
+    dst := resize(src, squareCrop(src.Bounds()), size)
+
+    var buf bytes.Buffer
+    if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: jpegQuality}); err != nil {
+        return nil, err
+    }
+    return buf.Bytes(), nil
+}
+
+// squareCrop 가운데 기준 정사각형 영역
+func squareCrop(b image.Rectangle) image.Rectangle {
+    w, h := b.Dx(), b.Dy()
+    if w > h {
+        offset := (w - h) / 2
+        return image.Rect(b.Min.X+offset, b.Min.Y, b.Min.X+offset+h, b.Max.Y)
+    }
+    offset := (h - w) / 2
+    return image.Rect(b.Min.X, b.Min.Y+offset, b.Max.X, b.Min.Y+offset+w)
+}
+
+// resize 영역 평균(box filter)으로 축소한다. 원본이 더 작으면 최근접 확대가 된다.
+// 투명 픽셀은 흰 배경 위에 합성한다 (JPEG는 알파 채널이 없음).
+func resize(src image.Image, area image.Rectangle, size int) *image.RGBA {
+    dst := image.NewRGBA(image.Rect(0, 0, size, size))
+    sw, sh := area.Dx(), area.Dy()
+
+    for dy := 0; dy < size; dy++ {
+        y0 := area.Min.Y + dy*sh/size
+        y1 := area.Min.Y + (dy+1)*sh/size
+        if y1 <= y0 {
+            y1 = y0 + 1
+        }
+
+        for dx := 0; dx < size; dx++ {
+            x0 := area.Min.X + dx*sw/size
+            x1 := area.Min.X + (dx+1)*sw/size
+            if x1 <= x0 {
+                x1 = x0 + 1
+            }
+
+            var r, g, b, a, n uint64
+            for y := y0; y < y1; y++ {
+                for x := x0; x < x1; x++ {
+                    pr, pg, pb, pa := src.At(x, y).RGBA()
+                    r += uint64(pr)
+                    g += uint64(pg)
+                    b += uint64(pb)
+                    a += uint64(pa)
+                    n++
+                }
+            }
+
+            // 알파 사전곱 값이므로 흰 배경 합성은 (0xffff - a)를 더하면 된다
+            bg := 0xffff - a/n
+            dst.SetRGBA(dx, dy, color.RGBA{
+                R: uint8((r/n + bg) >> 8),
+                G: uint8((g/n + bg) >> 8),
+                B: uint8((b/n + bg) >> 8),
+                A: 0xff,
+            })
+        }
+    }
+
+    return dst
+}
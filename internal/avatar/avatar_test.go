@@ -0,0 +1,76 @@
+package avatar
+
+import (
+    "bytes"
+    "errors"
+    "image"
+    "image/color"
+    "image/jpeg"
+    "image/png"
+    "testing"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+    t.Helper()
+    var buf bytes.Buffer
+    if err := png.Encode(&buf, img); err != nil {
+        t.Fatal(err)
+    }
+    return buf.Bytes()
+}
+
+func TestProcess(t *testing.T) {
+    // 가로로 긴 이미지: 왼쪽 1/3 빨강, 가운데 1/3 초록, 오른쪽 1/3 파랑
+    src := image.NewRGBA(image.Rect(0, 0, 600, 200))
+    for y := 0; y < 200; y++ {
+        for x := 0; x < 600; x++ {
+            c := color.RGBA{0, 0xff, 0, 0xff}
+            if x < 200 {
+                c = color.RGBA{0xff, 0, 0, 0xff}
+            } else if x >= 400 {
+                c = color.RGBA{0, 0, 0xff, 0xff}
+            }
+            src.SetRGBA(x, y, c)
+        }
+    }
+
+    out, err := Process(encodePNG(t, src), 64)
+    if err != nil {
+        t.Fatalf("Process() error = %v", err)
+    }
+
+    img, err := jpeg.Decode(bytes.NewReader(out))
+    if err != nil {
+        t.Fatalf("output is not a jpeg: %v", err)
+    }
+    if b := img.Bounds(); b.Dx() != 64 || b.Dy() != 64 {
+        t.Fatalf("size = %v, want 64x64", b)
+    }
+
+    // 가운데만 잘라냈으므로 초록색이어야 한다
+    r, g, b, _ := img.At(32, 32).RGBA()
+    if g>>8 < 200 || r>>8 > 60 || b>>8 > 60 {
+        t.Errorf("center pixel = (%d,%d,%d), want green", r>>8, g>>8, b>>8)
+    }
+}
+
+func TestProcessTransparentBecomesWhite(t *testing.T) {
+    src := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+
+    out, err := Process(encodePNG(t, src), 8)
+    if err != nil {
+        t.Fatalf("Process() error = %v", err)
+    }
+
+    img, _ := jpeg.Decode(bytes.NewReader(out))
+    r, g, b, _ := img.At(4, 4).RGBA()
+    if r>>8 < 245 || g>>8 < 245 || b>>8 < 245 {
+        t.Errorf("pixel = (%d,%d,%d), want white", r>>8, g>>8, b>>8)
+    }
+}
+
+func TestProcessRejectsInvalidInput(t *testing.T) {
+    if _, err := Process([]byte("not an image"), 64); !errors.Is(err, ErrUnsupportedFormat) {
+        t.Errorf("error = %v, want ErrUnsupportedFormat", err)
+    }
+}
@@ -0,0 +1,173 @@
+// Package breach 유출 비밀번호 목록 오프라인 조회
+//
+// 파일 형식: 헤더(magic 8바이트 + 항목 수 8바이트) 뒤에 SHA-1 앞 8바이트를
+// 오름차순으로 정렬해 이어 붙인다. 조회는 파일을 메모리에 올리지 않고 이진 탐색한다.
+// 64비트 접두사라 10억 건 기준 오탐 확률은 조회당 약 5e-11이다.
+package breach
+
+import (
+    "bufio"
+    "crypto/sha1"
+    "encoding/binary"
+    "encoding/hex"
+    "errors"
+    "io"
+    "os"
+    "slices"
+    "strconv"
+    "strings"
+)
+
+const (
+    magic      = "GBPWNED1"
+    headerSize = 16
+    entrySize  = 8
+)
+
+var ErrInvalidFile = errors.New("breach: invalid corpus file")
+
+// Checker 유출 비밀번호 파일 조회기 (동시 사용 가능)
+type Checker struct {
+    file  *os.File
+    count int64
+}
+
+// Open 빌드된 파일 열기
+func Open(path string) (*Checker, error) {
+    file, err := os.Open(path)
+    if err != nil {
+        return nil, err
+    }
+
+    header := make([]byte, headerSize)
+    if _, err := io.ReadFull(file, header); err != nil || string(header[:8]) != magic {
+        file.Close()
+        return nil, ErrInvalidFile
+    }
+
+    count := int64(binary.BigEndian.Uint64(header[8:]))
+    info, err := file.Stat()
+    if err != nil {
+        file.Close()
+        return nil, err
+    }
+    if info.Size() != headerSize+count*entrySize {
+        file.Close()
+        return nil, ErrInvalidFile
+    }
+
+    return &Checker{file: file, count: count}, nil
+}
+
+// Count 수록된 항목 수
+func (c *Checker) Count() int64 {
+    return c.count
+}
+
+// Contains 유출 목록에 있는 비밀번호인지 확인
+func (c *Checker) Contains(password string) (bool, error) {
+    target := prefix(sha1.Sum([]byte(password)))
+    buf := make([]byte, entrySize)
+
+    lo, hi := int64(0), c.count
+    for lo < hi {
+        mid := lo + (hi-lo)/2
+        if _, err := c.file.ReadAt(buf, headerSize+mid*entrySize); err != nil {
+            return false, err
+        }
+
+        v := binary.BigEndian.Uint64(buf)
+        switch {
+        case v == target:
+            return true, nil
+        case v < target:
+            lo = mid + 1
+        default:
+            hi = mid
+        }
+    }
+    return false, nil
+}
+
+func (c *Checker) Close() error {
+    return c.file.Close()
+}
+
+func prefix(sum [sha1.Size]byte) uint64 {
+    return binary.BigEndian.Uint64(sum[:entrySize])
+}
+
+// BuildOptions 빌드 옵션
+type BuildOptions struct {
+    MinCount int // "SHA1:count" 형식 입력에서 이 횟수 미만은 제외 (0이면 모두 포함)
+}
+
+// Build 입력을 읽어 조회용 파일 작성 후 수록 항목 수 반환
+// 입력 한 줄은 평문 비밀번호이거나 HIBP 형식("SHA1 16진수[:횟수]")이다.
+// 정렬을 위해 항목당 8바이트를 메모리에 올린다.
+func Build(in io.Reader, out io.Writer, opts BuildOptions) (int64, error) {
+    var entries []uint64
+
+    scanner := bufio.NewScanner(in)
+    scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+    for scanner.Scan() {
+        line := strings.TrimRight(scanner.Text(), "\r")
+        if line == "" {
+            continue
+        }
+
+        v, ok, err := parseLine(line, opts.MinCount)
+        if err != nil {
+            return 0, err
+        }
+        if ok {
+            entries = append(entries, v)
+        }
+    }
+    if err := scanner.Err(); err != nil {
+        return 0, err
+    }
+
+    slices.Sort(entries)
+    entries = slices.Compact(entries)
+
+    w := bufio.NewWriter(out)
+    header := make([]byte, headerSize)
+    copy(header, magic)
+    binary.BigEndian.PutUint64(header[8:], uint64(len(entries)))
+    if _, err := w.Write(header); err != nil {
+        return 0, err
+    }
+
+    buf := make([]byte, entrySize)
+    for _, v := range entries {
+        binary.BigEndian.PutUint64(buf, v)
+        if _, err := w.Write(buf); err != nil {
+            return 0, err
+        }
+    }
+
+    return int64(len(entries)), w.Flush()
+}
+
+// parseLine 한 줄을 항목으로 변환 (ok=false면 건너뜀)
+func parseLine(line string, minCount int) (uint64, bool, error) {
+    hash, countStr, hasCount := strings.Cut(line, ":")
+    if len(hash) == 2*sha1.Size {
+        if raw, err := hex.DecodeString(hash); err == nil {
+            if hasCount && minCount > 0 {
+                count, err := strconv.Atoi(strings.TrimSpace(countStr))
+                if err != nil {
+                    return 0, false, err
+                }
+                if count < minCount {
+                    return 0, false, nil
+                }
+            }
+            return binary.BigEndian.Uint64(raw[:entrySize]), true, nil
+        }
+    }
+
+    // 해시 형식이 아니면 평문 비밀번호로 취급
+    return prefix(sha1.Sum([]byte(line))), true, nil
+}
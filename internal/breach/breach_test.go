@@ -0,0 +1,98 @@
+package breach
+
+import (
+    "bytes"
+    "crypto/sha1"
+    "encoding/hex"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func sha1Hex(s string) string {
+    sum := sha1.Sum([]byte(s))
+    return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func buildFile(t *testing.T, input string, opts BuildOptions) *Checker {
+    t.Helper()
+
+    var buf bytes.Buffer
+    if _, err := Build(strings.NewReader(input), &buf, opts); err != nil {
+        t.Fatalf("Build() error = %v", err)
+    }
+
+    path := filepath.Join(t.TempDir(), "breached.bin")
+    if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+        t.Fatal(err)
+    }
+
+    checker, err := Open(path)
+    if err != nil {
+        t.Fatalf("Open() error = %v", err)
+    }
+    t.Cleanup(func() { checker.Close() })
+    return checker
+}
+
+func TestBuildAndContains(t *testing.T) {
+    input := strings.Join([]string{
+        "password",
+        "P@ssw0rd!",
+        "password", // 중복
+        "",
+        sha1Hex("qwerty123") + ":42",
+        strings.ToLower(sha1Hex("letmein")),
+    }, "\n")
+
+    checker := buildFile(t, input, BuildOptions{})
+
+    if got := checker.Count(); got != 4 {
+        t.Errorf("Count() = %d, want 4", got)
+    }
+
+    tests := []struct {
+        password string
+        want     bool
+    }{
+        {"password", true},
+        {"P@ssw0rd!", true},
+        {"qwerty123", true},
+        {"letmein", true},
+        {"Password", false},
+        {"c0rrect-h0rse-battery", false},
+    }
+    for _, tt := range tests {
+        got, err := checker.Contains(tt.password)
+        if err != nil {
+            t.Fatalf("Contains(%q) error = %v", tt.password, err)
+        }
+        if got != tt.want {
+            t.Errorf("Contains(%q) = %v, want %v", tt.password, got, tt.want)
+        }
+    }
+}
+
+func TestBuildMinCount(t *testing.T) {
+    input := sha1Hex("common") + ":100\n" + sha1Hex("rare") + ":2\n"
+    checker := buildFile(t, input, BuildOptions{MinCount: 10})
+
+    if ok, _ := checker.Contains("common"); !ok {
+        t.Error("Contains(common) = false, want true")
+    }
+    if ok, _ := checker.Contains("rare"); ok {
+        t.Error("Contains(rare) = true, want false (below min count)")
+    }
+}
+
+func TestOpenInvalidFile(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "invalid.bin")
+    if err := os.WriteFile(path, []byte("not a corpus file"), 0o600); err != nil {
+        t.Fatal(err)
+    }
+
+    if _, err := Open(path); err != ErrInvalidFile {
+        t.Errorf("Open() error = %v, want ErrInvalidFile", err)
+    }
+}
@@ -0,0 +1,211 @@
+package cache
+
+import (
+    "context"
+    "log"
+    "sync"
+    "time"
+
+    "github.com/redis/go-redis/v9"
+)
+
+// CounterStore 만료 시간이 있는 카운터와 플래그 저장소 (로그인 실패 횟수, 잠금 등)
+type CounterStore interface {
+    // Incr 카운터 증가 (새로 만들어질 때만 window 동안 유지되도록 만료 설정)
+    Incr(ctx context.Context, key string, window time.Duration) (int64, error)
+    // SetFlag ttl 동안 유지되는 플래그 설정 (이미 있으면 ttl을 덮어씀)
+    SetFlag(ctx context.Context, key string, ttl time.Duration) error
+    // TTL 남은 유지 시간 (키가 없으면 0)
+    TTL(ctx context.Context, key string) (time.Duration, error)
+    Delete(ctx context.Context, keys ...string) error
+}
+
+// NewCounterStore Redis가 초기화되어 있으면 Redis를, 아니면 인메모리 저장소를 사용
+// Redis 장애 시에는 인메모리 저장소로 대신 처리한다 (인스턴스 간 공유는 되지 않음).
+func NewCounterStore() CounterStore {
+    memory := NewMemoryCounterStore()
+    if redisClient == nil {
+        return memory
+    }
+    return &fallbackCounterStore{
+        primary:  &redisCounterStore{client: redisClient},
+        fallback: memory,
+    }
+}
+
+// redisCounterStore Redis 카운터 (여러 인스턴스가 공유)
+type redisCounterStore struct {
+    client *redis.Client
+}
+
+func (s *redisCounterStore) Incr(ctx context.Context, key string, window time.Duration) (int64, error) {
+    pipe := s.client.TxPipeline()
+    incr := pipe.Incr(ctx, key)
+    pipe.ExpireNX(ctx, key, window)
+    if _, err := pipe.Exec(ctx); err != nil {
+        return 0, err
+    }
+    return incr.Val(), nil
+}
+
+func (s *redisCounterStore) SetFlag(ctx context.Context, key string, ttl time.Duration) error {
+    return s.client.Set(ctx, key, "1", ttl).Err()
+}
+
+func (s *redisCounterStore) TTL(ctx context.Context, key string) (time.Duration, error) {
+    ttl, err := s.client.PTTL(ctx, key).Result()
+    if err != nil {
+        return 0, err
+    }
+    // -2: 키 없음, -1: 만료 없음
+    if ttl < 0 {
+        return 0, nil
+    }
+    return ttl, nil
+}
+
+func (s *redisCounterStore) Delete(ctx context.Context, keys ...string) error {
+    if len(keys) == 0 {
+        return nil
+    }
+    return s.client.Del(ctx, keys...).Err()
+}
+
+// fallbackCounterStore 기본 저장소 실패 시 보조 저장소 사용
+type fallbackCounterStore struct {
+    primary  CounterStore
+    fallback CounterStore
+}
+
+func (s *fallbackCounterStore) Incr(ctx context.Context, key string, window time.Duration) (int64, error) {
+    n, err := s.primary.Incr(ctx, key, window)
+    if err != nil {
+        log.Printf("카운터 저장소 장애, 메모리로 대체: %v", err)
+        return s.fallback.Incr(ctx, key, window)
+    }
+    return n, nil
+}
+
+func (s *fallbackCounterStore) SetFlag(ctx context.Context, key string, ttl time.Duration) error {
+    if err := s.primary.SetFlag(ctx, key, ttl); err != nil {
+        log.Printf("카운터 저장소 장애, 메모리로 대체: %v", err)
+        return s.fallback.SetFlag(ctx, key, ttl)
+    }
+    return nil
+}
+
+func (s *fallbackCounterStore) TTL(ctx context.Context, key string) (time.Duration, error) {
+    ttl, err := s.primary.TTL(ctx, key)
+    if err != nil {
+        log.Printf("카운터 저장소 장애, 메모리로 대체: %v", err)
+        return s.fallback.TTL(ctx, key)
+    }
+    // 장애 중에 메모리에만 기록된 잠금도 놓치지 않도록 둘 중 긴 값 사용
+    if memTTL, _ := s.fallback.TTL(ctx, key); memTTL > ttl {
+        return memTTL, nil
+    }
+    return ttl, nil
+}
+
+func (s *fallbackCounterStore) Delete(ctx context.Context, keys ...string) error {
+    s.fallback.Delete(ctx, keys...)
+    return s.primary.Delete(ctx, keys...)
+}
+
+type counterEntry struct {
+    value     int64
+    expiresAt time.Time
+}
+
+// MemoryCounterStore 단일 인스턴스용 인메모리 카운터
+type MemoryCounterStore struct {
+    mu      sync.Mutex
+    entries map[string]*counterEntry
+    writes  int
+    now     func() time.Time
+}
+
+// memorySweepInterval 이 횟수만큼 쓸 때마다 만료된 항목 정리
+const memorySweepInterval = 1024
+
+func NewMemoryCounterStore() *MemoryCounterStore {
+    return &MemoryCounterStore{
+        entries: make(map[string]*counterEntry),
+        now:     time.Now,
+    }
+}
+
+// get 만료된 항목은 지우고 nil 반환 (호출 전에 s.mu를 잡고 있어야 한다)
+func (s *MemoryCounterStore) get(key string) *counterEntry {
+    entry, ok := s.entries[key]
+    if !ok {
+        return nil
+    }
+    if !s.now().Before(entry.expiresAt) {
+        delete(s.entries, key)
+        return nil
+    }
+    return entry
+}
+
+func (s *MemoryCounterStore) Incr(ctx context.Context, key string, window time.Duration) (int64, error) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    s.sweepLocked()
+
+    entry := s.get(key)
+    if entry == nil {
+        entry = &counterEntry{expiresAt: s.now().Add(window)}
+        s.entries[key] = entry
+    }
+    entry.value++
+    return entry.value, nil
+}
+
+func (s *MemoryCounterStore) SetFlag(ctx context.Context, key string, ttl time.Duration) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    s.sweepLocked()
+
+    s.entries[key] = &counterEntry{value: 1, expiresAt: s.now().Add(ttl)}
+    return nil
+}
+
+// sweepLocked 한 번 찾아오지 않는 키(예: 지나가는 IP)가 쌓이지 않도록 주기적으로 정리
+func (s *MemoryCounterStore) sweepLocked() {
+    s.writes++
+    if s.writes < memorySweepInterval {
+        return
+    }
+    s.writes = 0
+
+    now := s.now()
+    for key, entry := range s.entries {
+        if !now.Before(entry.expiresAt) {
+            delete(s.entries, key)
+        }
+    }
+}
+
+func (s *MemoryCounterStore) TTL(ctx context.Context, key string) (time.Duration, error) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    entry := s.get(key)
+    if entry == nil {
+        return 0, nil
+    }
+    return entry.expiresAt.Sub(s.now()), nil
+}
+
+func (s *MemoryCounterStore) Delete(ctx context.Context, keys ...string) error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    for _, key := range keys {
+        delete(s.entries, key)
+    }
+    return nil
+}
@@ -0,0 +1,45 @@
+package cache
+
+import (
+    "context"
+    "testing"
+    "time"
+)
+
+func TestMemoryCounterStore(t *testing.T) {
+    ctx := context.Background()
+    now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+    store := NewMemoryCounterStore()
+    store.now = func() time.Time { return now }
+
+    for want := int64(1); want <= 3; want++ {
+        got, err := store.Incr(ctx, "fail", time.Minute)
+        if err != nil {
+            t.Fatalf("Incr() error = %v", err)
+        }
+        if got != want {
+            t.Errorf("Incr() = %d, want %d", got, want)
+        }
+    }
+
+    // 창은 처음 증가한 시점 기준이라 이후 증가로 연장되지 않는다
+    now = now.Add(61 * time.Second)
+    if got, _ := store.Incr(ctx, "fail", time.Minute); got != 1 {
+        t.Errorf("Incr() after window = %d, want 1", got)
+    }
+
+    if err := store.SetFlag(ctx, "lock", 10*time.Minute); err != nil {
+        t.Fatalf("SetFlag() error = %v", err)
+    }
+    now = now.Add(4 * time.Minute)
+    if ttl, _ := store.TTL(ctx, "lock"); ttl != 6*time.Minute {
+        t.Errorf("TTL() = %v, want 6m", ttl)
+    }
+
+    if err := store.Delete(ctx, "lock", "fail"); err != nil {
+        t.Fatalf("Delete() error = %v", err)
+    }
+    if ttl, _ := store.TTL(ctx, "lock"); ttl != 0 {
+        t.Errorf("TTL() after Delete = %v, want 0", ttl)
+    }
+}
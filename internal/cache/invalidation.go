@@ -0,0 +1,83 @@
+package cache
+
+import (
+    "context"
+    "log"
+    "sync"
+
+    "github.com/redis/go-redis/v9"
+)
+
+// Invalidator 인스턴스 간 캐시 무효화 알림
+type Invalidator interface {
+    // Publish 채널을 구독 중인 모든 인스턴스(자신 포함)에 알림
+    Publish(ctx context.Context, channel, message string) error
+    // Subscribe ctx가 끝날 때까지 채널 알림마다 handler 호출
+    Subscribe(ctx context.Context, channel string, handler func(message string))
+}
+
+// NewInvalidator Redis가 초기화되어 있으면 Pub/Sub을, 아니면 현재 인스턴스에만 전달하는 구현을 사용
+func NewInvalidator() Invalidator {
+    if redisClient == nil {
+        return NewLocalInvalidator()
+    }
+    return &redisInvalidator{client: redisClient}
+}
+
+// redisInvalidator Redis Pub/Sub (구독이 끊긴 동안의 알림은 유실되므로 캐시 TTL을 함께 둔다)
+type redisInvalidator struct {
+    client *redis.Client
+}
+
+func (i *redisInvalidator) Publish(ctx context.Context, channel, message string) error {
+    return i.client.Publish(ctx, channel, message).Err()
+}
+
+func (i *redisInvalidator) Subscribe(ctx context.Context, channel string, handler func(message string)) {
+    pubsub := i.client.Subscribe(ctx, channel)
+    go func() {
+        defer pubsub.Close()
+
+        messages := pubsub.Channel()
+        for {
+            select {
+            case <-ctx.Done():
+                return
+            case msg, ok := <-messages:
+                if !ok {
+                    log.Printf("캐시 무효화 구독 종료: %s", channel)
+                    return
+                }
+                handler(msg.Payload)
+            }
+        }
+    }()
+}
+
+// LocalInvalidator 단일 인스턴스용 (테스트, Redis 없는 환경)
+type LocalInvalidator struct {
+    mu       sync.RWMutex
+    handlers map[string][]func(string)
+}
+
+func NewLocalInvalidator() *LocalInvalidator {
+    return &LocalInvalidator{handlers: make(map[string][]func(string))}
+}
+
+func (i *LocalInvalidator) Publish(ctx context.Context, channel, message string) error {
+    i.mu.RLock()
+    handlers := i.handlers[channel]
+    i.mu.RUnlock()
+
+    for _, handler := range handlers {
+        handler(message)
+    }
+    return nil
+}
+
+func (i *LocalInvalidator) Subscribe(ctx context.Context, channel string, handler func(message string)) {
+    i.mu.Lock()
+    defer i.mu.Unlock()
+
+    i.handlers[channel] = append(i.handlers[channel], handler)
+}
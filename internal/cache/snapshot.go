@@ -0,0 +1,132 @@
+package cache
+
+import (
+    "context"
+    "log"
+    "sync"
+    "time"
+)
+
+// snapshotRetryInterval 다시 읽기에 실패했을 때 이전 값을 쓰는 시간
+const snapshotRetryInterval = 5 * time.Second
+
+// Snapshot 한 번에 통째로 읽어 두는 작은 데이터셋 캐시 (권한 매핑 등)
+// ttl이 지나거나 Invalidate되면 다음 Get에서 다시 읽는다. 다시 읽다 실패하면 이전 값을 계속 쓴다.
+type Snapshot[T any] struct {
+    ttl  time.Duration
+    load func(ctx context.Context) (T, error)
+    now  func() time.Time
+
+    mu        sync.RWMutex
+    value     T
+    loaded    bool
+    expiresAt time.Time
+    version   uint64 // Invalidate마다 증가 (읽는 도중 무효화된 결과를 최신으로 표시하지 않도록)
+
+    loadMu sync.Mutex // 동시에 만료를 본 요청이 한 번만 읽도록
+}
+
+func NewSnapshot[T any](ttl time.Duration, load func(ctx context.Context) (T, error)) *Snapshot[T] {
+    return &Snapshot[T]{ttl: ttl, load: load, now: time.Now}
+}
+
+// Get 캐시된 값 반환 (만료 또는 무효화되었으면 다시 읽음)
+func (s *Snapshot[T]) Get(ctx context.Context) (T, error) {
+    if value, ok := s.fresh(); ok {
+        return value, nil
+    }
+
+    s.loadMu.Lock()
+    defer s.loadMu.Unlock()
+
+    // 기다리는 동안 다른 요청이 읽어 왔으면 그대로 사용
+    if value, ok := s.fresh(); ok {
+        return value, nil
+    }
+
+    s.mu.RLock()
+    version := s.version
+    s.mu.RUnlock()
+
+    value, err := s.load(ctx)
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if err != nil {
+        if s.loaded {
+            // 저장소 장애 중에 매 요청이 다시 읽지 않도록 잠시 뒤에 재시도
+            s.expiresAt = s.now().Add(snapshotRetryInterval)
+            return s.value, nil
+        }
+        return value, err
+    }
+
+    s.value = value
+    s.loaded = true
+    if s.version == version {
+        s.expiresAt = s.now().Add(s.ttl)
+    }
+    return value, nil
+}
+
+// Invalidate 다음 Get에서 다시 읽도록 표시
+func (s *Snapshot[T]) Invalidate() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    s.expiresAt = time.Time{}
+    s.version++
+}
+
+func (s *Snapshot[T]) fresh() (T, bool) {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+
+    if s.loaded && s.now().Before(s.expiresAt) {
+        return s.value, true
+    }
+    var zero T
+    return zero, false
+}
+
+// SnapshotGroup 채널 하나로 모든 인스턴스에서 함께 비우는 Snapshot 묶음
+// 데이터가 바뀐 인스턴스가 Invalidate하면 Listen 중인 인스턴스의 Snapshot도 비워진다.
+// 알림이 유실되어도 각 Snapshot은 ttl이 지나면 다시 읽는다.
+type SnapshotGroup struct {
+    invalidator Invalidator
+    channel     string
+    snapshots   []interface{ Invalidate() }
+}
+
+func NewSnapshotGroup(invalidator Invalidator, channel string) *SnapshotGroup {
+    return &SnapshotGroup{invalidator: invalidator, channel: channel}
+}
+
+// NewGroupSnapshot group과 함께 비워지는 Snapshot 생성
+func NewGroupSnapshot[T any](group *SnapshotGroup, ttl time.Duration, load func(ctx context.Context) (T, error)) *Snapshot[T] {
+    snapshot := NewSnapshot(ttl, load)
+    group.snapshots = append(group.snapshots, snapshot)
+    return snapshot
+}
+
+// Listen 다른 인스턴스의 무효화 알림 구독 (ctx가 끝날 때까지)
+func (g *SnapshotGroup) Listen(ctx context.Context) {
+    g.invalidator.Subscribe(ctx, g.channel, func(string) {
+        g.invalidateLocal()
+    })
+}
+
+// Invalidate 현재 인스턴스의 Snapshot을 비우고 다른 인스턴스에 알림
+func (g *SnapshotGroup) Invalidate(ctx context.Context) {
+    g.invalidateLocal()
+    if err := g.invalidator.Publish(ctx, g.channel, "invalidate"); err != nil {
+        // 다른 인스턴스는 ttl이 지나면 반영된다
+        log.Printf("캐시 무효화 알림 실패: %s - %v", g.channel, err)
+    }
+}
+
+func (g *SnapshotGroup) invalidateLocal() {
+    for _, snapshot := range g.snapshots {
+        snapshot.Invalidate()
+    }
+}
@@ -0,0 +1,120 @@
+package cache
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+)
+
+func TestSnapshot(t *testing.T) {
+    ctx := context.Background()
+    now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+    loads := 0
+    var loadErr error
+    snapshot := NewSnapshot(time.Minute, func(ctx context.Context) (int, error) {
+        if loadErr != nil {
+            return 0, loadErr
+        }
+        loads++
+        return loads, nil
+    })
+    snapshot.now = func() time.Time { return now }
+
+    get := func() int {
+        t.Helper()
+        v, err := snapshot.Get(ctx)
+        if err != nil {
+            t.Fatalf("Get() error = %v", err)
+        }
+        return v
+    }
+
+    if got := get(); got != 1 {
+        t.Fatalf("first Get() = %d, want 1", got)
+    }
+    if got := get(); got != 1 {
+        t.Fatalf("cached Get() = %d, want 1", got)
+    }
+
+    snapshot.Invalidate()
+    if got := get(); got != 2 {
+        t.Fatalf("Get() after Invalidate = %d, want 2", got)
+    }
+
+    now = now.Add(2 * time.Minute)
+    if got := get(); got != 3 {
+        t.Fatalf("Get() after ttl = %d, want 3", got)
+    }
+
+    // 다시 읽기에 실패하면 이전 값 유지
+    loadErr = errors.New("db down")
+    snapshot.Invalidate()
+    if got := get(); got != 3 {
+        t.Fatalf("Get() on load error = %d, want stale 3", got)
+    }
+}
+
+func TestSnapshotInitialLoadError(t *testing.T) {
+    snapshot := NewSnapshot(time.Minute, func(ctx context.Context) (int, error) {
+        return 0, errors.New("db down")
+    })
+    if _, err := snapshot.Get(context.Background()); err == nil {
+        t.Fatal("Get() error = nil, want error")
+    }
+}
+
+func TestLocalInvalidator(t *testing.T) {
+    ctx := context.Background()
+    invalidator := NewLocalInvalidator()
+
+    var got []string
+    invalidator.Subscribe(ctx, "rbac", func(message string) { got = append(got, message) })
+    invalidator.Publish(ctx, "rbac", "grants")
+    invalidator.Publish(ctx, "other", "ignored")
+
+    if len(got) != 1 || got[0] != "grants" {
+        t.Fatalf("handled = %v, want [grants]", got)
+    }
+}
+
+func TestSnapshotGroup(t *testing.T) {
+    ctx := context.Background()
+    invalidator := NewLocalInvalidator()
+
+    // 같은 채널을 쓰는 두 인스턴스
+    newInstance := func(loads *int) (*SnapshotGroup, *Snapshot[int], *Snapshot[int]) {
+        group := NewSnapshotGroup(invalidator, "words:invalidate")
+        load := func(ctx context.Context) (int, error) {
+            *loads++
+            return *loads, nil
+        }
+        a, b := NewGroupSnapshot(group, time.Hour, load), NewGroupSnapshot(group, time.Hour, load)
+        group.Listen(ctx)
+        return group, a, b
+    }
+    var loadsA, loadsB int
+    groupA, _, _ := newInstance(&loadsA)
+    _, snapshotB1, snapshotB2 := newInstance(&loadsB)
+
+    snapshotB1.Get(ctx)
+    snapshotB2.Get(ctx)
+    if loadsB != 2 {
+        t.Fatalf("loads = %d, want 2", loadsB)
+    }
+
+    groupA.Invalidate(ctx)
+    snapshotB1.Get(ctx)
+    snapshotB2.Get(ctx)
+    if loadsB != 4 {
+        t.Errorf("loads after Invalidate on the other instance = %d, want 4 (both snapshots reloaded)", loadsB)
+    }
+
+    // 다른 채널은 영향 없음
+    NewSnapshotGroup(invalidator, "other").Invalidate(ctx)
+    snapshotB1.Get(ctx)
+    if loadsB != 4 {
+        t.Errorf("loads after unrelated Invalidate = %d, want 4", loadsB)
+    }
+}
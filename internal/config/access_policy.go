@@ -0,0 +1,13 @@
+package config
+
+import (
+    "goboardapi/internal/policy"
+
+    "github.com/spf13/viper"
+)
+
+// LoadPolicyEngine policy.dir의 정책 파일로 엔진 생성 (조건식 오류가 있으면 시작 실패)
+func LoadPolicyEngine() (*policy.Engine, error) {
+    viper.SetDefault("policy.dir", "config/policies")
+    return policy.LoadDir(viper.GetString("policy.dir"))
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+    "fmt"
+
+    "goboardapi/internal/service"
+
+    "github.com/spf13/viper"
+)
+
+// LoadDormancyConfig dormancy 설정 읽기 (없는 항목은 기본값)
+func LoadDormancyConfig() (service.DormancyConfig, error) {
+    viper.SetDefault("dormancy.reactivation_url", "http://localhost:3000/reactivate")
+
+    cfg := service.DefaultDormancyConfig(viper.GetString("dormancy.reactivation_url"))
+    if viper.IsSet("dormancy.dormant_after") {
+        cfg.DormantAfter = viper.GetDuration("dormancy.dormant_after")
+    }
+    if viper.IsSet("dormancy.warn_before") {
+        cfg.WarnBefore = viper.GetDuration("dormancy.warn_before")
+    }
+    if viper.IsSet("dormancy.token_ttl") {
+        cfg.TokenTTL = viper.GetDuration("dormancy.token_ttl")
+    }
+
+    if cfg.WarnBefore <= 0 || cfg.WarnBefore >= cfg.DormantAfter {
+        return cfg, fmt.Errorf("dormancy.warn_before must be positive and shorter than dormancy.dormant_after")
+    }
+    return cfg, nil
+}
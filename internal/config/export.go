@@ -0,0 +1,26 @@
+package config
+
+import (
+    "goboardapi/internal/service"
+    "goboardapi/internal/storage"
+
+    "github.com/spf13/viper"
+)
+
+// LoadDataExportStorage 내보내기 ZIP 저장소 (정적 경로로 서빙하지 않으므로 baseURL 없음)
+func LoadDataExportStorage() storage.Storage {
+    viper.SetDefault("data_export.dir", "./data/exports")
+
+    return storage.NewLocalStorage(viper.GetString("data_export.dir"), "")
+}
+
+// LoadDataExportConfig data_export 설정 읽기 (없는 항목은 기본값)
+func LoadDataExportConfig() service.DataExportConfig {
+    viper.SetDefault("data_export.download_url", "http://localhost:8080/api/v1/exports/download")
+
+    cfg := service.DefaultDataExportConfig(viper.GetString("data_export.download_url"))
+    if viper.IsSet("data_export.link_ttl") {
+        cfg.LinkTTL = viper.GetDuration("data_export.link_ttl")
+    }
+    return cfg
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+    "fmt"
+
+    "goboardapi/internal/service"
+
+    "github.com/spf13/viper"
+)
+
+// LoadModerationConfig moderation 설정 읽기 (없는 항목은 기본값)
+func LoadModerationConfig() (service.ModerationConfig, error) {
+    cfg := service.DefaultModerationConfig()
+    if viper.IsSet("moderation.auto_hide_threshold") {
+        cfg.AutoHideThreshold = viper.GetInt("moderation.auto_hide_threshold")
+    }
+    if viper.IsSet("moderation.claim_timeout") {
+        cfg.ClaimTimeout = viper.GetDuration("moderation.claim_timeout")
+    }
+
+    if cfg.AutoHideThreshold < 0 {
+        return cfg, fmt.Errorf("moderation.auto_hide_threshold must not be negative")
+    }
+    if cfg.ClaimTimeout <= 0 {
+        return cfg, fmt.Errorf("moderation.claim_timeout must be positive")
+    }
+    return cfg, nil
+}
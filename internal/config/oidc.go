@@ -0,0 +1,39 @@
+package config
+
+import (
+    "fmt"
+    "os"
+    "strings"
+
+    "goboardapi/internal/oidc"
+
+    "github.com/spf13/viper"
+)
+
+// LoadOIDCProviders oidc.providers 설정 읽기
+// client_secret은 설정 파일 대신 OIDC_<NAME>_CLIENT_SECRET 환경 변수로 줄 수 있다.
+func LoadOIDCProviders() ([]oidc.ProviderConfig, error) {
+    var providers []oidc.ProviderConfig
+    if err := viper.UnmarshalKey("oidc.providers", &providers); err != nil {
+        return nil, err
+    }
+
+    seen := make(map[string]bool, len(providers))
+    for i := range providers {
+        p := &providers[i]
+
+        if p.Name == "" || p.Issuer == "" || p.ClientID == "" || p.RedirectURL == "" {
+            return nil, fmt.Errorf("oidc provider #%d: name, issuer, client_id, redirect_url are required", i)
+        }
+        if seen[p.Name] {
+            return nil, fmt.Errorf("oidc provider %q: duplicated name", p.Name)
+        }
+        seen[p.Name] = true
+
+        if secret := os.Getenv("OIDC_" + strings.ToUpper(p.Name) + "_CLIENT_SECRET"); secret != "" {
+            p.ClientSecret = secret
+        }
+    }
+
+    return providers, nil
+}
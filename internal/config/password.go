@@ -0,0 +1,60 @@
+package config
+
+import (
+    "fmt"
+
+    "goboardapi/internal/auth"
+
+    "github.com/spf13/viper"
+    "golang.org/x/crypto/bcrypt"
+)
+
+// LoadPasswordHasher password 설정으로 비밀번호 해셔 생성
+// 새 해시는 argon2id로 만들고, 기존 bcrypt 해시는 그대로 검증한 뒤 로그인 시 교체한다.
+func LoadPasswordHasher() (auth.PasswordHasher, error) {
+    params := auth.DefaultArgon2Params()
+    if viper.IsSet("password.argon2") {
+        if err := viper.UnmarshalKey("password.argon2", &params); err != nil {
+            return nil, err
+        }
+    }
+    if err := params.Validate(); err != nil {
+        return nil, fmt.Errorf("password.argon2: %w", err)
+    }
+
+    bcryptCost := bcrypt.DefaultCost
+    if viper.IsSet("password.bcrypt_cost") {
+        bcryptCost = viper.GetInt("password.bcrypt_cost")
+    }
+
+    return auth.NewMultiHasher(
+        auth.NewArgon2idHasher(params),
+        auth.NewBcryptHasher(bcryptCost),
+    ), nil
+}
+
+// BreachConfig 유출 비밀번호 검사 설정
+type BreachConfig struct {
+    Mode string `mapstructure:"mode"` // off, warn, block
+    File string `mapstructure:"file"` // cmd/breachdb로 만든 파일
+}
+
+// LoadBreachConfig password.breach 설정 읽기 (없으면 off)
+func LoadBreachConfig() (BreachConfig, error) {
+    cfg := BreachConfig{Mode: "off"}
+    if err := viper.UnmarshalKey("password.breach", &cfg); err != nil {
+        return cfg, err
+    }
+
+    switch cfg.Mode {
+    case "off":
+    case "warn", "block":
+        if cfg.File == "" {
+            return cfg, fmt.Errorf("password.breach.file is required for mode %q", cfg.Mode)
+        }
+    default:
+        return cfg, fmt.Errorf("password.breach.mode: unknown mode %q", cfg.Mode)
+    }
+
+    return cfg, nil
+}
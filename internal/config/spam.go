@@ -0,0 +1,46 @@
+package config
+
+import (
+    "fmt"
+
+    "goboardapi/internal/service"
+
+    "github.com/spf13/viper"
+)
+
+// LoadSpamConfig spam 설정 읽기 (없는 항목은 기본값)
+func LoadSpamConfig() (service.SpamConfig, error) {
+    cfg := service.DefaultSpamConfig()
+    if viper.IsSet("spam.hold_threshold") {
+        cfg.HoldThreshold = viper.GetFloat64("spam.hold_threshold")
+    }
+    if viper.IsSet("spam.min_training_samples") {
+        cfg.MinTrainingSamples = viper.GetInt("spam.min_training_samples")
+    }
+    if viper.IsSet("spam.link_density") {
+        cfg.LinkDensity = viper.GetFloat64("spam.link_density")
+    }
+    if viper.IsSet("spam.new_account_age") {
+        cfg.NewAccountAge = viper.GetDuration("spam.new_account_age")
+    }
+    if viper.IsSet("spam.velocity_window") {
+        cfg.VelocityWindow = viper.GetDuration("spam.velocity_window")
+    }
+    if viper.IsSet("spam.velocity_limit") {
+        cfg.VelocityLimit = viper.GetInt("spam.velocity_limit")
+    }
+    if viper.IsSet("spam.duplicate_window") {
+        cfg.DuplicateWindow = viper.GetDuration("spam.duplicate_window")
+    }
+    if viper.IsSet("spam.duplicate_limit") {
+        cfg.DuplicateLimit = viper.GetInt("spam.duplicate_limit")
+    }
+
+    if cfg.HoldThreshold <= 0 || cfg.HoldThreshold > 1 {
+        return cfg, fmt.Errorf("spam.hold_threshold must be in (0, 1]")
+    }
+    if cfg.MinTrainingSamples < 1 {
+        return cfg, fmt.Errorf("spam.min_training_samples must be positive")
+    }
+    return cfg, nil
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+    "goboardapi/internal/service"
+    "goboardapi/internal/storage"
+
+    "github.com/spf13/viper"
+)
+
+// LoadUploadStorage upload 설정으로 파일 저장소 생성
+func LoadUploadStorage() storage.Storage {
+    viper.SetDefault("upload.dir", "./static/uploads")
+    viper.SetDefault("upload.base_url", "/static/uploads")
+
+    return storage.NewLocalStorage(viper.GetString("upload.dir"), viper.GetString("upload.base_url"))
+}
+
+// LoadProfileConfig profile 설정 읽기 (없는 항목은 기본값)
+func LoadProfileConfig() service.ProfileConfig {
+    cfg := service.DefaultProfileConfig()
+    if viper.IsSet("profile.avatar_size") {
+        cfg.AvatarSize = viper.GetInt("profile.avatar_size")
+    }
+    if viper.IsSet("profile.max_avatar_bytes") {
+        cfg.MaxAvatarBytes = viper.GetInt64("profile.max_avatar_bytes")
+    }
+    return cfg
+}
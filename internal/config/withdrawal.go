@@ -0,0 +1,27 @@
+package config
+
+import (
+    "fmt"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/service"
+
+    "github.com/spf13/viper"
+)
+
+// LoadWithdrawalConfig withdrawal 설정 읽기 (없는 항목은 기본값)
+func LoadWithdrawalConfig() (service.WithdrawalConfig, error) {
+    cfg := service.DefaultWithdrawalConfig()
+
+    if viper.IsSet("withdrawal.content_policy") {
+        policy := domain.ContentPolicy(viper.GetString("withdrawal.content_policy"))
+        if !policy.IsValid() {
+            return cfg, fmt.Errorf("withdrawal.content_policy must be anonymize, delete or transfer: %q", policy)
+        }
+        cfg.DefaultContentPolicy = policy
+    }
+    if viper.IsSet("withdrawal.system_username") {
+        cfg.SystemUsername = viper.GetString("withdrawal.system_username")
+    }
+    return cfg, nil
+}
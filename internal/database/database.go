@@ -3,7 +3,42 @@ func Init(cfg *config.DatabaseConfig) (*gorm.DB, error) {
     // ...
 
     // 자동 마이그레이션
-    if err := db.AutoMigrate(&domain.Post{}, &domain.Comment{}); err != nil {
+    if err := db.AutoMigrate(
+        &domain.User{},
+        &domain.Post{},
+        &domain.Comment{},
+        &domain.EmailVerification{},
+        &domain.PasswordReset{},
+        &domain.RefreshToken{},
+        &domain.Session{},
+        &domain.PersonalAccessToken{},
+        &domain.TwoFactor{},
+        &domain.RecoveryCode{},
+        &domain.ExternalIdentity{},
+        &domain.OIDCLoginState{},
+        &domain.Board{},
+        &domain.Follow{},
+        &domain.FeedItem{},
+        &domain.UserBlock{},
+        &domain.Conversation{},
+        &domain.ConversationMember{},
+        &domain.Message{},
+        &domain.DataExport{},
+        &domain.WithdrawLog{},
+        &domain.DormantAccount{},
+        &domain.BatchCheckpoint{},
+        &domain.RoleDefinition{},
+        &domain.PermissionDefinition{},
+        &domain.RolePermission{},
+        &domain.RoleAssignment{},
+        &domain.AuthzDenial{},
+        &domain.TemporaryGrant{},
+        &domain.ModerationCase{},
+        &domain.Report{},
+        &domain.SpamFingerprint{},
+        &domain.BannedWord{},
+        &domain.Sanction{},
+    ); err != nil {
         return nil, err
     }
 
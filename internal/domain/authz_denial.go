@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// AuthzDenial 권한 거부 기록 (RBAC 미들웨어와 auth.Require* 헬퍼가 남긴다)
+type AuthzDenial struct {
+    ID        uint   `gorm:"primaryKey" json:"id"`
+    RequestID string `gorm:"size:64;index" json:"request_id"`
+    UserID    uint   `gorm:"index" json:"user_id"`
+    Role      string `gorm:"size:20" json:"role"`
+    // Check 거부한 확인 (예: RequireAllPermissions, auth.RequireCan)
+    Check string `gorm:"size:50;not null" json:"check"`
+    // Action 확인한 권한 또는 정책 action
+    Action string `gorm:"size:100" json:"action"`
+    // Resource 대상 (예: board:3, post:12), 없으면 빈 문자열
+    Resource  string    `gorm:"size:100" json:"resource"`
+    Reason    string    `gorm:"size:255" json:"reason"`
+    CreatedAt time.Time `gorm:"index" json:"created_at"`
+}
+
+// TableName 테이블 이름 지정
+func (AuthzDenial) TableName() string {
+    return "authz_denials"
+}
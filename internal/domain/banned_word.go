@@ -0,0 +1,23 @@
+package domain
+
+import (
+    "time"
+
+    "goboardapi/internal/wordfilter"
+)
+
+// BannedWord 금칙어 (제목, 본문, 댓글, 사용자 이름에 공통으로 적용)
+// Word는 관리자가 입력한 그대로 저장하고, 비교할 때 wordfilter가 정규화한다.
+type BannedWord struct {
+    ID        uint              `gorm:"primaryKey" json:"id"`
+    Word      string            `gorm:"size:100;not null;uniqueIndex" json:"word"`
+    Action    wordfilter.Action `gorm:"size:10;not null" json:"action"`
+    CreatedBy uint              `gorm:"not null" json:"created_by"`
+    CreatedAt time.Time         `json:"created_at"`
+    UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// TableName 테이블 이름 지정
+func (BannedWord) TableName() string {
+    return "banned_words"
+}
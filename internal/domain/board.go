@@ -0,0 +1,23 @@
+package domain
+
+import (
+    "time"
+
+    "gorm.io/gorm"
+)
+
+// Board 게시판
+type Board struct {
+    ID          uint           `gorm:"primaryKey" json:"id"`
+    Slug        string         `gorm:"size:50;uniqueIndex;not null" json:"slug"`
+    Name        string         `gorm:"size:100;not null" json:"name"`
+    Description string         `gorm:"size:500" json:"description"`
+    CreatedAt   time.Time      `json:"created_at"`
+    UpdatedAt   time.Time      `json:"updated_at"`
+    DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (Board) TableName() string {
+    return "boards"
+}
@@ -1,12 +1,14 @@
 
 type Comment struct {
-    ID        uint           `gorm:"primaryKey" json:"id"`
-    PostID    uint           `gorm:"not null;index" json:"post_id"`
-    ParentID  *uint          `gorm:"index" json:"parent_id,omitempty"`
-    AuthorID  uint           `gorm:"not null;index" json:"author_id"`
-    Author    *User          `gorm:"foreignKey:AuthorID" json:"author,omitempty"`
-    Content   string         `gorm:"type:text;not null" json:"content"`
-    IsDeleted bool           `gorm:"default:false" json:"is_deleted"`
+    ID        uint   `gorm:"primaryKey" json:"id"`
+    PostID    uint   `gorm:"not null;index" json:"post_id"`
+    ParentID  *uint  `gorm:"index" json:"parent_id,omitempty"`
+    AuthorID  uint   `gorm:"not null;index" json:"author_id"`
+    Author    *User  `gorm:"foreignKey:AuthorID" json:"author,omitempty"`
+    Content   string `gorm:"type:text;not null" json:"content"`
+    IsDeleted bool   `gorm:"default:false" json:"is_deleted"`
+    // HiddenAt 신고 누적이나 모더레이터 처리로 숨긴 시각 (목록에서 제외)
+    HiddenAt  *time.Time     `gorm:"index" json:"hidden_at,omitempty"`
     CreatedAt time.Time      `json:"created_at"`
     UpdatedAt time.Time      `json:"updated_at"`
     DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
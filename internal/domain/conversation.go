@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+// Conversation 1:1 또는 소규모 그룹 대화방
+type Conversation struct {
+    ID      uint   `gorm:"primaryKey" json:"id"`
+    IsGroup bool   `gorm:"not null;default:false" json:"is_group"`
+    Title   string `gorm:"size:100" json:"title,omitempty"` // 그룹 대화방 이름
+    // DirectKey 1:1 대화방 중복 생성 방지용 "작은ID:큰ID" (그룹은 NULL)
+    DirectKey     *string    `gorm:"size:41;uniqueIndex" json:"-"`
+    CreatorID     uint       `gorm:"not null" json:"creator_id"`
+    LastMessageAt *time.Time `gorm:"index" json:"last_message_at,omitempty"`
+    CreatedAt     time.Time  `json:"created_at"`
+    UpdatedAt     time.Time  `json:"updated_at"`
+
+    // 연관관계
+    Members []ConversationMember `gorm:"foreignKey:ConversationID" json:"members,omitempty"`
+}
+
+// TableName 테이블 이름 지정
+func (Conversation) TableName() string {
+    return "conversations"
+}
+
+// ConversationMember 대화방 참여자 (읽음 위치 포함)
+type ConversationMember struct {
+    ID             uint `gorm:"primaryKey" json:"id"`
+    ConversationID uint `gorm:"not null;uniqueIndex:idx_conversation_member,priority:1" json:"conversation_id"`
+    UserID         uint `gorm:"not null;uniqueIndex:idx_conversation_member,priority:2;index" json:"user_id"`
+    // LastReadMessageID 이 ID까지 읽음 (읽음 표시와 안 읽은 메시지 수 계산에 사용)
+    LastReadMessageID uint      `gorm:"not null;default:0" json:"last_read_message_id"`
+    JoinedAt          time.Time `gorm:"not null" json:"joined_at"`
+
+    // 연관관계
+    User *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// TableName 테이블 이름 지정
+func (ConversationMember) TableName() string {
+    return "conversation_members"
+}
+
+// Message 대화 메시지
+type Message struct {
+    ID             uint      `gorm:"primaryKey;index:idx_message_conversation,priority:2" json:"id"`
+    ConversationID uint      `gorm:"not null;index:idx_message_conversation,priority:1" json:"conversation_id"`
+    SenderID       uint      `gorm:"not null" json:"sender_id"`
+    Content        string    `gorm:"type:text;not null" json:"content"`
+    CreatedAt      time.Time `json:"created_at"`
+}
+
+// TableName 테이블 이름 지정
+func (Message) TableName() string {
+    return "messages"
+}
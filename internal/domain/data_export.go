@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// DataExportStatus 개인 데이터 내보내기 상태
+type DataExportStatus string
+
+const (
+    DataExportPending    DataExportStatus = "pending"    // 큐 대기
+    DataExportProcessing DataExportStatus = "processing" // 워커가 생성 중
+    DataExportReady      DataExportStatus = "ready"      // 다운로드 가능
+    DataExportFailed     DataExportStatus = "failed"
+    DataExportExpired    DataExportStatus = "expired" // 링크 만료로 파일 삭제됨
+)
+
+// DataExport 개인 데이터 내보내기 요청
+// 다운로드 토큰은 메일로만 전달하고 DB에는 SHA-256 해시만 저장한다.
+type DataExport struct {
+    ID     uint             `gorm:"primaryKey" json:"id"`
+    UserID uint             `gorm:"not null;index" json:"user_id"`
+    Status DataExportStatus `gorm:"size:20;not null;index" json:"status"`
+    // ActiveUserID 대기/생성 중인 동안만 UserID를 담는다 (사용자당 진행 중인 요청 하나를 유니크 인덱스로 보장)
+    ActiveUserID *uint      `gorm:"uniqueIndex" json:"-"`
+    TokenHash    *string    `gorm:"size:64;uniqueIndex" json:"-"`
+    FileKey      string     `gorm:"size:255" json:"-"`
+    FileSize     int64      `json:"file_size,omitempty"`
+    ExpiresAt    *time.Time `json:"expires_at,omitempty"` // 다운로드 링크 만료 시각
+    Error        string     `gorm:"size:255" json:"-"`
+    CreatedAt    time.Time  `json:"created_at"`
+    CompletedAt  *time.Time `json:"completed_at,omitempty"`
+
+    // 연관관계
+    User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (DataExport) TableName() string {
+    return "data_exports"
+}
+
+// IsActive 대기 또는 생성 중인지
+func (e *DataExport) IsActive() bool {
+    return e.Status == DataExportPending || e.Status == DataExportProcessing
+}
+
+// IsDownloadable 다운로드 가능 여부
+func (e *DataExport) IsDownloadable(now time.Time) bool {
+    return e.Status == DataExportReady && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
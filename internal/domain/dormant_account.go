@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// DormantAccount 휴면 계정에서 분리 보관하는 개인 정보
+// 휴면 전환 시 users 행의 이메일/프로필을 이 테이블로 옮기고 users 행은 자리표시 값으로 바꾼다.
+// 배치와 재활성화 외에는 읽지 않는 제한 테이블이다.
+type DormantAccount struct {
+    UserID          uint   `gorm:"primaryKey"`
+    Email           string `gorm:"size:255;uniqueIndex;not null"`
+    DisplayName     string `gorm:"size:50"`
+    Bio             string `gorm:"size:500"`
+    Website         string `gorm:"size:255"`
+    AvatarURL       string `gorm:"size:512"`
+    AvatarKey       string `gorm:"size:255"`
+    EmailVerifiedAt *time.Time
+    // 재활성화 링크 토큰은 SHA-256 해시만 저장한다
+    TokenHash      *string `gorm:"size:64;uniqueIndex"`
+    TokenExpiresAt *time.Time
+    TokenSentAt    *time.Time // 재발송 간격 제한용
+    CreatedAt      time.Time  // 휴면 전환 시각
+}
+
+// TableName 테이블 이름 지정
+func (DormantAccount) TableName() string {
+    return "dormant_accounts"
+}
+
+// BatchCheckpoint 배치 작업 진행 위치
+// 중단된 배치는 다음 실행에서 LastID 이후부터 이어서 처리한다.
+type BatchCheckpoint struct {
+    Name      string `gorm:"primaryKey;size:100"`
+    LastID    uint   `gorm:"not null;default:0"`
+    UpdatedAt time.Time
+}
+
+// TableName 테이블 이름 지정
+func (BatchCheckpoint) TableName() string {
+    return "batch_checkpoints"
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// EmailVerification 이메일 인증 토큰
+// 원본 토큰은 메일로만 전달하고 DB에는 SHA-256 해시만 저장한다.
+type EmailVerification struct {
+    ID        uint       `gorm:"primaryKey" json:"id"`
+    UserID    uint       `gorm:"not null;index" json:"user_id"`
+    TokenHash string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+    ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+    UsedAt    *time.Time `json:"used_at,omitempty"` // 사용 또는 재발송으로 폐기된 시각
+    CreatedAt time.Time  `json:"created_at"`
+
+    // 연관관계
+    User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (EmailVerification) TableName() string {
+    return "email_verifications"
+}
+
+// IsExpired 만료 여부
+func (v *EmailVerification) IsExpired(now time.Time) bool {
+    return now.After(v.ExpiresAt)
+}
+
+// IsUsed 사용(폐기) 여부
+func (v *EmailVerification) IsUsed() bool {
+    return v.UsedAt != nil
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// ExternalIdentity 외부 인증 제공자(OIDC) 계정 연결
+type ExternalIdentity struct {
+    ID        uint      `gorm:"primaryKey" json:"id"`
+    UserID    uint      `gorm:"not null;index" json:"user_id"`
+    Provider  string    `gorm:"size:50;not null;uniqueIndex:idx_provider_subject;uniqueIndex:idx_user_provider" json:"provider"`
+    Subject   string    `gorm:"size:255;not null;uniqueIndex:idx_provider_subject" json:"-"` // 제공자 내 고유 ID (sub)
+    Email     string    `gorm:"size:255" json:"email"`
+    CreatedAt time.Time `json:"created_at"`
+
+    // 연관관계
+    User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (ExternalIdentity) TableName() string {
+    return "external_identities"
+}
+
+// OIDCLoginState 인가 요청과 콜백 사이에 보관하는 로그인 상태 (일회용)
+type OIDCLoginState struct {
+    ID           uint      `gorm:"primaryKey"`
+    StateHash    string    `gorm:"size:64;not null;uniqueIndex"`
+    Provider     string    `gorm:"size:50;not null"`
+    Nonce        string    `gorm:"size:64;not null"`
+    CodeVerifier string    `gorm:"size:128;not null"`
+    LinkUserID   *uint     // 로그인한 사용자가 계정 연결을 요청한 경우
+    ExpiresAt    time.Time `gorm:"not null;index"`
+    CreatedAt    time.Time
+}
+
+// TableName 테이블 이름 지정
+func (OIDCLoginState) TableName() string {
+    return "oidc_login_states"
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// FollowTargetType 팔로우 대상 종류
+type FollowTargetType string
+
+const (
+    FollowTargetUser  FollowTargetType = "user"
+    FollowTargetBoard FollowTargetType = "board"
+)
+
+// Follow 사용자/게시판 팔로우
+type Follow struct {
+    ID         uint             `gorm:"primaryKey" json:"id"`
+    FollowerID uint             `gorm:"not null;uniqueIndex:idx_follow_target,priority:1" json:"follower_id"`
+    TargetType FollowTargetType `gorm:"size:10;not null;uniqueIndex:idx_follow_target,priority:2;index:idx_follow_reverse,priority:1" json:"target_type"`
+    TargetID   uint             `gorm:"not null;uniqueIndex:idx_follow_target,priority:3;index:idx_follow_reverse,priority:2" json:"target_id"`
+    CreatedAt  time.Time        `json:"created_at"`
+}
+
+// TableName 테이블 이름 지정
+func (Follow) TableName() string {
+    return "follows"
+}
+
+// FeedItem 홈 피드 항목 (게시글 작성 시 팔로워별로 미리 넣어 두는 fan-out-on-write)
+// 팔로워가 많은 작성자와 게시판 글은 넣지 않고 조회 시점에 합친다.
+type FeedItem struct {
+    ID        uint      `gorm:"primaryKey" json:"id"`
+    UserID    uint      `gorm:"not null;uniqueIndex:idx_feed_user_post,priority:1;index:idx_feed_user_author,priority:1" json:"user_id"`
+    PostID    uint      `gorm:"not null;uniqueIndex:idx_feed_user_post,priority:2" json:"post_id"`
+    AuthorID  uint      `gorm:"not null;index:idx_feed_user_author,priority:2" json:"author_id"` // 언팔로우 시 정리용
+    CreatedAt time.Time `gorm:"not null;index" json:"created_at"`                                // 게시글 작성 시각
+}
+
+// TableName 테이블 이름 지정
+func (FeedItem) TableName() string {
+    return "feed_items"
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// PasswordReset 비밀번호 재설정 토큰
+// 원본 토큰은 메일로만 전달하고 DB에는 SHA-256 해시만 저장한다.
+type PasswordReset struct {
+    ID        uint       `gorm:"primaryKey" json:"id"`
+    UserID    uint       `gorm:"not null;index" json:"user_id"`
+    TokenHash string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+    ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+    UsedAt    *time.Time `json:"used_at,omitempty"` // 사용 또는 재요청으로 폐기된 시각
+    CreatedAt time.Time  `json:"created_at"`
+
+    // 연관관계
+    User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (PasswordReset) TableName() string {
+    return "password_resets"
+}
+
+// IsExpired 만료 여부
+func (r *PasswordReset) IsExpired(now time.Time) bool {
+    return now.After(r.ExpiresAt)
+}
+
+// IsUsed 사용(폐기) 여부
+func (r *PasswordReset) IsUsed() bool {
+    return r.UsedAt != nil
+}
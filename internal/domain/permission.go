@@ -1,5 +1,7 @@
 package domain
 
+import "context"
+
 // Permission 권한
 type Permission string
 
@@ -21,9 +23,14 @@ const (
     // 사용자 권한
     PermissionUserRead   Permission = "user:read"
     PermissionUserManage Permission = "user:manage"
+
+    // 역할/권한 관리 (DB에 저장된 역할, 권한, 부여 관계 변경)
+    PermissionRoleManage Permission = "role:manage"
 )
 
-// RolePermissions 역할별 권한 매핑
+// RolePermissions 기본 역할별 권한 매핑
+// 실제 권한은 DB(roles, permissions, role_permissions)에서 읽고, 이 매핑은 처음 실행할 때 넣는 시드 데이터다.
+// PermissionSource가 등록되지 않은 환경(테스트, 배치 등)에서는 이 매핑을 그대로 쓴다.
 var RolePermissions = map[Role][]Permission{
     RoleGuest: {
         PermissionPostRead,
@@ -52,21 +59,68 @@ var RolePermissions = map[Role][]Permission{
         PermissionCommentManage,
         PermissionUserRead,
         PermissionUserManage,
+        PermissionRoleManage,
     },
 }
 
-// HasPermission 역할이 특정 권한을 가지고 있는지 확인
-func HasPermission(role Role, permission Permission) bool {
-    permissions, ok := RolePermissions[role]
-    if !ok {
-        return false
-    }
+// PermissionSource 역할의 권한 조회
+type PermissionSource interface {
+    RoleHasPermission(role Role, permission Permission) bool
+    // UserHasPermission 사용자에게 지금 적용 중인 전역 임시 부여(TemporaryGrant)로 권한을 가지는지
+    UserHasPermission(userID uint, permission Permission) bool
+    // UserHasPermissionIn 범위 한정 역할(RoleAssignment)이나 범위 임시 부여로 scope 안에서 권한을 가지는지
+    UserHasPermissionIn(ctx context.Context, userID uint, permission Permission, scope Scope) bool
+}
 
-    for _, p := range permissions {
+// staticPermissions RolePermissions 매핑을 그대로 쓰는 기본 조회
+type staticPermissions struct{}
+
+func (staticPermissions) RoleHasPermission(role Role, permission Permission) bool {
+    for _, p := range RolePermissions[role] {
         if p == permission {
             return true
         }
     }
+    return false
+}
 
+// UserHasPermission 기본 매핑에는 임시 부여가 없다
+func (staticPermissions) UserHasPermission(userID uint, permission Permission) bool {
     return false
 }
+
+// UserHasPermissionIn 기본 매핑에는 범위 한정 역할이 없다
+func (staticPermissions) UserHasPermissionIn(ctx context.Context, userID uint, permission Permission, scope Scope) bool {
+    return false
+}
+
+var permissionSource PermissionSource = staticPermissions{}
+
+// SetPermissionSource 권한 조회 대상 등록 (서버 시작 시 DB 저장소로 교체)
+func SetPermissionSource(source PermissionSource) {
+    if source == nil {
+        source = staticPermissions{}
+    }
+    permissionSource = source
+}
+
+// HasPermission 역할이 특정 권한을 가지고 있는지 확인
+func HasPermission(role Role, permission Permission) bool {
+    return permissionSource.RoleHasPermission(role, permission)
+}
+
+// HasUserPermission 역할 권한 또는 사용자에게 임시로 부여된 전역 권한 확인
+func HasUserPermission(userID uint, role Role, permission Permission) bool {
+    return HasPermission(role, permission) || permissionSource.UserHasPermission(userID, permission)
+}
+
+// HasPermissionIn 전역 권한이 없으면 scope에 부여된 역할 권한 확인
+func HasPermissionIn(ctx context.Context, userID uint, role Role, permission Permission, scope Scope) bool {
+    if HasUserPermission(userID, role, permission) {
+        return true
+    }
+    if scope.IsZero() {
+        return false
+    }
+    return permissionSource.UserHasPermissionIn(ctx, userID, permission, scope)
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+    "strings"
+    "time"
+)
+
+// PersonalAccessTokenPrefix 개인 액세스 토큰 접두사
+// 유출된 토큰을 코드 저장소나 로그에서 검색할 수 있도록 고정 접두사를 붙인다.
+const PersonalAccessTokenPrefix = "gbpat_"
+
+// PersonalAccessToken 스크립트/연동용 개인 액세스 토큰
+// 원본 토큰은 발급 응답에서 한 번만 보여주고 DB에는 SHA-256 해시만 저장한다.
+type PersonalAccessToken struct {
+    ID         uint       `gorm:"primaryKey" json:"id"`
+    UserID     uint       `gorm:"not null;index" json:"user_id"`
+    Name       string     `gorm:"size:100;not null" json:"name"`
+    TokenHash  string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+    TokenHint  string     `gorm:"size:20;not null" json:"token_hint"` // 목록에서 구분용 앞부분 (예: gbpat_Ab3x)
+    Scopes     string     `gorm:"size:1000;not null" json:"-"`        // 공백으로 구분한 권한 목록
+    MFA        bool       `gorm:"not null;default:false" json:"-"`    // 2단계 인증을 거친 세션에서 발급
+    ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`
+    LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+    RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+    CreatedAt  time.Time  `json:"created_at"`
+
+    // 연관관계
+    User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (PersonalAccessToken) TableName() string {
+    return "personal_access_tokens"
+}
+
+// IsActive 폐기되지 않았고 만료 전인 토큰인지 확인
+func (t *PersonalAccessToken) IsActive(now time.Time) bool {
+    return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+// ScopeList 토큰에 부여된 권한 목록
+func (t *PersonalAccessToken) ScopeList() []Permission {
+    fields := strings.Fields(t.Scopes)
+    scopes := make([]Permission, 0, len(fields))
+    for _, f := range fields {
+        scopes = append(scopes, Permission(f))
+    }
+    return scopes
+}
+
+// SetScopes 권한 목록 저장
+func (t *PersonalAccessToken) SetScopes(scopes []Permission) {
+    parts := make([]string, 0, len(scopes))
+    for _, s := range scopes {
+        parts = append(parts, string(s))
+    }
+    t.Scopes = strings.Join(parts, " ")
+}
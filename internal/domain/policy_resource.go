@@ -0,0 +1,60 @@
+package domain
+
+// 정책 엔진(policy.Resource) 구현
+// 조건식에서 resource.<키>로 참조하므로 키 이름을 바꾸면 정책 파일도 함께 고쳐야 한다.
+
+func (p *Post) PolicyType() string { return "post" }
+
+func (p *Post) PolicyAttributes() map[string]any {
+    return map[string]any{
+        "id":         p.ID,
+        "author_id":  p.AuthorID,
+        "board_id":   p.BoardID,
+        "like_count": p.LikeCount,
+        "views":      p.Views,
+        "hidden":     p.HiddenAt != nil,
+        "created_at": p.CreatedAt,
+        "updated_at": p.UpdatedAt,
+    }
+}
+
+// PolicyScope 게시판 모더레이터 권한 확인용 범위
+func (p *Post) PolicyScope() Scope {
+    if p.BoardID == nil {
+        return Scope{}
+    }
+    return BoardScope(*p.BoardID)
+}
+
+func (c *Comment) PolicyType() string { return "comment" }
+
+func (c *Comment) PolicyAttributes() map[string]any {
+    return map[string]any{
+        "id":         c.ID,
+        "post_id":    c.PostID,
+        "parent_id":  c.ParentID,
+        "author_id":  c.AuthorID,
+        "is_deleted": c.IsDeleted,
+        "hidden":     c.HiddenAt != nil,
+        "created_at": c.CreatedAt,
+        "updated_at": c.UpdatedAt,
+    }
+}
+
+// PolicyScope 게시글을 함께 불러온 경우에만 게시판 범위
+func (c *Comment) PolicyScope() Scope {
+    return c.Post.PolicyScope()
+}
+
+func (b *Board) PolicyType() string { return "board" }
+
+func (b *Board) PolicyAttributes() map[string]any {
+    return map[string]any{
+        "id":   b.ID,
+        "slug": b.Slug,
+    }
+}
+
+func (b *Board) PolicyScope() Scope {
+    return BoardScope(b.ID)
+}
@@ -1,12 +1,15 @@
 
 type Post struct {
-    ID        uint           `gorm:"primaryKey" json:"id"`
-    Title     string         `gorm:"size:200;not null" json:"title"`
-    Content   string         `gorm:"type:text" json:"content"`
-    AuthorID  uint           `gorm:"not null;index" json:"author_id"`
-    Author    *User          `gorm:"foreignKey:AuthorID" json:"author,omitempty"`
-    Views     int            `gorm:"default:0" json:"views"`
-    LikeCount int            `gorm:"default:0" json:"like_count"`
+    ID        uint   `gorm:"primaryKey" json:"id"`
+    Title     string `gorm:"size:200;not null" json:"title"`
+    Content   string `gorm:"type:text" json:"content"`
+    AuthorID  uint   `gorm:"not null;index" json:"author_id"`
+    Author    *User  `gorm:"foreignKey:AuthorID" json:"author,omitempty"`
+    BoardID   *uint  `gorm:"index" json:"board_id,omitempty"`
+    Views     int    `gorm:"default:0" json:"views"`
+    LikeCount int    `gorm:"default:0" json:"like_count"`
+    // HiddenAt 신고 누적이나 모더레이터 처리로 숨긴 시각 (목록과 피드에서 제외)
+    HiddenAt  *time.Time     `gorm:"index" json:"hidden_at,omitempty"`
     CreatedAt time.Time      `json:"created_at"`
     UpdatedAt time.Time      `json:"updated_at"`
     DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
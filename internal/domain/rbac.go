@@ -0,0 +1,142 @@
+package domain
+
+import (
+    "fmt"
+    "time"
+)
+
+// RoleDefinition DB에 저장된 역할
+// 사용자의 역할은 users.role에 이름으로 저장하고, 권한은 RolePermission으로 부여한다.
+type RoleDefinition struct {
+    Name        Role      `gorm:"primaryKey;size:20" json:"name"`
+    Description string    `gorm:"size:255" json:"description"`
+    System      bool      `gorm:"not null;default:false" json:"system"` // 기본 역할 (삭제 불가)
+    CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName 테이블 이름 지정
+func (RoleDefinition) TableName() string {
+    return "roles"
+}
+
+// PermissionDefinition DB에 저장된 권한
+type PermissionDefinition struct {
+    Name        Permission `gorm:"primaryKey;size:100" json:"name"`
+    Description string     `gorm:"size:255" json:"description"`
+    CreatedAt   time.Time  `json:"created_at"`
+}
+
+// TableName 테이블 이름 지정
+func (PermissionDefinition) TableName() string {
+    return "permissions"
+}
+
+// RolePermission 역할에 부여된 권한
+type RolePermission struct {
+    Role       Role       `gorm:"primaryKey;size:20" json:"role"`
+    Permission Permission `gorm:"primaryKey;size:100" json:"permission"`
+    CreatedAt  time.Time  `json:"created_at"`
+}
+
+// TableName 테이블 이름 지정
+func (RolePermission) TableName() string {
+    return "role_permissions"
+}
+
+// ScopeType 권한을 제한하는 리소스 종류
+type ScopeType string
+
+const (
+    ScopeBoard ScopeType = "board"
+)
+
+// Scope 권한이 적용되는 리소스 범위 (예: 특정 게시판)
+type Scope struct {
+    Type ScopeType
+    ID   uint
+}
+
+// BoardScope 게시판 범위
+func BoardScope(boardID uint) Scope {
+    return Scope{Type: ScopeBoard, ID: boardID}
+}
+
+// IsZero 범위가 없는지 (게시판에 속하지 않은 글 등)
+func (s Scope) IsZero() bool {
+    return s.Type == "" || s.ID == 0
+}
+
+// String 로그/응답용 표기 (예: board:3, 범위가 없으면 빈 문자열)
+func (s Scope) String() string {
+    if s.IsZero() {
+        return ""
+    }
+    return fmt.Sprintf("%s:%d", s.Type, s.ID)
+}
+
+// RoleAssignment 특정 리소스 범위에서만 유효한 역할 부여 (예: Q&A 게시판 모더레이터)
+// 사용자의 전역 역할(users.role)은 그대로 두고, 범위 안에서는 이 역할의 권한을 추가로 가진다.
+type RoleAssignment struct {
+    ID        uint      `gorm:"primaryKey" json:"id"`
+    UserID    uint      `gorm:"not null;uniqueIndex:idx_role_assignment" json:"user_id"`
+    Role      Role      `gorm:"size:20;not null;uniqueIndex:idx_role_assignment" json:"role"`
+    ScopeType ScopeType `gorm:"size:20;not null;uniqueIndex:idx_role_assignment;index:idx_role_assignment_scope" json:"scope_type"`
+    ScopeID   uint      `gorm:"not null;uniqueIndex:idx_role_assignment;index:idx_role_assignment_scope" json:"scope_id"`
+    GrantedBy uint      `json:"granted_by"`
+    CreatedAt time.Time `json:"created_at"`
+
+    // 연관관계
+    User *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// TableName 테이블 이름 지정
+func (RoleAssignment) TableName() string {
+    return "role_assignments"
+}
+
+// Scope 부여 범위
+func (a *RoleAssignment) Scope() Scope {
+    return Scope{Type: a.ScopeType, ID: a.ScopeID}
+}
+
+// TemporaryGrant 기간을 정해 부여한 역할 또는 권한 (예: 주말 이벤트 모더레이터)
+// Role과 Permission 중 하나만 채운다. 범위가 비어 있으면 전역으로 적용된다.
+// StartsAt 이전과 EndsAt 이후에는 권한 캐시와 관계없이 확인할 때마다 적용되지 않는다.
+type TemporaryGrant struct {
+    ID         uint       `gorm:"primaryKey" json:"id"`
+    UserID     uint       `gorm:"not null;index" json:"user_id"`
+    Role       Role       `gorm:"size:20" json:"role,omitempty"`
+    Permission Permission `gorm:"size:100" json:"permission,omitempty"`
+    ScopeType  ScopeType  `gorm:"size:20" json:"scope_type,omitempty"`
+    ScopeID    uint       `json:"scope_id,omitempty"`
+    Reason     string     `gorm:"size:255;not null" json:"reason"`
+    GrantedBy  uint       `gorm:"not null" json:"granted_by"`
+    StartsAt   time.Time  `gorm:"not null" json:"starts_at"`
+    EndsAt     time.Time  `gorm:"not null;index" json:"ends_at"`
+    // ExpiryNotifiedAt 만료 알림을 보낸 시각 (정리 작업이 채움)
+    ExpiryNotifiedAt *time.Time `json:"-"`
+    CreatedAt        time.Time  `json:"created_at"`
+}
+
+// TableName 테이블 이름 지정
+func (TemporaryGrant) TableName() string {
+    return "temporary_grants"
+}
+
+// Scope 부여 범위 (전역이면 빈 Scope)
+func (g *TemporaryGrant) Scope() Scope {
+    return Scope{Type: g.ScopeType, ID: g.ScopeID}
+}
+
+// ActiveAt t 시점에 적용되는지
+func (g *TemporaryGrant) ActiveAt(t time.Time) bool {
+    return !t.Before(g.StartsAt) && t.Before(g.EndsAt)
+}
+
+// Grants 이 부여로 permission을 가지는지 (역할 부여는 roleHasPermission으로 확인)
+func (g *TemporaryGrant) Grants(permission Permission, roleHasPermission func(Role, Permission) bool) bool {
+    if g.Role != "" {
+        return roleHasPermission(g.Role, permission)
+    }
+    return g.Permission == permission
+}
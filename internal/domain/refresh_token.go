@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// RefreshToken 리프레시 토큰
+// 원본 토큰은 클라이언트만 보관하고 DB에는 SHA-256 해시만 저장한다.
+type RefreshToken struct {
+    ID        uint       `gorm:"primaryKey" json:"id"`
+    UserID    uint       `gorm:"not null;index" json:"user_id"`
+    SessionID uint       `gorm:"not null;index" json:"session_id"`
+    TokenHash string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+    ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+    RevokedAt *time.Time `json:"revoked_at,omitempty"`
+    CreatedAt time.Time  `json:"created_at"`
+
+    // 연관관계
+    User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (RefreshToken) TableName() string {
+    return "refresh_tokens"
+}
+
+// IsActive 사용 가능한 토큰인지 확인
+func (t *RefreshToken) IsActive(now time.Time) bool {
+    return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
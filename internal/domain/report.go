@@ -0,0 +1,117 @@
+package domain
+
+import "time"
+
+// ReportTargetType 신고 대상 종류
+type ReportTargetType string
+
+const (
+    ReportTargetPost    ReportTargetType = "post"
+    ReportTargetComment ReportTargetType = "comment"
+)
+
+// ManagePermission 대상 종류를 처리할 수 있는 관리 권한
+func (t ReportTargetType) ManagePermission() Permission {
+    if t == ReportTargetComment {
+        return PermissionCommentManage
+    }
+    return PermissionPostManage
+}
+
+// ReportReason 신고 사유 분류
+type ReportReason string
+
+const (
+    ReportReasonSpam       ReportReason = "spam"
+    ReportReasonAbuse      ReportReason = "abuse"
+    ReportReasonHarassment ReportReason = "harassment"
+    ReportReasonSexual     ReportReason = "sexual"
+    ReportReasonIllegal    ReportReason = "illegal"
+    ReportReasonOther      ReportReason = "other"
+)
+
+// ModerationStatus 처리 상태
+type ModerationStatus string
+
+const (
+    ModerationOpen      ModerationStatus = "open"      // 처리 대기
+    ModerationClaimed   ModerationStatus = "claimed"   // 모더레이터가 맡음
+    ModerationResolved  ModerationStatus = "resolved"  // 신고 인정 (콘텐츠 숨김)
+    ModerationDismissed ModerationStatus = "dismissed" // 신고 기각 (자동 숨김 해제)
+)
+
+// ModerationSource 처리 건이 만들어진 경로
+type ModerationSource string
+
+const (
+    ModerationSourceReport     ModerationSource = "report"      // 사용자 신고
+    ModerationSourceSpamFilter ModerationSource = "spam_filter" // 작성 단계 스팸 판정으로 보류 (보류 큐)
+    ModerationSourceWordFilter ModerationSource = "word_filter" // 검토 대상 금칙어가 포함됨 (공개 상태로 검토)
+)
+
+// ModerationCase 신고 대상 하나에 대한 처리 건 (모더레이션 큐의 항목)
+// 같은 대상에 대한 신고는 모두 한 건으로 모이고, 기각된 뒤 새 신고가 들어오면 다시 열린다.
+// 스팸 판정으로 보류된 콘텐츠도 숨긴 채로 처리 건이 되어, 기각(승인)하면 공개되고 인정하면 숨김이 유지된다.
+type ModerationCase struct {
+    ID         uint             `gorm:"primaryKey" json:"id"`
+    TargetType ReportTargetType `gorm:"size:20;not null;uniqueIndex:idx_moderation_target" json:"target_type"`
+    TargetID   uint             `gorm:"not null;uniqueIndex:idx_moderation_target" json:"target_id"`
+    // BoardID 게시판 모더레이터 권한 확인용 (게시판 없는 글이면 nil)
+    BoardID  *uint            `gorm:"index" json:"board_id,omitempty"`
+    AuthorID uint             `gorm:"not null;index" json:"author_id"`
+    Status   ModerationStatus `gorm:"size:20;not null;index" json:"status"`
+    Source   ModerationSource `gorm:"size:20;not null;default:report;index" json:"source"`
+    // ReportCount OpenedAt 이후 들어온 신고 수 (자동 숨김 기준)
+    ReportCount int `gorm:"not null;default:0" json:"report_count"`
+    // OpenedAt 처음 신고되었거나 기각 후 다시 열린 시각
+    OpenedAt time.Time `gorm:"not null" json:"opened_at"`
+    // AutoHidden 신고 누적이나 스팸 보류로 자동 숨겼는지 (기각하면 숨김 해제)
+    AutoHidden bool       `gorm:"not null;default:false" json:"auto_hidden"`
+    ClaimedBy  *uint      `json:"claimed_by,omitempty"`
+    ClaimedAt  *time.Time `json:"claimed_at,omitempty"`
+    ResolvedBy *uint      `json:"resolved_by,omitempty"`
+    ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+    // SpamScore, SpamReasons 보류 당시 스팸 판정 결과 (spam_filter 건만)
+    SpamScore   float64 `json:"spam_score,omitempty"`
+    SpamReasons string  `gorm:"size:255" json:"spam_reasons,omitempty"`
+    // FilteredWords 검토 대상으로 걸린 금칙어 (word_filter 건만, 쉼표로 구분)
+    FilteredWords string    `gorm:"size:255" json:"filtered_words,omitempty"`
+    Note          string    `gorm:"size:500" json:"note,omitempty"`
+    CreatedAt     time.Time `json:"created_at"`
+    UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// TableName 테이블 이름 지정
+func (ModerationCase) TableName() string {
+    return "moderation_cases"
+}
+
+// Scope 처리 권한을 확인할 범위
+func (c *ModerationCase) Scope() Scope {
+    if c.BoardID == nil {
+        return Scope{}
+    }
+    return BoardScope(*c.BoardID)
+}
+
+// Closed 처리가 끝났는지
+func (c *ModerationCase) Closed() bool {
+    return c.Status == ModerationResolved || c.Status == ModerationDismissed
+}
+
+// Report 사용자 신고 (신고자와 대상마다 하나)
+type Report struct {
+    ID         uint             `gorm:"primaryKey" json:"id"`
+    CaseID     uint             `gorm:"not null;index" json:"case_id"`
+    ReporterID uint             `gorm:"not null;uniqueIndex:idx_report_reporter_target" json:"reporter_id"`
+    TargetType ReportTargetType `gorm:"size:20;not null;uniqueIndex:idx_report_reporter_target" json:"target_type"`
+    TargetID   uint             `gorm:"not null;uniqueIndex:idx_report_reporter_target" json:"target_id"`
+    Reason     ReportReason     `gorm:"size:20;not null" json:"reason"`
+    Detail     string           `gorm:"size:1000" json:"detail,omitempty"`
+    CreatedAt  time.Time        `json:"created_at"`
+}
+
+// TableName 테이블 이름 지정
+func (Report) TableName() string {
+    return "reports"
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// Session 로그인 세션 (기기별)
+// 리프레시 토큰은 갱신 때마다 바뀌지만 세션은 로그아웃/폐기 전까지 유지된다.
+type Session struct {
+    ID          uint       `gorm:"primaryKey" json:"id"`
+    UserID      uint       `gorm:"not null;index" json:"user_id"`
+    DeviceLabel string     `gorm:"size:100" json:"device_label"`
+    UserAgent   string     `gorm:"size:512" json:"user_agent"`
+    IP          string     `gorm:"size:45" json:"ip"`
+    MFA         bool       `gorm:"not null;default:false" json:"-"` // 2단계 인증을 거친 로그인 (토큰 갱신 시 유지)
+    LastSeenAt  time.Time  `gorm:"not null" json:"last_seen_at"`
+    ExpiresAt   time.Time  `gorm:"not null;index" json:"expires_at"`
+    RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+    CreatedAt   time.Time  `json:"created_at"`
+
+    // 연관관계
+    User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (Session) TableName() string {
+    return "sessions"
+}
+
+// IsActive 폐기되지 않았고 만료 전인 세션인지 확인
+func (s *Session) IsActive(now time.Time) bool {
+    return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// SpamFingerprint 작성된 본문의 지문 (같은 내용을 반복해서 올리는지 확인)
+type SpamFingerprint struct {
+    ID        uint      `gorm:"primaryKey"`
+    Hash      string    `gorm:"size:64;not null;index"`
+    AuthorID  uint      `gorm:"not null;index"`
+    CreatedAt time.Time `gorm:"index"`
+}
+
+// TableName 테이블 이름 지정
+func (SpamFingerprint) TableName() string {
+    return "spam_fingerprints"
+}
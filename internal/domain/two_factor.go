@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// TwoFactor 사용자별 TOTP 2단계 인증 설정
+type TwoFactor struct {
+    ID           uint       `gorm:"primaryKey" json:"id"`
+    UserID       uint       `gorm:"not null;uniqueIndex" json:"user_id"`
+    Secret       string     `gorm:"size:64;not null" json:"-"`
+    EnabledAt    *time.Time `json:"enabled_at,omitempty"` // nil이면 등록 확인 대기 중
+    LastUsedStep int64      `gorm:"default:0" json:"-"`   // 마지막으로 사용된 TOTP 시간 단계 (재사용 방지)
+    CreatedAt    time.Time  `json:"created_at"`
+    UpdatedAt    time.Time  `json:"updated_at"`
+
+    // 연관관계
+    User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (TwoFactor) TableName() string {
+    return "two_factors"
+}
+
+// IsEnabled 등록 확인까지 마쳤는지 여부
+func (t *TwoFactor) IsEnabled() bool {
+    return t.EnabledAt != nil
+}
+
+// RecoveryCode 2단계 인증 복구 코드 (일회용)
+type RecoveryCode struct {
+    ID        uint       `gorm:"primaryKey" json:"id"`
+    UserID    uint       `gorm:"not null;index" json:"user_id"`
+    CodeHash  string     `gorm:"size:64;not null;index" json:"-"`
+    UsedAt    *time.Time `json:"used_at,omitempty"`
+    CreatedAt time.Time  `json:"created_at"`
+}
+
+// TableName 테이블 이름 지정
+func (RecoveryCode) TableName() string {
+    return "recovery_codes"
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+    "time"
+
+    "gorm.io/gorm"
+)
+
+// User 사용자 엔티티
+type User struct {
+    ID                uint           `gorm:"primaryKey" json:"id"`
+    Email             string         `gorm:"size:255;uniqueIndex;not null" json:"email"`
+    Username          string         `gorm:"size:50;uniqueIndex;not null" json:"username"`
+    Password          string         `gorm:"size:255" json:"-"`
+    Role              Role           `gorm:"size:20;default:'user'" json:"role"`
+    DisplayName       string         `gorm:"size:50" json:"display_name,omitempty"`
+    Bio               string         `gorm:"size:500" json:"bio,omitempty"`
+    Website           string         `gorm:"size:255" json:"website,omitempty"`
+    AvatarURL         string         `gorm:"size:512" json:"avatar_url,omitempty"`
+    AvatarKey         string         `gorm:"size:255" json:"-"` // 저장소 키 (교체 시 이전 파일 삭제용)
+    FollowerCount     int64          `gorm:"not null;default:0" json:"follower_count"`
+    FollowingCount    int64          `gorm:"not null;default:0" json:"following_count"` // 사용자 팔로우만 (게시판 제외)
+    EmailVerifiedAt   *time.Time     `json:"email_verified_at,omitempty"`               // nil이면 미인증 (읽기 전용)
+    PasswordChangedAt *time.Time     `json:"-"`                                         // 마지막 비밀번호 변경 시각
+    LastLoginAt       *time.Time     `gorm:"index" json:"last_login_at,omitempty"`
+    DormancyWarnedAt  *time.Time     `json:"-"`              // 휴면 전환 예정 안내 메일 발송 시각
+    DormantAt         *time.Time     `gorm:"index" json:"-"` // nil이 아니면 휴면 계정 (개인 정보는 DormantAccount에 분리)
+    CreatedAt         time.Time      `json:"created_at"`
+    UpdatedAt         time.Time      `json:"updated_at"`
+    DeletedAt         gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (User) TableName() string {
+    return "users"
+}
+
+// IsEmailVerified 이메일 인증 여부
+func (u *User) IsEmailVerified() bool {
+    return u.EmailVerifiedAt != nil
+}
+
+// HasPassword 비밀번호 로그인이 가능한 계정인지 (외부 인증으로만 가입한 계정은 false)
+func (u *User) HasPassword() bool {
+    return u.Password != ""
+}
+
+// IsDormant 휴면 계정 여부 (재활성화 전까지 로그인 불가)
+func (u *User) IsDormant() bool {
+    return u.DormantAt != nil
+}
+
+// LastActiveAt 휴면 판단 기준 시각 (로그인한 적 없으면 가입 시각)
+func (u *User) LastActiveAt() time.Time {
+    if u.LastLoginAt != nil {
+        return *u.LastLoginAt
+    }
+    return u.CreatedAt
+}
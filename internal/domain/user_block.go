@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// BlockKind 차단 종류
+type BlockKind string
+
+const (
+    // BlockKindBlock 차단: 서로의 콘텐츠가 보이지 않고 댓글/답글/멘션을 주고받을 수 없다
+    BlockKindBlock BlockKind = "block"
+    // BlockKindMute 뮤트: 내 화면에서만 상대 콘텐츠를 숨긴다 (상대는 알 수 없음)
+    BlockKindMute BlockKind = "mute"
+)
+
+// UserBlock 사용자 차단/뮤트 (사용자 쌍마다 하나, 차단이 뮤트보다 우선)
+type UserBlock struct {
+    ID        uint      `gorm:"primaryKey" json:"id"`
+    BlockerID uint      `gorm:"not null;uniqueIndex:idx_user_block_pair,priority:1" json:"blocker_id"`
+    BlockedID uint      `gorm:"not null;uniqueIndex:idx_user_block_pair,priority:2;index" json:"blocked_id"`
+    Kind      BlockKind `gorm:"size:10;not null" json:"kind"`
+    CreatedAt time.Time `json:"created_at"`
+
+    // 연관관계
+    Blocked User `gorm:"foreignKey:BlockedID" json:"-"`
+}
+
+// TableName 테이블 이름 지정
+func (UserBlock) TableName() string {
+    return "user_blocks"
+}
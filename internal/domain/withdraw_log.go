@@ -2,13 +2,39 @@ package domain
 
 import "time"
 
+// WithdrawRestoreWindow 탈퇴 후 계정을 복구할 수 있는 기간 (지나면 영구 삭제)
+const WithdrawRestoreWindow = 30 * 24 * time.Hour
+
+// ContentPolicy 탈퇴한 사용자가 남긴 게시글/댓글 처리 방식
+type ContentPolicy string
+
+const (
+    ContentAnonymize ContentPolicy = "anonymize" // 내용은 남기고 작성자를 "탈퇴한 사용자"로 표시
+    ContentDelete    ContentPolicy = "delete"    // 게시글/댓글/좋아요/메시지 삭제
+    ContentTransfer  ContentPolicy = "transfer"  // 시스템 계정으로 작성자 변경
+)
+
+// IsValid 지원하는 처리 방식인지
+func (p ContentPolicy) IsValid() bool {
+    return p == ContentAnonymize || p == ContentDelete || p == ContentTransfer
+}
+
 // WithdrawLog 탈퇴 로그
+// 복구 기간 동안에는 계정을 소프트 삭제 상태로 두고, PurgeAfter가 지나면 ContentPolicy를 적용한 뒤
+// 개인 정보를 지운다 (Email도 이때 비운다).
 type WithdrawLog struct {
-    ID        uint      `gorm:"primaryKey" json:"id"`
-    UserID    uint      `gorm:"not null" json:"user_id"`
-    Email     string    `gorm:"size:255" json:"email"`
-    Reason    string    `gorm:"type:text" json:"reason"`
-    CreatedAt time.Time `json:"created_at"`
+    ID            uint          `gorm:"primaryKey" json:"id"`
+    UserID        uint          `gorm:"not null;index" json:"user_id"`
+    Email         string        `gorm:"size:255" json:"email"`
+    Reason        string        `gorm:"type:text" json:"reason"`
+    ContentPolicy ContentPolicy `gorm:"size:20;not null;default:'anonymize'" json:"content_policy"`
+    PurgeAfter    time.Time     `gorm:"index" json:"purge_after"`
+    PurgedAt      *time.Time    `json:"purged_at,omitempty"`
+    RestoredAt    *time.Time    `json:"restored_at,omitempty"` // 복구 기간 안에 계정을 되살린 경우
+    // PurgeAttempts 영구 삭제 실패 횟수 (계속 실패하는 기록이 배치를 차지하지 않도록 적은 순으로 처리)
+    PurgeAttempts      int        `gorm:"not null;default:0" json:"-"`
+    LastPurgeAttemptAt *time.Time `json:"-"`
+    CreatedAt          time.Time  `json:"created_at"`
 }
 
 func (WithdrawLog) TableName() string {
@@ -11,7 +11,7 @@ type AdminStats struct {
 
 // ChangeRoleRequest 역할 변경 요청
 type ChangeRoleRequest struct {
-    Role string `json:"role" binding:"required,oneof=user admin"`
+    Role string `json:"role" binding:"required,max=20"` // roles 테이블에 있는 역할
 }
 
 // UserListResponse 사용자 목록 응답
@@ -0,0 +1,224 @@
+package dto
+
+import (
+    "time"
+
+    "goboardapi/internal/domain"
+)
+
+// SignupRequest 회원가입 요청
+type SignupRequest struct {
+    Email    string `json:"email" binding:"required,email,max=254" example:"user@example.com"`
+    Username string `json:"username" binding:"required,min=2,max=50" example:"gopher"`
+    Password string `json:"password" binding:"required,password" example:"Passw0rd!"`
+}
+
+// UserResponse 사용자 응답
+type UserResponse struct {
+    ID            uint      `json:"id"`
+    Email         string    `json:"email"`
+    Username      string    `json:"username"`
+    Role          string    `json:"role"`
+    EmailVerified bool      `json:"email_verified"`
+    DisplayName   string    `json:"display_name,omitempty"`
+    Bio           string    `json:"bio,omitempty"`
+    Website       string    `json:"website,omitempty"`
+    AvatarURL     string    `json:"avatar_url,omitempty"`
+    Followers     int64     `json:"follower_count"`
+    Following     int64     `json:"following_count"`
+    CreatedAt     time.Time `json:"created_at"`
+}
+
+func ToUserResponse(user *domain.User) *UserResponse {
+    return &UserResponse{
+        ID:            user.ID,
+        Email:         user.Email,
+        Username:      user.Username,
+        Role:          string(user.Role),
+        EmailVerified: user.IsEmailVerified(),
+        DisplayName:   user.DisplayName,
+        Bio:           user.Bio,
+        Website:       user.Website,
+        AvatarURL:     user.AvatarURL,
+        Followers:     user.FollowerCount,
+        Following:     user.FollowingCount,
+        CreatedAt:     user.CreatedAt,
+    }
+}
+
+// SignupResponse 회원가입 응답
+type SignupResponse struct {
+    *UserResponse
+    Warnings []string `json:"warnings,omitempty"` // 유출 비밀번호 warn 모드 경고 등
+}
+
+// ChangePasswordRequest 비밀번호 변경 요청
+// 외부 로그인으로만 가입해 비밀번호가 없는 계정은 current_password 없이 설정할 수 있다.
+type ChangePasswordRequest struct {
+    CurrentPassword string `json:"current_password" example:"Passw0rd!"`
+    NewPassword     string `json:"new_password" binding:"required,password" example:"N3wPassw0rd!"`
+}
+
+// PasswordResetRequest 비밀번호 재설정 메일 요청
+type PasswordResetRequest struct {
+    Email string `json:"email" binding:"required,email" example:"user@example.com"`
+}
+
+// ResetPasswordRequest 비밀번호 재설정 요청
+type ResetPasswordRequest struct {
+    Token       string `json:"token" binding:"required"`
+    NewPassword string `json:"new_password" binding:"required,password" example:"N3wPassw0rd!"`
+}
+
+// DormantReactivationRequest 휴면 계정 재활성화 메일 요청
+type DormantReactivationRequest struct {
+    Email string `json:"email" binding:"required,email" example:"user@example.com"`
+}
+
+// ReactivateDormantRequest 휴면 계정 재활성화
+type ReactivateDormantRequest struct {
+    Token string `json:"token" binding:"required"`
+}
+
+// LoginRequest 로그인 요청
+type LoginRequest struct {
+    Email    string `json:"email" binding:"required,email" example:"user@example.com"`
+    Password string `json:"password" binding:"required" example:"Passw0rd!"`
+}
+
+// LoginTwoFactorRequest 2단계 인증 로그인 요청
+type LoginTwoFactorRequest struct {
+    ChallengeToken string `json:"challenge_token" binding:"required"`
+    Code           string `json:"code" binding:"required" example:"123456"` // TOTP 코드 또는 복구 코드
+}
+
+// TokenResponse 로그인 응답
+// 2단계 인증이 필요하면 토큰 대신 challenge_token만 채워진다.
+type TokenResponse struct {
+    AccessToken            string `json:"access_token,omitempty"`
+    RefreshToken           string `json:"refresh_token,omitempty"`
+    TokenType              string `json:"token_type,omitempty" example:"Bearer"`
+    ExpiresIn              int    `json:"expires_in,omitempty" example:"900"`
+    TwoFactorRequired      bool   `json:"two_factor_required,omitempty"`
+    ChallengeToken         string `json:"challenge_token,omitempty"`
+    TwoFactorSetupRequired bool   `json:"two_factor_setup_required,omitempty"` // 관리자 2단계 인증 의무화 시 미등록 안내
+}
+
+// TwoFactorSetupResponse 2단계 인증 등록 정보
+type TwoFactorSetupResponse struct {
+    Secret     string `json:"secret" example:"JBSWY3DPEHPK3PXP"`
+    OTPAuthURI string `json:"otpauth_uri" example:"otpauth://totp/Go%20Board:user@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Go+Board"`
+}
+
+// TwoFactorCodeRequest 2단계 인증 코드 확인 요청
+type TwoFactorCodeRequest struct {
+    Code string `json:"code" binding:"required" example:"123456"`
+}
+
+// DisableTwoFactorRequest 2단계 인증 해제 요청
+type DisableTwoFactorRequest struct {
+    Password string `json:"password" binding:"required"`
+    Code     string `json:"code" binding:"required"` // TOTP 코드 또는 복구 코드
+}
+
+// RecoveryCodesResponse 복구 코드 (발급 시 한 번만 노출)
+type RecoveryCodesResponse struct {
+    RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// SocialLoginResponse 외부 로그인 콜백 응답
+// 계정 연결 요청이었다면 linked만 true이고 토큰은 발급하지 않는다.
+type SocialLoginResponse struct {
+    Provider string `json:"provider" example:"google"`
+    Linked   bool   `json:"linked,omitempty"`
+    *TokenResponse
+}
+
+// ExternalIdentityResponse 연결된 외부 계정
+type ExternalIdentityResponse struct {
+    Provider  string    `json:"provider" example:"google"`
+    Email     string    `json:"email"`
+    CreatedAt time.Time `json:"created_at"`
+}
+
+// AuthURLResponse 외부 인증 페이지 주소
+type AuthURLResponse struct {
+    AuthURL string `json:"auth_url"`
+}
+
+func ToExternalIdentityResponse(identity *domain.ExternalIdentity) ExternalIdentityResponse {
+    return ExternalIdentityResponse{
+        Provider:  identity.Provider,
+        Email:     identity.Email,
+        CreatedAt: identity.CreatedAt,
+    }
+}
+
+// RefreshRequest 토큰 갱신 요청
+type RefreshRequest struct {
+    RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// SessionResponse 로그인 세션 (기기)
+type SessionResponse struct {
+    ID          uint      `json:"id"`
+    DeviceLabel string    `json:"device_label" example:"Chrome on Windows"`
+    UserAgent   string    `json:"user_agent"`
+    IP          string    `json:"ip" example:"203.0.113.7"`
+    CreatedAt   time.Time `json:"created_at"`
+    LastSeenAt  time.Time `json:"last_seen_at"`
+    Current     bool      `json:"current"` // 이 요청을 보낸 세션인지
+}
+
+func ToSessionResponse(session *domain.Session, currentSessionID uint) SessionResponse {
+    return SessionResponse{
+        ID:          session.ID,
+        DeviceLabel: session.DeviceLabel,
+        UserAgent:   session.UserAgent,
+        IP:          session.IP,
+        CreatedAt:   session.CreatedAt,
+        LastSeenAt:  session.LastSeenAt,
+        Current:     session.ID == currentSessionID,
+    }
+}
+
+// CreatePersonalAccessTokenRequest 개인 액세스 토큰 발급 요청
+type CreatePersonalAccessTokenRequest struct {
+    Name          string   `json:"name" binding:"required,max=100" example:"CI 배포 스크립트"`
+    Scopes        []string `json:"scopes" binding:"required,min=1,dive,required" example:"post:read,post:create"`
+    ExpiresInDays int      `json:"expires_in_days" binding:"omitempty,min=1" example:"30"` // 생략하면 30일
+}
+
+// PersonalAccessTokenResponse 개인 액세스 토큰 정보 (원본 토큰 제외)
+type PersonalAccessTokenResponse struct {
+    ID         uint       `json:"id"`
+    Name       string     `json:"name"`
+    TokenHint  string     `json:"token_hint" example:"gbpat_Ab3x"`
+    Scopes     []string   `json:"scopes"`
+    ExpiresAt  time.Time  `json:"expires_at"`
+    LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+    CreatedAt  time.Time  `json:"created_at"`
+}
+
+// PersonalAccessTokenCreatedResponse 발급 응답 (token은 다시 조회할 수 없음)
+type PersonalAccessTokenCreatedResponse struct {
+    PersonalAccessTokenResponse
+    Token string `json:"token" example:"gbpat_Ab3x..."`
+}
+
+func ToPersonalAccessTokenResponse(token *domain.PersonalAccessToken) PersonalAccessTokenResponse {
+    scopes := make([]string, 0)
+    for _, s := range token.ScopeList() {
+        scopes = append(scopes, string(s))
+    }
+
+    return PersonalAccessTokenResponse{
+        ID:         token.ID,
+        Name:       token.Name,
+        TokenHint:  token.TokenHint,
+        Scopes:     scopes,
+        ExpiresAt:  token.ExpiresAt,
+        LastUsedAt: token.LastUsedAt,
+        CreatedAt:  token.CreatedAt,
+    }
+}
@@ -0,0 +1,77 @@
+package dto
+
+import (
+    "time"
+
+    "goboardapi/internal/domain"
+)
+
+// AuthzExplainRequest 권한 판단 설명 요청
+type AuthzExplainRequest struct {
+    UserID uint `json:"user_id" binding:"required" example:"42"`
+    // Action 권한 이름 또는 정책 action (예: post:update)
+    Action       string `json:"action" binding:"required,max=100" example:"post:update"`
+    ResourceType string `json:"resource_type" binding:"omitempty,oneof=post board" example:"post"`
+    ResourceID   uint   `json:"resource_id" binding:"required_with=ResourceType" example:"12"`
+}
+
+// AuthzRuleResult 평가한 규칙 하나
+type AuthzRuleResult struct {
+    // Source role(전역 역할), scoped_role(범위 한정 역할), temporary_grant(기간 한정 부여), policy(정책 엔진)
+    Source string `json:"source"`
+    Rule   string `json:"rule"`
+    Effect string `json:"effect,omitempty"`
+    // Result granted, not_granted (역할) 또는 applied, not_applied, not_matched, error (정책)
+    Result string `json:"result"`
+    Detail string `json:"detail,omitempty"`
+}
+
+// AuthzExplainResponse 권한 판단 설명
+type AuthzExplainResponse struct {
+    UserID   uint   `json:"user_id"`
+    Role     string `json:"role"`
+    Action   string `json:"action"`
+    Resource string `json:"resource,omitempty"`
+    // PermissionAllowed 역할 권한 확인 결과 (RequirePermission, RBAC 미들웨어)
+    PermissionAllowed bool `json:"permission_allowed"`
+    // PolicyAllowed 정책 엔진 결과 (auth.Checker.Can)
+    PolicyAllowed  bool              `json:"policy_allowed"`
+    PolicyDecision string            `json:"policy_decision"`
+    Rules          []AuthzRuleResult `json:"rules"`
+}
+
+// AuthzDenialQuery 권한 거부 기록 조회 조건
+type AuthzDenialQuery struct {
+    UserID    uint      `form:"user_id"`
+    RequestID string    `form:"request_id"`
+    Since     time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+    Before    uint      `form:"before"`
+    Size      int       `form:"size" binding:"omitempty,min=1,max=100"`
+}
+
+// AuthzDenialResponse 권한 거부 기록
+type AuthzDenialResponse struct {
+    ID        uint      `json:"id"`
+    RequestID string    `json:"request_id"`
+    UserID    uint      `json:"user_id"`
+    Role      string    `json:"role"`
+    Check     string    `json:"check"`
+    Action    string    `json:"action"`
+    Resource  string    `json:"resource,omitempty"`
+    Reason    string    `json:"reason"`
+    CreatedAt time.Time `json:"created_at"`
+}
+
+func ToAuthzDenialResponse(denial *domain.AuthzDenial) *AuthzDenialResponse {
+    return &AuthzDenialResponse{
+        ID:        denial.ID,
+        RequestID: denial.RequestID,
+        UserID:    denial.UserID,
+        Role:      denial.Role,
+        Check:     denial.Check,
+        Action:    denial.Action,
+        Resource:  denial.Resource,
+        Reason:    denial.Reason,
+        CreatedAt: denial.CreatedAt,
+    }
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/wordfilter"
+)
+
+// BannedWordRequest 금칙어 등록/수정 요청
+type BannedWordRequest struct {
+    Word   string            `json:"word" binding:"required,max=100" example:"바보"`
+    Action wordfilter.Action `json:"action" binding:"required,oneof=mask reject flag allow" example:"mask"`
+}
+
+// BannedWordResponse 금칙어
+type BannedWordResponse struct {
+    ID         uint              `json:"id"`
+    Word       string            `json:"word"`
+    Normalized string            `json:"normalized"` // 비교에 쓰는 형태 (자모 분해, 공백/기호 제거)
+    Action     wordfilter.Action `json:"action"`
+    CreatedBy  uint              `json:"created_by"`
+    CreatedAt  time.Time         `json:"created_at"`
+    UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+func ToBannedWordResponse(word *domain.BannedWord) *BannedWordResponse {
+    return &BannedWordResponse{
+        ID:         word.ID,
+        Word:       word.Word,
+        Normalized: wordfilter.Normalize(word.Word),
+        Action:     word.Action,
+        CreatedBy:  word.CreatedBy,
+        CreatedAt:  word.CreatedAt,
+        UpdatedAt:  word.UpdatedAt,
+    }
+}
+
+// WordFilterHit 금칙어 검사에 걸린 부분 (거부 응답의 ErrorResponse.Details)
+type WordFilterHit struct {
+    Field  string            `json:"field" example:"content"`
+    Word   string            `json:"word" example:"바보"`
+    Action wordfilter.Action `json:"action" example:"reject"`
+    Text   string            `json:"text" example:"바 보"` // 원문에서 실제로 걸린 부분
+    Start  int               `json:"start"`              // 원문 바이트 위치
+    End    int               `json:"end"`
+}
@@ -30,6 +30,12 @@ func ToCommentResponse(comment *domain.Comment) *CommentResponse {
                 ID:       comment.Author.ID,
                 Username: comment.Author.Username,
             }
+        } else {
+            // 탈퇴한 사용자 처리 (ToPostResponse와 같은 표시)
+            resp.Author = &AuthorInfo{
+                ID:       0,
+                Username: "탈퇴한 사용자",
+            }
         }
     }
 
@@ -0,0 +1,28 @@
+package dto
+
+import (
+    "time"
+
+    "goboardapi/internal/domain"
+)
+
+// DataExportResponse 개인 데이터 내보내기 요청 상태
+type DataExportResponse struct {
+    ID          uint                    `json:"id"`
+    Status      domain.DataExportStatus `json:"status"`
+    FileSize    int64                   `json:"file_size,omitempty"`
+    ExpiresAt   *time.Time              `json:"expires_at,omitempty"`
+    CreatedAt   time.Time               `json:"created_at"`
+    CompletedAt *time.Time              `json:"completed_at,omitempty"`
+}
+
+func ToDataExportResponse(export *domain.DataExport) *DataExportResponse {
+    return &DataExportResponse{
+        ID:          export.ID,
+        Status:      export.Status,
+        FileSize:    export.FileSize,
+        ExpiresAt:   export.ExpiresAt,
+        CreatedAt:   export.CreatedAt,
+        CompletedAt: export.CompletedAt,
+    }
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "goboardapi/internal/domain"
+
+// UserSummary 목록용 사용자 요약
+type UserSummary struct {
+    ID          uint   `json:"id"`
+    Username    string `json:"username"`
+    DisplayName string `json:"display_name,omitempty"`
+    AvatarURL   string `json:"avatar_url,omitempty"`
+}
+
+func ToUserSummary(user *domain.User) UserSummary {
+    return UserSummary{
+        ID:          user.ID,
+        Username:    user.Username,
+        DisplayName: user.DisplayName,
+        AvatarURL:   user.AvatarURL,
+    }
+}
+
+// FeedResponse 홈 피드 응답
+type FeedResponse struct {
+    Posts []*PostResponse `json:"posts"`
+    Meta  CursorMeta      `json:"meta"`
+}
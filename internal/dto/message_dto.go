@@ -0,0 +1,87 @@
+package dto
+
+import (
+    "time"
+
+    "goboardapi/internal/domain"
+)
+
+// CreateConversationRequest 대화방 생성 요청 (상대가 한 명이면 1:1 대화방)
+type CreateConversationRequest struct {
+    Usernames []string `json:"usernames" binding:"required,min=1"`
+    Title     string   `json:"title" binding:"max=100"`
+}
+
+// SendMessageRequest 메시지 전송 요청 (WebSocket dm_send의 data도 같은 형식)
+type SendMessageRequest struct {
+    ConversationID uint   `json:"conversation_id,omitempty"`
+    Content        string `json:"content" binding:"required"`
+}
+
+// MarkReadRequest 읽음 처리 요청 (WebSocket dm_read의 data도 같은 형식)
+type MarkReadRequest struct {
+    ConversationID uint `json:"conversation_id,omitempty"`
+    MessageID      uint `json:"message_id" binding:"required"`
+}
+
+// ConversationResponse 대화방 응답
+type ConversationResponse struct {
+    ID            uint          `json:"id"`
+    IsGroup       bool          `json:"is_group"`
+    Title         string        `json:"title,omitempty"`
+    Members       []UserSummary `json:"members"`
+    Unread        int64         `json:"unread"`
+    LastMessageAt *time.Time    `json:"last_message_at,omitempty"`
+    CreatedAt     time.Time     `json:"created_at"`
+}
+
+func ToConversationResponse(conversation *domain.Conversation, unread int64) *ConversationResponse {
+    members := make([]UserSummary, 0, len(conversation.Members))
+    for _, member := range conversation.Members {
+        if member.User != nil {
+            members = append(members, ToUserSummary(member.User))
+        }
+    }
+
+    return &ConversationResponse{
+        ID:            conversation.ID,
+        IsGroup:       conversation.IsGroup,
+        Title:         conversation.Title,
+        Members:       members,
+        Unread:        unread,
+        LastMessageAt: conversation.LastMessageAt,
+        CreatedAt:     conversation.CreatedAt,
+    }
+}
+
+// MessageResponse 메시지 응답
+type MessageResponse struct {
+    ID             uint      `json:"id"`
+    ConversationID uint      `json:"conversation_id"`
+    SenderID       uint      `json:"sender_id"`
+    Content        string    `json:"content"`
+    CreatedAt      time.Time `json:"created_at"`
+}
+
+func ToMessageResponse(message *domain.Message) *MessageResponse {
+    return &MessageResponse{
+        ID:             message.ID,
+        ConversationID: message.ConversationID,
+        SenderID:       message.SenderID,
+        Content:        message.Content,
+        CreatedAt:      message.CreatedAt,
+    }
+}
+
+// ReadReceipt 읽음 표시 (다른 참여자에게 실시간 전달)
+type ReadReceipt struct {
+    ConversationID uint `json:"conversation_id"`
+    UserID         uint `json:"user_id"`
+    MessageID      uint `json:"message_id"`
+}
+
+// UnreadResponse 전체 안 읽은 메시지 수
+type UnreadResponse struct {
+    Total          int64          `json:"total"`
+    ByConversation map[uint]int64 `json:"by_conversation"`
+}
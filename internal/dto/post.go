@@ -54,6 +54,6 @@ type ErrorResponse struct {
     Error string `json:"error" example:"게시글을 찾을 수 없습니다"`
     // 에러 코드
     Code string `json:"code,omitempty" example:"POST_NOT_FOUND"`
-    // 상세 정보
-    Details string `json:"details,omitempty" example:"요청한 ID: 999"`
+    // 상세 정보 (문자열, 또는 금칙어 검사에 걸린 부분 같은 항목 목록)
+    Details interface{} `json:"details,omitempty" example:"요청한 ID: 999"`
 }
@@ -0,0 +1,129 @@
+package dto
+
+import (
+    "time"
+
+    "goboardapi/internal/domain"
+)
+
+// RoleResponse 역할과 부여된 권한
+type RoleResponse struct {
+    Name        domain.Role         `json:"name"`
+    Description string              `json:"description"`
+    System      bool                `json:"system"`
+    Permissions []domain.Permission `json:"permissions"`
+    CreatedAt   time.Time           `json:"created_at"`
+}
+
+func ToRoleResponse(role *domain.RoleDefinition, permissions []domain.Permission) *RoleResponse {
+    if permissions == nil {
+        permissions = []domain.Permission{}
+    }
+    return &RoleResponse{
+        Name:        role.Name,
+        Description: role.Description,
+        System:      role.System,
+        Permissions: permissions,
+        CreatedAt:   role.CreatedAt,
+    }
+}
+
+// PermissionResponse 권한
+type PermissionResponse struct {
+    Name        domain.Permission `json:"name"`
+    Description string            `json:"description"`
+    CreatedAt   time.Time         `json:"created_at"`
+}
+
+func ToPermissionResponse(permission *domain.PermissionDefinition) *PermissionResponse {
+    return &PermissionResponse{
+        Name:        permission.Name,
+        Description: permission.Description,
+        CreatedAt:   permission.CreatedAt,
+    }
+}
+
+// CreateRoleRequest 역할 생성 요청
+type CreateRoleRequest struct {
+    Name        string `json:"name" binding:"required,max=20" example:"moderator"`
+    Description string `json:"description" binding:"max=255" example:"게시판 관리자"`
+}
+
+// CreatePermissionRequest 권한 생성 요청
+type CreatePermissionRequest struct {
+    Name        string `json:"name" binding:"required,max=100" example:"report:review"`
+    Description string `json:"description" binding:"max=255"`
+}
+
+// RoleAssignmentResponse 범위 한정 역할 부여
+type RoleAssignmentResponse struct {
+    ID        uint         `json:"id"`
+    User      *UserSummary `json:"user,omitempty"`
+    Role      domain.Role  `json:"role"`
+    GrantedBy uint         `json:"granted_by"`
+    CreatedAt time.Time    `json:"created_at"`
+}
+
+func ToRoleAssignmentResponse(assignment *domain.RoleAssignment) *RoleAssignmentResponse {
+    resp := &RoleAssignmentResponse{
+        ID:        assignment.ID,
+        Role:      assignment.Role,
+        GrantedBy: assignment.GrantedBy,
+        CreatedAt: assignment.CreatedAt,
+    }
+    if assignment.User != nil {
+        user := ToUserSummary(assignment.User)
+        resp.User = &user
+    }
+    return resp
+}
+
+// AssignBoardRoleRequest 게시판 역할 부여 요청
+type AssignBoardRoleRequest struct {
+    UserID uint   `json:"user_id" binding:"required" example:"42"`
+    Role   string `json:"role" binding:"required,max=20" example:"moderator"`
+}
+
+// TemporaryGrantRequest 기간 한정 역할/권한 부여 요청 (role과 permission 중 하나만)
+type TemporaryGrantRequest struct {
+    UserID     uint   `json:"user_id" binding:"required" example:"42"`
+    Role       string `json:"role" binding:"omitempty,max=20" example:"moderator"`
+    Permission string `json:"permission" binding:"omitempty,max=100"`
+    // BoardID 0이면 전역으로 부여
+    BoardID uint   `json:"board_id" example:"3"`
+    Reason  string `json:"reason" binding:"required,max=255" example:"주말 이벤트 진행"`
+    // StartsAt 없으면 바로 시작
+    StartsAt *time.Time `json:"starts_at"`
+    EndsAt   time.Time  `json:"ends_at" binding:"required"`
+}
+
+// TemporaryGrantResponse 기간 한정 부여
+type TemporaryGrantResponse struct {
+    ID         uint              `json:"id"`
+    UserID     uint              `json:"user_id"`
+    Role       domain.Role       `json:"role,omitempty"`
+    Permission domain.Permission `json:"permission,omitempty"`
+    Scope      string            `json:"scope,omitempty"`
+    Reason     string            `json:"reason"`
+    GrantedBy  uint              `json:"granted_by"`
+    StartsAt   time.Time         `json:"starts_at"`
+    EndsAt     time.Time         `json:"ends_at"`
+    Active     bool              `json:"active"`
+    CreatedAt  time.Time         `json:"created_at"`
+}
+
+func ToTemporaryGrantResponse(grant *domain.TemporaryGrant, now time.Time) *TemporaryGrantResponse {
+    return &TemporaryGrantResponse{
+        ID:         grant.ID,
+        UserID:     grant.UserID,
+        Role:       grant.Role,
+        Permission: grant.Permission,
+        Scope:      grant.Scope().String(),
+        Reason:     grant.Reason,
+        GrantedBy:  grant.GrantedBy,
+        StartsAt:   grant.StartsAt,
+        EndsAt:     grant.EndsAt,
+        Active:     grant.ActiveAt(now),
+        CreatedAt:  grant.CreatedAt,
+    }
+}
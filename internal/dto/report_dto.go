@@ -0,0 +1,103 @@
+package dto
+
+import (
+    "time"
+
+    "goboardapi/internal/domain"
+)
+
+// CreateReportRequest 게시글/댓글 신고
+type CreateReportRequest struct {
+    TargetType string `json:"target_type" binding:"required,oneof=post comment" example:"comment"`
+    TargetID   uint   `json:"target_id" binding:"required" example:"12"`
+    Reason     string `json:"reason" binding:"required,oneof=spam abuse harassment sexual illegal other" example:"abuse"`
+    Detail     string `json:"detail" binding:"max=1000" example:"욕설이 포함되어 있습니다"`
+}
+
+// ReportResponse 신고
+type ReportResponse struct {
+    ID         uint                    `json:"id"`
+    ReporterID uint                    `json:"reporter_id"`
+    TargetType domain.ReportTargetType `json:"target_type"`
+    TargetID   uint                    `json:"target_id"`
+    Reason     domain.ReportReason     `json:"reason"`
+    Detail     string                  `json:"detail,omitempty"`
+    CreatedAt  time.Time               `json:"created_at"`
+}
+
+func ToReportResponse(report *domain.Report) *ReportResponse {
+    return &ReportResponse{
+        ID:         report.ID,
+        ReporterID: report.ReporterID,
+        TargetType: report.TargetType,
+        TargetID:   report.TargetID,
+        Reason:     report.Reason,
+        Detail:     report.Detail,
+        CreatedAt:  report.CreatedAt,
+    }
+}
+
+// ModerationQueueQuery 모더레이션 큐 조회 조건
+type ModerationQueueQuery struct {
+    // Status open, claimed, resolved, dismissed (없으면 open과 claimed)
+    Status     string `form:"status" binding:"omitempty,oneof=open claimed resolved dismissed"`
+    TargetType string `form:"target_type" binding:"omitempty,oneof=post comment"`
+    // Source report(신고), spam_filter(스팸 보류 큐), word_filter(금칙어 검토)
+    Source string `form:"source" binding:"omitempty,oneof=report spam_filter word_filter"`
+    // BoardID 게시판 모더레이터는 자기 게시판을 지정해야 한다
+    BoardID uint `form:"board_id"`
+    Before  uint `form:"before"`
+    Size    int  `form:"size" binding:"omitempty,min=1,max=100"`
+}
+
+// ModerationNoteRequest 처리/기각 메모
+type ModerationNoteRequest struct {
+    Note string `json:"note" binding:"max=500" example:"욕설 확인, 숨김 처리"`
+}
+
+// ModerationCaseResponse 모더레이션 큐 항목
+type ModerationCaseResponse struct {
+    ID            uint                    `json:"id"`
+    TargetType    domain.ReportTargetType `json:"target_type"`
+    TargetID      uint                    `json:"target_id"`
+    BoardID       *uint                   `json:"board_id,omitempty"`
+    AuthorID      uint                    `json:"author_id"`
+    Status        domain.ModerationStatus `json:"status"`
+    Source        domain.ModerationSource `json:"source"`
+    ReportCount   int                     `json:"report_count"`
+    AutoHidden    bool                    `json:"auto_hidden"`
+    ClaimedBy     *uint                   `json:"claimed_by,omitempty"`
+    ClaimedAt     *time.Time              `json:"claimed_at,omitempty"`
+    ResolvedBy    *uint                   `json:"resolved_by,omitempty"`
+    ResolvedAt    *time.Time              `json:"resolved_at,omitempty"`
+    SpamScore     float64                 `json:"spam_score,omitempty"`
+    SpamReasons   string                  `json:"spam_reasons,omitempty"`
+    FilteredWords string                  `json:"filtered_words,omitempty"`
+    Note          string                  `json:"note,omitempty"`
+    OpenedAt      time.Time               `json:"opened_at"`
+    // Reports 상세 조회에서만 채운다
+    Reports []*ReportResponse `json:"reports,omitempty"`
+}
+
+func ToModerationCaseResponse(c *domain.ModerationCase) *ModerationCaseResponse {
+    return &ModerationCaseResponse{
+        ID:            c.ID,
+        TargetType:    c.TargetType,
+        TargetID:      c.TargetID,
+        BoardID:       c.BoardID,
+        AuthorID:      c.AuthorID,
+        Status:        c.Status,
+        Source:        c.Source,
+        ReportCount:   c.ReportCount,
+        AutoHidden:    c.AutoHidden,
+        ClaimedBy:     c.ClaimedBy,
+        ClaimedAt:     c.ClaimedAt,
+        ResolvedBy:    c.ResolvedBy,
+        ResolvedAt:    c.ResolvedAt,
+        SpamScore:     c.SpamScore,
+        SpamReasons:   c.SpamReasons,
+        FilteredWords: c.FilteredWords,
+        Note:          c.Note,
+        OpenedAt:      c.OpenedAt,
+    }
+}
@@ -2,9 +2,10 @@ package dto
 
 // ErrorResponse 에러 응답
 type ErrorResponse struct {
-    Error   string `json:"error" example:"잘못된 요청입니다"`
-    Code    string `json:"code,omitempty" example:"INVALID_INPUT"`
-    Details string `json:"details,omitempty" example:"title 필드는 필수입니다"`
+    Error string `json:"error" example:"잘못된 요청입니다"`
+    Code  string `json:"code,omitempty" example:"INVALID_INPUT"`
+    // Details 추가 정보 (문자열, 또는 금칙어 검사에 걸린 부분 같은 항목 목록)
+    Details interface{} `json:"details,omitempty" example:"title 필드는 필수입니다"`
 }
 
 // ListResponse 목록 응답
@@ -0,0 +1,56 @@
+package dto
+
+import (
+    "time"
+
+    "goboardapi/internal/domain"
+)
+
+// SanctionRequest 이용 제재 요청
+type SanctionRequest struct {
+    // Type write_restricted(작성 제한), suspended(기간 정지), banned(정지, 종료 시각 없으면 영구)
+    Type   domain.SanctionType `json:"type" binding:"required,oneof=write_restricted suspended banned" example:"suspended"`
+    Reason string              `json:"reason" binding:"required,max=500" example:"반복된 욕설"`
+    // StartsAt 없으면 바로 시작
+    StartsAt *time.Time `json:"starts_at"`
+    // EndsAt 없으면 해제할 때까지 (suspended는 필수)
+    EndsAt *time.Time `json:"ends_at"`
+}
+
+// RevokeSanctionRequest 제재 해제 요청
+type RevokeSanctionRequest struct {
+    Reason string `json:"reason" binding:"max=500" example:"이의 제기 인정"`
+}
+
+// SanctionResponse 이용 제재
+type SanctionResponse struct {
+    ID           uint                `json:"id"`
+    UserID       uint                `json:"user_id"`
+    Type         domain.SanctionType `json:"type"`
+    Reason       string              `json:"reason"`
+    IssuedBy     uint                `json:"issued_by"`
+    StartsAt     time.Time           `json:"starts_at"`
+    EndsAt       *time.Time          `json:"ends_at,omitempty"`
+    RevokedAt    *time.Time          `json:"revoked_at,omitempty"`
+    RevokedBy    *uint               `json:"revoked_by,omitempty"`
+    RevokeReason string              `json:"revoke_reason,omitempty"`
+    Active       bool                `json:"active"`
+    CreatedAt    time.Time           `json:"created_at"`
+}
+
+func ToSanctionResponse(sanction *domain.Sanction, now time.Time) *SanctionResponse {
+    return &SanctionResponse{
+        ID:           sanction.ID,
+        UserID:       sanction.UserID,
+        Type:         sanction.Type,
+        Reason:       sanction.Reason,
+        IssuedBy:     sanction.IssuedBy,
+        StartsAt:     sanction.StartsAt,
+        EndsAt:       sanction.EndsAt,
+        RevokedAt:    sanction.RevokedAt,
+        RevokedBy:    sanction.RevokedBy,
+        RevokeReason: sanction.RevokeReason,
+        Active:       sanction.ActiveAt(now),
+        CreatedAt:    sanction.CreatedAt,
+    }
+}
@@ -3,4 +3,47 @@
 type WithdrawRequest struct {
     Password string `json:"password" binding:"required"`
     Reason   string `json:"reason"` // 탈퇴 사유 (선택)
+    // Content 작성한 글 처리 방식 (anonymize, delete, transfer / 비우면 서버 기본값)
+    Content string `json:"content" binding:"omitempty,oneof=anonymize delete transfer"`
+}
+
+// UpdateProfileRequest 프로필 수정 요청 (보낸 항목만 변경, 빈 문자열은 삭제)
+type UpdateProfileRequest struct {
+    DisplayName *string `json:"display_name" binding:"omitempty,max=50" example:"홍길동"`
+    Bio         *string `json:"bio" binding:"omitempty,max=500" example:"Go 개발자입니다"`
+    Website     *string `json:"website" binding:"omitempty,max=255" example:"https://example.com"`
+}
+
+// ProfileStats 활동 통계
+type ProfileStats struct {
+    PostCount      int64 `json:"post_count"`
+    CommentCount   int64 `json:"comment_count"`
+    LikeCount      int64 `json:"like_count"` // 누른 좋아요 수
+    FollowerCount  int64 `json:"follower_count"`
+    FollowingCount int64 `json:"following_count"`
+}
+
+// PublicProfileResponse 공개 프로필 (이메일 등 개인 정보 제외)
+type PublicProfileResponse struct {
+    ID          uint         `json:"id"`
+    Username    string       `json:"username"`
+    DisplayName string       `json:"display_name,omitempty"`
+    Bio         string       `json:"bio,omitempty"`
+    Website     string       `json:"website,omitempty"`
+    AvatarURL   string       `json:"avatar_url,omitempty"`
+    Stats       ProfileStats `json:"stats"`
+    CreatedAt   time.Time    `json:"created_at"`
+}
+
+func ToPublicProfileResponse(user *domain.User, stats ProfileStats) *PublicProfileResponse {
+    return &PublicProfileResponse{
+        ID:          user.ID,
+        Username:    user.Username,
+        DisplayName: user.DisplayName,
+        Bio:         user.Bio,
+        Website:     user.Website,
+        AvatarURL:   user.AvatarURL,
+        Stats:       stats,
+        CreatedAt:   user.CreatedAt,
+    }
 }
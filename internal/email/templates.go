@@ -9,6 +9,11 @@ type TemplateData struct {
     Username string
     Link     string
     AppName  string
+    IP       string // 요청이 발생한 IP (보안 알림용)
+    Until    string // 잠금/제한 해제 시각 등 안내용 시각
+    Since    string // 제한 시작 시각 등 안내용 시각
+    Kind     string // 안내 대상 종류 (제재 종류 등)
+    Reason   string // 관리자가 남긴 사유
 }
 
 var templates = map[string]string{
@@ -39,6 +44,116 @@ var templates = map[string]string{
     <p>이 링크는 1시간 후 만료됩니다.</p>
 </body>
 </html>
+`,
+    "verify_email": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body>
+    <h1>이메일 인증</h1>
+    <p>안녕하세요, {{.Username}}님!</p>
+    <p>아래 링크를 클릭하여 {{.AppName}} 계정의 이메일 주소를 인증하세요.</p>
+    <p><a href="{{.Link}}">이메일 인증하기</a></p>
+    <p>이 링크는 24시간 후 만료되며, 한 번만 사용할 수 있습니다.</p>
+    <p>인증 전까지는 글과 댓글 작성이 제한됩니다.</p>
+</body>
+</html>
+`,
+    "account_locked": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body>
+    <h1>계정이 일시적으로 잠겼습니다</h1>
+    <p>안녕하세요, {{.Username}}님!</p>
+    <p>{{.AppName}} 계정에 로그인 실패가 반복되어 보안을 위해 로그인을 잠시 막았습니다.</p>
+    <p>마지막 시도 IP: {{.IP}}</p>
+    <p>{{.Until}} 이후 다시 로그인할 수 있습니다.</p>
+    <p>본인이 시도한 것이 아니라면 잠금이 풀린 뒤 비밀번호를 변경해주세요.</p>
+</body>
+</html>
+`,
+    "data_export_ready": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body>
+    <h1>개인 데이터 내보내기 완료</h1>
+    <p>안녕하세요, {{.Username}}님!</p>
+    <p>요청하신 {{.AppName}} 개인 데이터 내보내기 파일이 준비되었습니다.</p>
+    <p><a href="{{.Link}}">ZIP 파일 다운로드</a></p>
+    <p>이 링크는 {{.Until}}까지 사용할 수 있으며, 이후 파일은 삭제됩니다.</p>
+    <p>본인이 요청한 것이 아니라면 즉시 비밀번호를 변경해주세요.</p>
+</body>
+</html>
+`,
+    "dormancy_warning": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body>
+    <h1>휴면 계정 전환 예정 안내</h1>
+    <p>안녕하세요, {{.Username}}님!</p>
+    <p>{{.AppName}}에 오랫동안 로그인하지 않아 {{.Until}} 이후 계정이 휴면 상태로 전환됩니다.</p>
+    <p>휴면 상태가 되면 개인 정보는 별도로 분리 보관되며, 이메일 인증을 거쳐야 다시 로그인할 수 있습니다.</p>
+    <p>계속 이용하시려면 그 전에 한 번 로그인해주세요.</p>
+</body>
+</html>
+`,
+    "dormant_reactivate": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body>
+    <h1>휴면 계정 재활성화</h1>
+    <p>안녕하세요, {{.Username}}님!</p>
+    <p>아래 링크를 클릭하면 {{.AppName}} 휴면 계정이 다시 활성화됩니다.</p>
+    <p><a href="{{.Link}}">계정 재활성화</a></p>
+    <p>이 링크는 {{.Until}}까지 한 번만 사용할 수 있습니다.</p>
+    <p>본인이 요청한 것이 아니라면 이 메일을 무시하세요.</p>
+</body>
+</html>
+`,
+    "sanction_notice": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body>
+    <h1>계정 {{.Kind}} 안내</h1>
+    <p>안녕하세요, {{.Username}}님!</p>
+    <p>{{.AppName}} 운영 정책에 따라 계정에 {{.Kind}} 조치가 적용되었습니다.</p>
+    <p>사유: {{.Reason}}</p>
+    <p>시작: {{.Since}}</p>
+    {{if .Until}}<p>종료: {{.Until}}</p>{{else}}<p>종료: 별도 해제 시까지</p>{{end}}
+    <p>조치에 이의가 있으면 고객센터로 문의해주세요.</p>
+</body>
+</html>
+`,
+    "sanction_revoked": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body>
+    <h1>계정 {{.Kind}} 해제 안내</h1>
+    <p>안녕하세요, {{.Username}}님!</p>
+    <p>{{.AppName}} 계정에 적용되었던 {{.Kind}} 조치가 해제되었습니다.</p>
+    <p>지금부터 다시 정상적으로 이용할 수 있습니다.</p>
+</body>
+</html>
 `,
 }
 
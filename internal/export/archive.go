@@ -0,0 +1,107 @@
+// Package export 개인 데이터 내보내기 ZIP 작성
+package export
+
+import (
+    "archive/zip"
+    "encoding/json"
+    "io"
+    "time"
+)
+
+// Archive JSON 파일과 첨부 파일을 담는 ZIP
+type Archive struct {
+    zw  *zip.Writer
+    now time.Time
+}
+
+// NewArchive w에 ZIP을 쓴다 (파일 수정 시각은 모두 now)
+func NewArchive(w io.Writer, now time.Time) *Archive {
+    return &Archive{zw: zip.NewWriter(w), now: now}
+}
+
+func (a *Archive) create(name string) (io.Writer, error) {
+    return a.zw.CreateHeader(&zip.FileHeader{
+        Name:     name,
+        Method:   zip.Deflate,
+        Modified: a.now,
+    })
+}
+
+// WriteJSON v를 들여쓴 JSON 파일로 추가
+func (a *Archive) WriteJSON(name string, v interface{}) error {
+    w, err := a.create(name)
+    if err != nil {
+        return err
+    }
+
+    enc := json.NewEncoder(w)
+    enc.SetIndent("", "  ")
+    return enc.Encode(v)
+}
+
+// WriteJSONList next가 빈 목록을 돌려줄 때까지 이어 받아 하나의 JSON 배열 파일로 추가
+// 전체를 메모리에 올리지 않도록 배치 단위로 기록한다.
+func WriteJSONList[T any](a *Archive, name string, next func() ([]T, error)) error {
+    w, err := a.create(name)
+    if err != nil {
+        return err
+    }
+
+    if _, err := io.WriteString(w, "["); err != nil {
+        return err
+    }
+
+    first := true
+    for {
+        items, err := next()
+        if err != nil {
+            return err
+        }
+        if len(items) == 0 {
+            break
+        }
+
+        for _, item := range items {
+            data, err := json.MarshalIndent(item, "  ", "  ")
+            if err != nil {
+                return err
+            }
+
+            sep := ",\n  "
+            if first {
+                sep = "\n  "
+                first = false
+            }
+            if _, err := io.WriteString(w, sep); err != nil {
+                return err
+            }
+            if _, err := w.Write(data); err != nil {
+                return err
+            }
+        }
+    }
+
+    if !first {
+        if _, err := io.WriteString(w, "\n"); err != nil {
+            return err
+        }
+    }
+    _, err = io.WriteString(w, "]\n")
+    return err
+}
+
+// WriteFile 첨부 파일 추가
+func (a *Archive) WriteFile(name string, r io.Reader) error {
+    w, err := a.create(name)
+    if err != nil {
+        return err
+    }
+
+    _, err = io.Copy(w, r)
+    return err
+}
+
+// Close ZIP 목차를 기록 (하위 Writer는 닫지 않는다)
+func (a *Archive) Close() error {
+    return a.zw.Close()
+}
@@ -0,0 +1,90 @@
+package export
+
+import (
+    "archive/zip"
+    "bytes"
+    "encoding/json"
+    "io"
+    "strings"
+    "testing"
+    "time"
+)
+
+type item struct {
+    ID   int    `json:"id"`
+    Name string `json:"name"`
+}
+
+func readEntries(t *testing.T, data []byte) map[string][]byte {
+    t.Helper()
+
+    zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+    if err != nil {
+        t.Fatalf("zip.NewReader: %v", err)
+    }
+
+    entries := make(map[string][]byte)
+    for _, f := range zr.File {
+        rc, err := f.Open()
+        if err != nil {
+            t.Fatalf("%s 열기 실패: %v", f.Name, err)
+        }
+        body, _ := io.ReadAll(rc)
+        rc.Close()
+        entries[f.Name] = body
+    }
+    return entries
+}
+
+func TestArchive(t *testing.T) {
+    var buf bytes.Buffer
+    a := NewArchive(&buf, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+
+    if err := a.WriteJSON("profile.json", map[string]string{"username": "alice"}); err != nil {
+        t.Fatalf("WriteJSON: %v", err)
+    }
+
+    batches := [][]item{{{1, "a"}, {2, "b"}}, {{3, "c"}}, nil}
+    err := WriteJSONList(a, "posts.json", func() ([]item, error) {
+        batch := batches[0]
+        batches = batches[1:]
+        return batch, nil
+    })
+    if err != nil {
+        t.Fatalf("WriteJSONList: %v", err)
+    }
+
+    err = WriteJSONList(a, "likes.json", func() ([]item, error) { return nil, nil })
+    if err != nil {
+        t.Fatalf("WriteJSONList (빈 목록): %v", err)
+    }
+
+    if err := a.WriteFile("attachments/avatar.jpg", strings.NewReader("jpeg")); err != nil {
+        t.Fatalf("WriteFile: %v", err)
+    }
+    if err := a.Close(); err != nil {
+        t.Fatalf("Close: %v", err)
+    }
+
+    entries := readEntries(t, buf.Bytes())
+
+    var posts []item
+    if err := json.Unmarshal(entries["posts.json"], &posts); err != nil {
+        t.Fatalf("posts.json 파싱 실패: %v\n%s", err, entries["posts.json"])
+    }
+    if len(posts) != 3 || posts[2].Name != "c" {
+        t.Fatalf("posts = %+v", posts)
+    }
+
+    var likes []item
+    if err := json.Unmarshal(entries["likes.json"], &likes); err != nil || len(likes) != 0 {
+        t.Fatalf("likes.json = %q (%v)", entries["likes.json"], err)
+    }
+
+    if string(entries["attachments/avatar.jpg"]) != "jpeg" {
+        t.Fatalf("첨부 파일 내용 = %q", entries["attachments/avatar.jpg"])
+    }
+    if !strings.Contains(string(entries["profile.json"]), `"alice"`) {
+        t.Fatalf("profile.json = %q", entries["profile.json"])
+    }
+}
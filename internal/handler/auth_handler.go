@@ -1,4 +1,12 @@
 
+type AuthHandler struct {
+    authService service.AuthService
+}
+
+func NewAuthHandler(authService service.AuthService) *AuthHandler {
+    return &AuthHandler{authService: authService}
+}
+
 // @Summary 로그인
 // @Description 이메일과 비밀번호로 로그인합니다
 // @Tags auth
@@ -7,8 +15,49 @@
 // @Param request body LoginRequest true "로그인 정보"
 // @Success 200 {object} TokenResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
 // @Router /auth/login [post]
-func (h *AuthHandler) Login(c *gin.Context) {}
+func (h *AuthHandler) Login(c *gin.Context) {
+    var req dto.LoginRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.authService.Login(middleware.WithClientInfo(c), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 2단계 인증 로그인
+// @Description 로그인 응답의 challenge_token과 인증 앱 코드(또는 복구 코드)로 로그인을 완료합니다. challenge_token은 5분간 유효합니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.LoginTwoFactorRequest true "challenge_token과 코드"
+// @Success 200 {object} TokenResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /auth/login/2fa [post]
+func (h *AuthHandler) LoginTwoFactor(c *gin.Context) {
+    var req dto.LoginTwoFactorRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.authService.LoginTwoFactor(middleware.WithClientInfo(c), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, resp)
+}
 
 // @Summary 회원가입
 // @Description 새 계정을 생성합니다
@@ -16,10 +65,36 @@ func (h *AuthHandler) Login(c *gin.Context) {}
 // @Accept json
 // @Produce json
 // @Param request body SignupRequest true "회원가입 정보"
-// @Success 201 {object} UserResponse
+// @Success 201 {object} dto.SignupResponse
 // @Failure 400 {object} ErrorResponse
 // @Router /auth/signup [post]
-func (h *AuthHandler) Signup(c *gin.Context) {}
+func (h *AuthHandler) Signup(c *gin.Context) {
+    var req dto.SignupRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.authService.Signup(c.Request.Context(), &req)
+    if err != nil {
+        if respondWordFilterError(c, err) {
+            return
+        }
+        switch {
+        case errors.Is(err, service.ErrEmailAlreadyExists):
+            c.JSON(http.StatusConflict, gin.H{"error": "이미 가입된 이메일입니다"})
+        case errors.Is(err, service.ErrWeakPassword):
+            c.JSON(http.StatusBadRequest, gin.H{"error": "비밀번호 정책을 만족하지 않습니다", "code": "WEAK_PASSWORD"})
+        case errors.Is(err, service.ErrBreachedPassword):
+            c.JSON(http.StatusBadRequest, gin.H{"error": "유출된 비밀번호 목록에 포함된 비밀번호는 사용할 수 없습니다", "code": "BREACHED_PASSWORD"})
+        default:
+            c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        }
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(resp))
+}
 
 // @Summary 토큰 갱신
 // @Description Refresh Token으로 Access Token을 갱신합니다
@@ -30,4 +105,94 @@ func (h *AuthHandler) Signup(c *gin.Context) {}
 // @Success 200 {object} TokenResponse
 // @Failure 401 {object} ErrorResponse
 // @Router /auth/refresh [post]
-func (h *AuthHandler) Refresh(c *gin.Context) {}
+func (h *AuthHandler) Refresh(c *gin.Context) {
+    var req dto.RefreshRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.authService.Refresh(middleware.WithClientInfo(c), req.RefreshToken)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 비밀번호 변경
+// @Description 현재 비밀번호를 확인한 뒤 변경하고, 이 기기를 제외한 모든 기기에서 로그아웃합니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.ChangePasswordRequest true "현재 비밀번호와 새 비밀번호"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Router /users/me/password [put]
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+    var req dto.ChangePasswordRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    warnings, err := h.authService.ChangePassword(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":  true,
+        "message":  "비밀번호가 변경되었습니다",
+        "warnings": warnings,
+    })
+}
+
+func (h *AuthHandler) handleError(c *gin.Context, err error) {
+    if respondSanctionError(c, err) {
+        return
+    }
+
+    var throttled *service.LoginThrottledError
+    if errors.As(err, &throttled) {
+        retryAfter := int(throttled.RetryAfter.Seconds()) + 1
+        c.Header("Retry-After", strconv.Itoa(retryAfter))
+
+        code := "LOGIN_THROTTLED"
+        message := "로그인 시도가 너무 잦습니다. 잠시 후 다시 시도해주세요"
+        if throttled.Locked {
+            code = "ACCOUNT_LOCKED"
+            message = "로그인 실패가 반복되어 일시적으로 잠겼습니다"
+        }
+        c.JSON(http.StatusTooManyRequests, gin.H{"error": message, "code": code, "retry_after": retryAfter})
+        return
+    }
+
+    switch {
+    case errors.Is(err, service.ErrInvalidCredentials):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "이메일 또는 비밀번호가 올바르지 않습니다"})
+    case errors.Is(err, service.ErrAccountDormant):
+        c.JSON(http.StatusForbidden, gin.H{"error": "휴면 계정입니다. 이메일 인증으로 재활성화해주세요", "code": "ACCOUNT_DORMANT"})
+    case errors.Is(err, service.ErrInvalidChallenge):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증 시간이 만료되었습니다. 다시 로그인해주세요", "code": "INVALID_CHALLENGE"})
+    case errors.Is(err, service.ErrInvalidTwoFactorCode):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증 코드가 올바르지 않습니다", "code": "INVALID_2FA_CODE"})
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, service.ErrPersonalTokenNotAllowed):
+        c.JSON(http.StatusForbidden, gin.H{"error": "개인 액세스 토큰으로는 비밀번호를 변경할 수 없습니다", "code": "INTERACTIVE_LOGIN_REQUIRED"})
+    case errors.Is(err, service.ErrWrongPassword):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "현재 비밀번호가 올바르지 않습니다"})
+    case errors.Is(err, service.ErrWeakPassword):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "비밀번호 정책을 만족하지 않습니다", "code": "WEAK_PASSWORD"})
+    case errors.Is(err, service.ErrBreachedPassword):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "유출된 비밀번호 목록에 포함된 비밀번호는 사용할 수 없습니다", "code": "BREACHED_PASSWORD"})
+    case errors.Is(err, service.ErrInvalidRefreshToken):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "다시 로그인해주세요", "code": "INVALID_REFRESH_TOKEN"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type AuthzHandler struct {
+    authzService service.AuthzService
+}
+
+func NewAuthzHandler(authzService service.AuthzService) *AuthzHandler {
+    return &AuthzHandler{authzService: authzService}
+}
+
+// @Summary 권한 판단 설명 (관리자)
+// @Description 사용자가 대상에 action을 할 수 있는지 역할 권한과 정책을 모두 평가해 규칙별 결과를 반환합니다 (role:manage 권한 필요)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.AuthzExplainRequest true "사용자, action, 대상"
+// @Success 200 {object} dto.AuthzExplainResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/authz/explain [post]
+func (h *AuthzHandler) Explain(c *gin.Context) {
+    var req dto.AuthzExplainRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.authzService.Explain(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 권한 거부 기록 (관리자)
+// @Description RBAC 미들웨어와 서비스 권한 확인에서 거부된 요청을 최신순으로 조회합니다. 이전 페이지는 마지막 기록 ID를 before로 넘깁니다
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param user_id query int false "사용자 ID"
+// @Param request_id query string false "요청 ID (X-Request-ID)"
+// @Param since query string false "이 시각 이후 (RFC3339)"
+// @Param before query int false "이 ID보다 이전 기록"
+// @Param size query int false "개수" default(50)
+// @Success 200 {array} dto.AuthzDenialResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /admin/authz/denials [get]
+func (h *AuthzHandler) ListDenials(c *gin.Context) {
+    var query dto.AuthzDenialQuery
+    if err := c.ShouldBindQuery(&query); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.authzService.ListDenials(c.Request.Context(), &query)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+func (h *AuthzHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, auth.ErrNotAuthenticated):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, auth.ErrNoPermission):
+        c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrPostNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "게시글을 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrBoardNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "게시판을 찾을 수 없습니다"})
+    case errors.Is(err, service.ErrUnsupportedResource):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "지원하지 않는 대상 종류입니다", "code": "UNSUPPORTED_RESOURCE"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
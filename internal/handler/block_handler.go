@@ -0,0 +1,139 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type BlockHandler struct {
+    blockService service.BlockService
+}
+
+func NewBlockHandler(blockService service.BlockService) *BlockHandler {
+    return &BlockHandler{blockService: blockService}
+}
+
+// @Summary 사용자 차단
+// @Description 차단하면 서로의 글과 댓글이 보이지 않고, 상대는 내 글에 댓글/답글을 달거나 나를 멘션할 수 없습니다. 서로의 팔로우도 해제됩니다
+// @Tags blocks
+// @Produce json
+// @Security Bearer
+// @Param username path string true "사용자 이름"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{username}/block [post]
+func (h *BlockHandler) Block(c *gin.Context) {
+    if err := h.blockService.Block(c.Request.Context(), c.Param("username")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "차단했습니다",
+    })
+}
+
+// @Summary 사용자 차단 해제
+// @Tags blocks
+// @Produce json
+// @Security Bearer
+// @Param username path string true "사용자 이름"
+// @Success 200 {object} map[string]interface{}
+// @Router /users/{username}/block [delete]
+func (h *BlockHandler) Unblock(c *gin.Context) {
+    if err := h.blockService.Unblock(c.Request.Context(), c.Param("username")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "차단을 해제했습니다",
+    })
+}
+
+// @Summary 사용자 뮤트
+// @Description 뮤트하면 내 화면에서만 상대의 글, 댓글, 알림이 숨겨집니다. 상대에게는 알리지 않습니다
+// @Tags blocks
+// @Produce json
+// @Security Bearer
+// @Param username path string true "사용자 이름"
+// @Success 200 {object} map[string]interface{}
+// @Router /users/{username}/mute [post]
+func (h *BlockHandler) Mute(c *gin.Context) {
+    if err := h.blockService.Mute(c.Request.Context(), c.Param("username")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "뮤트했습니다",
+    })
+}
+
+// @Summary 사용자 뮤트 해제
+// @Tags blocks
+// @Produce json
+// @Security Bearer
+// @Param username path string true "사용자 이름"
+// @Success 200 {object} map[string]interface{}
+// @Router /users/{username}/mute [delete]
+func (h *BlockHandler) Unmute(c *gin.Context) {
+    if err := h.blockService.Unmute(c.Request.Context(), c.Param("username")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "뮤트를 해제했습니다",
+    })
+}
+
+// @Summary 차단/뮤트 목록
+// @Tags blocks
+// @Produce json
+// @Security Bearer
+// @Param kind query string false "block 또는 mute" default(block)
+// @Param page query int false "페이지" default(1)
+// @Param size query int false "페이지 크기" default(20)
+// @Success 200 {object} dto.ListResponse[dto.UserSummary]
+// @Router /users/me/blocks [get]
+func (h *BlockHandler) List(c *gin.Context) {
+    kind := domain.BlockKind(c.DefaultQuery("kind", string(domain.BlockKindBlock)))
+    if kind != domain.BlockKindBlock && kind != domain.BlockKindMute {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "kind는 block 또는 mute여야 합니다"})
+        return
+    }
+
+    p := parsePagination(c)
+
+    users, total, err := h.blockService.List(c.Request.Context(), kind, p)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, toUserSummaryList(users, total, p))
+}
+
+func (h *BlockHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    case errors.Is(err, service.ErrCannotBlockSelf):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "자기 자신은 차단하거나 뮤트할 수 없습니다", "code": "CANNOT_BLOCK_SELF"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
@@ -39,3 +39,67 @@ func (h *CommentHandler) DeleteComment(c *gin.Context) {
         "message": "댓글이 삭제되었습니다",
     })
 }
+
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게시글 ID"})
+        return
+    }
+
+    var req dto.CreateCommentRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    comment, err := h.commentService.Create(c.Request.Context(), uint(postID), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(dto.ToCommentResponse(comment)))
+}
+
+func (h *CommentHandler) ListComments(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게시글 ID"})
+        return
+    }
+
+    comments, err := h.commentService.ListByPost(c.Request.Context(), uint(postID))
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    resp := make([]*dto.CommentResponse, 0, len(comments))
+    for _, comment := range comments {
+        resp = append(resp, dto.ToCommentResponse(comment))
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+func (h *CommentHandler) handleError(c *gin.Context, err error) {
+    if respondWordFilterError(c, err) || respondSanctionError(c, err) {
+        return
+    }
+
+    switch {
+    case errors.Is(err, service.ErrUnauthorized), errors.Is(err, auth.ErrNotAuthenticated):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, auth.ErrNoPermission):
+        c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+    case errors.Is(err, repository.ErrPostNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "게시글을 찾을 수 없습니다"})
+    case errors.Is(err, service.ErrEmailNotVerified):
+        c.JSON(http.StatusForbidden, gin.H{"error": "이메일 인증 후 이용할 수 있습니다", "code": "EMAIL_NOT_VERIFIED"})
+    case errors.Is(err, service.ErrBlocked):
+        c.JSON(http.StatusForbidden, gin.H{"error": "차단 관계인 사용자의 글이나 댓글에는 댓글을 달 수 없습니다", "code": "BLOCKED"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
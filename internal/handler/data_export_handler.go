@@ -0,0 +1,96 @@
+package handler
+
+import (
+    "errors"
+    "fmt"
+    "net/http"
+
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type DataExportHandler struct {
+    exportService service.DataExportService
+}
+
+func NewDataExportHandler(exportService service.DataExportService) *DataExportHandler {
+    return &DataExportHandler{exportService: exportService}
+}
+
+// @Summary 개인 데이터 내보내기 요청
+// @Description 프로필, 게시글, 댓글, 좋아요, 알림, 탈퇴 기록과 첨부 파일을 ZIP으로 만들어 다운로드 링크를 메일로 보냅니다. 진행 중인 요청은 한 번에 하나만 가능합니다
+// @Tags users
+// @Produce json
+// @Security Bearer
+// @Success 202 {object} dto.DataExportResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /users/me/export [post]
+func (h *DataExportHandler) Request(c *gin.Context) {
+    resp, err := h.exportService.Request(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusAccepted, dto.SuccessResponse(resp))
+}
+
+// @Summary 개인 데이터 내보내기 상태
+// @Tags users
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.DataExportResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/me/export [get]
+func (h *DataExportHandler) Latest(c *gin.Context) {
+    resp, err := h.exportService.Latest(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 개인 데이터 다운로드
+// @Description 메일로 받은 링크의 토큰으로 ZIP 파일을 내려받습니다
+// @Tags users
+// @Produce application/zip
+// @Param token query string true "다운로드 토큰"
+// @Success 200 {file} file
+// @Failure 404 {object} ErrorResponse
+// @Router /exports/download [get]
+func (h *DataExportHandler) Download(c *gin.Context) {
+    r, export, err := h.exportService.Open(c.Request.Context(), c.Query("token"))
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+    defer r.Close()
+
+    filename := fmt.Sprintf("data-export-%s.zip", export.CreatedAt.Format("20060102"))
+    c.Header("Cache-Control", "no-store")
+    c.DataFromReader(http.StatusOK, export.FileSize, "application/zip", r, map[string]string{
+        "Content-Disposition": fmt.Sprintf(`attachment; filename="%s"`, filename),
+    })
+}
+
+func (h *DataExportHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, service.ErrPersonalTokenNotAllowed):
+        c.JSON(http.StatusForbidden, gin.H{"error": "개인 액세스 토큰으로는 데이터 내보내기를 요청할 수 없습니다", "code": "INTERACTIVE_LOGIN_REQUIRED"})
+    case errors.Is(err, repository.ErrDataExportInProgress):
+        c.JSON(http.StatusConflict, gin.H{"error": "이미 진행 중인 내보내기 요청이 있습니다", "code": "EXPORT_IN_PROGRESS"})
+    case errors.Is(err, repository.ErrDataExportNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "내보내기 요청이 없습니다"})
+    case errors.Is(err, service.ErrInvalidExportToken):
+        c.JSON(http.StatusNotFound, gin.H{"error": "유효하지 않거나 만료된 다운로드 링크입니다", "code": "INVALID_EXPORT_TOKEN"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
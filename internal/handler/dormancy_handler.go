@@ -0,0 +1,78 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+
+    "goboardapi/internal/dto"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type DormancyHandler struct {
+    dormancyService service.DormancyService
+}
+
+func NewDormancyHandler(dormancyService service.DormancyService) *DormancyHandler {
+    return &DormancyHandler{dormancyService: dormancyService}
+}
+
+// @Summary 휴면 계정 재활성화 메일 요청
+// @Description 휴면 계정의 이메일이면 24시간 동안 유효한 재활성화 링크를 보냅니다. 휴면 여부와 관계없이 같은 응답을 반환합니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.DormantReactivationRequest true "이메일"
+// @Success 202 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Router /auth/dormant/request [post]
+func (h *DormancyHandler) RequestReactivation(c *gin.Context) {
+    var req dto.DormantReactivationRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    if err := h.dormancyService.RequestReactivation(c.Request.Context(), req.Email); err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+        return
+    }
+
+    c.JSON(http.StatusAccepted, gin.H{
+        "success": true,
+        "message": "휴면 계정이라면 재활성화 메일이 발송됩니다",
+    })
+}
+
+// @Summary 휴면 계정 재활성화
+// @Description 메일로 받은 토큰으로 휴면을 해제합니다. 이후 기존 비밀번호로 로그인할 수 있습니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ReactivateDormantRequest true "재활성화 토큰"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Router /auth/dormant/reactivate [post]
+func (h *DormancyHandler) Reactivate(c *gin.Context) {
+    var req dto.ReactivateDormantRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    if err := h.dormancyService.Reactivate(c.Request.Context(), req.Token); err != nil {
+        switch {
+        case errors.Is(err, service.ErrInvalidReactivationToken):
+            c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않거나 만료된 재활성화 링크입니다", "code": "INVALID_TOKEN"})
+        default:
+            c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+        }
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "계정이 다시 활성화되었습니다",
+    })
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type EmailVerificationHandler struct {
+    verificationService service.EmailVerificationService
+}
+
+func NewEmailVerificationHandler(verificationService service.EmailVerificationService) *EmailVerificationHandler {
+    return &EmailVerificationHandler{verificationService: verificationService}
+}
+
+// @Summary 이메일 인증
+// @Description 메일로 받은 일회용 토큰으로 이메일을 인증합니다
+// @Tags auth
+// @Produce json
+// @Param token query string true "인증 토큰"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Router /auth/verify-email [get]
+func (h *EmailVerificationHandler) Verify(c *gin.Context) {
+    token := c.Query("token")
+    if token == "" {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "토큰이 필요합니다"})
+        return
+    }
+
+    if err := h.verificationService.Verify(c.Request.Context(), token); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "이메일 인증이 완료되었습니다",
+    })
+}
+
+// @Summary 인증 메일 재발송
+// @Description 로그인한 사용자에게 이메일 인증 메일을 다시 보냅니다 (1분 간격, 하루 5회 제한)
+// @Tags auth
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} map[string]interface{}
+// @Failure 409 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /auth/verify-email/resend [post]
+func (h *EmailVerificationHandler) Resend(c *gin.Context) {
+    if err := h.verificationService.Resend(c.Request.Context()); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "인증 메일을 발송했습니다",
+    })
+}
+
+// @Summary 이메일 인증 처리 (관리자)
+// @Description 관리자가 사용자를 이메일 인증 상태로 변경합니다 (user:manage 권한 필요)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param id path int true "사용자 ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} ErrorResponse
+// @Router /admin/users/{id}/verify-email [post]
+func (h *EmailVerificationHandler) AdminVerify(c *gin.Context) {
+    userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 사용자 ID"})
+        return
+    }
+
+    if err := h.verificationService.MarkVerified(c.Request.Context(), uint(userID)); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func (h *EmailVerificationHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrInvalidVerificationToken):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 인증 링크입니다", "code": "INVALID_TOKEN"})
+    case errors.Is(err, service.ErrVerificationTokenExpired):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "만료된 인증 링크입니다", "code": "TOKEN_EXPIRED"})
+    case errors.Is(err, service.ErrEmailAlreadyVerified):
+        c.JSON(http.StatusConflict, gin.H{"error": "이미 인증된 이메일입니다"})
+    case errors.Is(err, service.ErrVerificationThrottled):
+        c.JSON(http.StatusTooManyRequests, gin.H{"error": "잠시 후 다시 시도해주세요"})
+    case errors.Is(err, service.ErrUnauthorized), errors.Is(err, auth.ErrNotAuthenticated):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, auth.ErrNoPermission):
+        c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type FollowHandler struct {
+    followService service.FollowService
+    feedService   service.FeedService
+}
+
+func NewFollowHandler(followService service.FollowService, feedService service.FeedService) *FollowHandler {
+    return &FollowHandler{
+        followService: followService,
+        feedService:   feedService,
+    }
+}
+
+// @Summary 사용자 팔로우
+// @Description 사용자를 팔로우합니다. 상대방에게 알림이 가고 이후 작성하는 글이 홈 피드에 표시됩니다
+// @Tags follows
+// @Produce json
+// @Security Bearer
+// @Param username path string true "사용자 이름"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{username}/follow [post]
+func (h *FollowHandler) FollowUser(c *gin.Context) {
+    if err := h.followService.FollowUser(c.Request.Context(), c.Param("username")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "팔로우했습니다",
+    })
+}
+
+// @Summary 사용자 언팔로우
+// @Tags follows
+// @Produce json
+// @Security Bearer
+// @Param username path string true "사용자 이름"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{username}/follow [delete]
+func (h *FollowHandler) UnfollowUser(c *gin.Context) {
+    if err := h.followService.UnfollowUser(c.Request.Context(), c.Param("username")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "팔로우를 취소했습니다",
+    })
+}
+
+// @Summary 게시판 팔로우
+// @Description 게시판을 팔로우하면 새 글이 홈 피드에 표시됩니다
+// @Tags follows
+// @Produce json
+// @Security Bearer
+// @Param id path int true "게시판 ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} ErrorResponse
+// @Router /boards/{id}/follow [post]
+func (h *FollowHandler) FollowBoard(c *gin.Context) {
+    boardID, ok := h.parseBoardID(c)
+    if !ok {
+        return
+    }
+
+    if err := h.followService.FollowBoard(c.Request.Context(), boardID); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "게시판을 팔로우했습니다",
+    })
+}
+
+// @Summary 게시판 언팔로우
+// @Tags follows
+// @Produce json
+// @Security Bearer
+// @Param id path int true "게시판 ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /boards/{id}/follow [delete]
+func (h *FollowHandler) UnfollowBoard(c *gin.Context) {
+    boardID, ok := h.parseBoardID(c)
+    if !ok {
+        return
+    }
+
+    if err := h.followService.UnfollowBoard(c.Request.Context(), boardID); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "게시판 팔로우를 취소했습니다",
+    })
+}
+
+// @Summary 팔로워 목록
+// @Tags follows
+// @Produce json
+// @Param username path string true "사용자 이름"
+// @Param page query int false "페이지" default(1)
+// @Param size query int false "페이지 크기" default(20)
+// @Success 200 {object} dto.ListResponse[dto.UserSummary]
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{username}/followers [get]
+func (h *FollowHandler) Followers(c *gin.Context) {
+    p := parsePagination(c)
+
+    users, total, err := h.followService.Followers(c.Request.Context(), c.Param("username"), p)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, toUserSummaryList(users, total, p))
+}
+
+// @Summary 팔로잉 목록
+// @Tags follows
+// @Produce json
+// @Param username path string true "사용자 이름"
+// @Param page query int false "페이지" default(1)
+// @Param size query int false "페이지 크기" default(20)
+// @Success 200 {object} dto.ListResponse[dto.UserSummary]
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{username}/following [get]
+func (h *FollowHandler) Following(c *gin.Context) {
+    p := parsePagination(c)
+
+    users, total, err := h.followService.Following(c.Request.Context(), c.Param("username"), p)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, toUserSummaryList(users, total, p))
+}
+
+// @Summary 홈 피드
+// @Description 팔로우한 사용자와 게시판의 글을 최신순으로 조회합니다. 다음 페이지는 meta.next_cursor를 cursor로 넘깁니다
+// @Tags follows
+// @Produce json
+// @Security Bearer
+// @Param cursor query string false "이전 응답의 next_cursor"
+// @Param size query int false "페이지 크기" default(20)
+// @Success 200 {object} dto.FeedResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /feed [get]
+func (h *FollowHandler) Home(c *gin.Context) {
+    size, _ := strconv.Atoi(c.Query("size"))
+    req := dto.CursorPagination{Cursor: c.Query("cursor"), Size: size}
+
+    resp, err := h.feedService.Home(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+func (h *FollowHandler) parseBoardID(c *gin.Context) (uint, bool) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게시판 ID"})
+        return 0, false
+    }
+    return uint(id), true
+}
+
+func (h *FollowHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrBoardNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "게시판을 찾을 수 없습니다"})
+    case errors.Is(err, service.ErrCannotFollowSelf):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "자기 자신은 팔로우할 수 없습니다", "code": "CANNOT_FOLLOW_SELF"})
+    case errors.Is(err, service.ErrBlocked):
+        c.JSON(http.StatusForbidden, gin.H{"error": "차단 관계인 사용자는 팔로우할 수 없습니다", "code": "BLOCKED"})
+    case errors.Is(err, service.ErrInvalidCursor):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 커서입니다", "code": "INVALID_CURSOR"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
+
+// parsePagination page/size 쿼리 파싱 (기본 20개, 최대 100개)
+func parsePagination(c *gin.Context) *dto.Pagination {
+    page, _ := strconv.Atoi(c.Query("page"))
+    size, _ := strconv.Atoi(c.Query("size"))
+    return dto.NewPagination(page, size, 20, 100)
+}
+
+func toUserSummaryList(users []*domain.User, total int64, p *dto.Pagination) dto.ListResponse[dto.UserSummary] {
+    data := make([]dto.UserSummary, 0, len(users))
+    for _, user := range users {
+        data = append(data, dto.ToUserSummary(user))
+    }
+
+    return dto.ListResponse[dto.UserSummary]{
+        Data:       data,
+        TotalCount: total,
+        Page:       p.Page,
+        Size:       p.Size,
+    }
+}
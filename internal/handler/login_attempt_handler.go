@@ -0,0 +1,58 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type LoginAttemptHandler struct {
+    attemptService service.LoginAttemptService
+}
+
+func NewLoginAttemptHandler(attemptService service.LoginAttemptService) *LoginAttemptHandler {
+    return &LoginAttemptHandler{attemptService: attemptService}
+}
+
+// @Summary 로그인 잠금 해제 (관리자)
+// @Description 로그인 실패 누적으로 잠긴 계정의 잠금과 실패 기록을 초기화합니다 (user:manage 권한 필요)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param id path int true "사용자 ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/users/{id}/unlock [post]
+func (h *LoginAttemptHandler) AdminUnlock(c *gin.Context) {
+    userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 사용자 ID"})
+        return
+    }
+
+    if err := h.attemptService.Unlock(c.Request.Context(), uint(userID)); err != nil {
+        switch {
+        case errors.Is(err, auth.ErrNotAuthenticated):
+            c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+        case errors.Is(err, auth.ErrNoPermission):
+            c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+        case errors.Is(err, repository.ErrUserNotFound):
+            c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+        default:
+            c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+        }
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "로그인 잠금이 해제되었습니다",
+    })
+}
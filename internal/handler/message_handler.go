@@ -0,0 +1,218 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type MessageHandler struct {
+    messageService service.MessageService
+}
+
+func NewMessageHandler(messageService service.MessageService) *MessageHandler {
+    return &MessageHandler{messageService: messageService}
+}
+
+// @Summary 대화방 만들기
+// @Description 상대가 한 명이고 제목이 없으면 1:1 대화방을 만들거나 기존 대화방을 돌려줍니다
+// @Tags messages
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.CreateConversationRequest true "참여자"
+// @Success 200 {object} dto.ConversationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /conversations [post]
+func (h *MessageHandler) CreateConversation(c *gin.Context) {
+    var req dto.CreateConversationRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.messageService.CreateConversation(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 대화방 목록
+// @Tags messages
+// @Produce json
+// @Security Bearer
+// @Param page query int false "페이지" default(1)
+// @Param size query int false "페이지 크기" default(20)
+// @Success 200 {object} []dto.ConversationResponse
+// @Router /conversations [get]
+func (h *MessageHandler) ListConversations(c *gin.Context) {
+    resp, err := h.messageService.List(c.Request.Context(), parsePagination(c))
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 메시지 기록
+// @Description 실시간 연결이 끊긴 동안의 메시지를 조회합니다. 이전 페이지는 마지막 메시지 ID를 before로 넘깁니다
+// @Tags messages
+// @Produce json
+// @Security Bearer
+// @Param id path int true "대화방 ID"
+// @Param before query int false "이 ID보다 이전 메시지"
+// @Param size query int false "개수" default(30)
+// @Success 200 {object} []dto.MessageResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /conversations/{id}/messages [get]
+func (h *MessageHandler) History(c *gin.Context) {
+    conversationID, ok := h.parseConversationID(c)
+    if !ok {
+        return
+    }
+
+    before, _ := strconv.ParseUint(c.Query("before"), 10, 32)
+    size, _ := strconv.Atoi(c.Query("size"))
+
+    resp, err := h.messageService.History(c.Request.Context(), conversationID, uint(before), size)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 메시지 보내기
+// @Description WebSocket의 dm_send와 같은 동작입니다
+// @Tags messages
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "대화방 ID"
+// @Param request body dto.SendMessageRequest true "메시지"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Router /conversations/{id}/messages [post]
+func (h *MessageHandler) Send(c *gin.Context) {
+    conversationID, ok := h.parseConversationID(c)
+    if !ok {
+        return
+    }
+
+    var req dto.SendMessageRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.messageService.Send(c.Request.Context(), conversationID, req.Content)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 읽음 처리
+// @Description WebSocket의 dm_read와 같은 동작입니다. 다른 참여자에게 읽음 표시가 전달됩니다
+// @Tags messages
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "대화방 ID"
+// @Param request body dto.MarkReadRequest true "마지막으로 읽은 메시지"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} ErrorResponse
+// @Router /conversations/{id}/read [post]
+func (h *MessageHandler) MarkRead(c *gin.Context) {
+    conversationID, ok := h.parseConversationID(c)
+    if !ok {
+        return
+    }
+
+    var req dto.MarkReadRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    if err := h.messageService.MarkRead(c.Request.Context(), conversationID, req.MessageID); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "읽음 처리했습니다",
+    })
+}
+
+// @Summary 안 읽은 메시지 수
+// @Tags messages
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.UnreadResponse
+// @Router /conversations/unread [get]
+func (h *MessageHandler) Unread(c *gin.Context) {
+    resp, err := h.messageService.Unread(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+func (h *MessageHandler) parseConversationID(c *gin.Context) (uint, bool) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 대화방 ID"})
+        return 0, false
+    }
+    return uint(id), true
+}
+
+func (h *MessageHandler) handleError(c *gin.Context, err error) {
+    if respondSanctionError(c, err) {
+        return
+    }
+
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrConversationNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "대화방을 찾을 수 없습니다", "code": "CONVERSATION_NOT_FOUND"})
+    case errors.Is(err, service.ErrEmailNotVerified):
+        c.JSON(http.StatusForbidden, gin.H{"error": "이메일 인증 후 이용할 수 있습니다", "code": "EMAIL_NOT_VERIFIED"})
+    case errors.Is(err, service.ErrBlocked):
+        c.JSON(http.StatusForbidden, gin.H{"error": "차단 관계인 사용자에게는 메시지를 보낼 수 없습니다", "code": "BLOCKED"})
+    case errors.Is(err, service.ErrMessageRateLimited):
+        c.JSON(http.StatusTooManyRequests, gin.H{"error": "메시지를 너무 자주 보내고 있습니다. 잠시 후 다시 시도해주세요", "code": "MESSAGE_RATE_LIMITED"})
+    case errors.Is(err, service.ErrEmptyMessage):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "메시지 내용을 입력해주세요", "code": "EMPTY_MESSAGE"})
+    case errors.Is(err, service.ErrMessageTooLong):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "메시지가 너무 깁니다", "code": "MESSAGE_TOO_LONG"})
+    case errors.Is(err, service.ErrGroupTooLarge):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "대화방 인원이 너무 많습니다", "code": "GROUP_TOO_LARGE"})
+    case errors.Is(err, service.ErrNoRecipients):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "대화할 상대를 지정해주세요", "code": "NO_RECIPIENTS"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
@@ -0,0 +1,210 @@
+package handler
+
+import (
+    "context"
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type ModerationHandler struct {
+    moderationService service.ModerationService
+}
+
+func NewModerationHandler(moderationService service.ModerationService) *ModerationHandler {
+    return &ModerationHandler{moderationService: moderationService}
+}
+
+// @Summary 게시글/댓글 신고
+// @Description 사유 분류와 내용을 적어 신고합니다. 같은 대상은 한 번만 신고할 수 있고, 신고가 일정 수 이상 쌓이면 자동으로 숨겨집니다
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.CreateReportRequest true "신고 대상과 사유"
+// @Success 201 {object} dto.ReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /reports [post]
+func (h *ModerationHandler) Report(c *gin.Context) {
+    var req dto.CreateReportRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.moderationService.Report(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(resp))
+}
+
+// @Summary 모더레이션 큐
+// @Description 관리 권한이 있는 대상 종류의 처리 건을 최신순으로 조회합니다. 게시판 모더레이터는 board_id를 지정해야 합니다
+// @Tags moderation
+// @Produce json
+// @Security Bearer
+// @Param status query string false "open, claimed, resolved, dismissed (없으면 open과 claimed)"
+// @Param target_type query string false "post, comment"
+// @Param source query string false "report(신고), spam_filter(스팸 보류 큐), word_filter(금칙어 검토)"
+// @Param board_id query int false "게시판 ID"
+// @Param before query int false "이 ID보다 이전 건"
+// @Param size query int false "개수" default(20)
+// @Success 200 {array} dto.ModerationCaseResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /moderation/cases [get]
+func (h *ModerationHandler) ListQueue(c *gin.Context) {
+    var query dto.ModerationQueueQuery
+    if err := c.ShouldBindQuery(&query); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.moderationService.ListQueue(c.Request.Context(), &query)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 처리 건 상세
+// @Tags moderation
+// @Produce json
+// @Security Bearer
+// @Param id path int true "처리 건 ID"
+// @Success 200 {object} dto.ModerationCaseResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /moderation/cases/{id} [get]
+func (h *ModerationHandler) GetCase(c *gin.Context) {
+    id, ok := parseCaseID(c)
+    if !ok {
+        return
+    }
+
+    resp, err := h.moderationService.GetCase(c.Request.Context(), id)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 처리 건 맡기
+// @Description 다른 모더레이터가 맡은 건은 일정 시간 처리되지 않았을 때만 가져올 수 있습니다
+// @Tags moderation
+// @Produce json
+// @Security Bearer
+// @Param id path int true "처리 건 ID"
+// @Success 200 {object} dto.ModerationCaseResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /moderation/cases/{id}/claim [post]
+func (h *ModerationHandler) Claim(c *gin.Context) {
+    id, ok := parseCaseID(c)
+    if !ok {
+        return
+    }
+
+    resp, err := h.moderationService.Claim(c.Request.Context(), id)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 신고 인정
+// @Description 맡은 건의 대상을 숨기고 신고자에게 결과를 알립니다
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "처리 건 ID"
+// @Param request body dto.ModerationNoteRequest false "메모"
+// @Success 204
+// @Failure 409 {object} ErrorResponse
+// @Router /moderation/cases/{id}/resolve [post]
+func (h *ModerationHandler) Resolve(c *gin.Context) {
+    h.close(c, h.moderationService.Resolve)
+}
+
+// @Summary 신고 기각
+// @Description 맡은 건을 기각하고 자동 숨김을 해제한 뒤 신고자에게 결과를 알립니다
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "처리 건 ID"
+// @Param request body dto.ModerationNoteRequest false "메모"
+// @Success 204
+// @Failure 409 {object} ErrorResponse
+// @Router /moderation/cases/{id}/dismiss [post]
+func (h *ModerationHandler) Dismiss(c *gin.Context) {
+    h.close(c, h.moderationService.Dismiss)
+}
+
+func (h *ModerationHandler) close(c *gin.Context, action func(ctx context.Context, id uint, req *dto.ModerationNoteRequest) error) {
+    id, ok := parseCaseID(c)
+    if !ok {
+        return
+    }
+
+    var req dto.ModerationNoteRequest
+    if c.Request.ContentLength > 0 {
+        if err := c.ShouldBindJSON(&req); err != nil {
+            c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+            return
+        }
+    }
+
+    if err := action(c.Request.Context(), id, &req); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Status(http.StatusNoContent)
+}
+
+func parseCaseID(c *gin.Context) (uint, bool) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 처리 건 ID"})
+        return 0, false
+    }
+    return uint(id), true
+}
+
+func (h *ModerationHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrUnauthorized), errors.Is(err, auth.ErrNotAuthenticated):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, auth.ErrNoPermission):
+        c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+    case errors.Is(err, service.ErrReportTargetNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "신고할 게시글이나 댓글을 찾을 수 없습니다"})
+    case errors.Is(err, service.ErrCannotReportOwnContent):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "자신의 글은 신고할 수 없습니다", "code": "CANNOT_REPORT_SELF"})
+    case errors.Is(err, repository.ErrReportExists):
+        c.JSON(http.StatusConflict, gin.H{"error": "이미 신고한 대상입니다", "code": "REPORT_EXISTS"})
+    case errors.Is(err, repository.ErrModerationCaseNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "처리 건을 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrModerationCaseUnavailable):
+        c.JSON(http.StatusConflict, gin.H{"error": "다른 모더레이터가 맡았거나 이미 처리된 건입니다", "code": "CASE_UNAVAILABLE"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+
+    "goboardapi/internal/dto"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type PasswordResetHandler struct {
+    passwordResetService service.PasswordResetService
+}
+
+func NewPasswordResetHandler(passwordResetService service.PasswordResetService) *PasswordResetHandler {
+    return &PasswordResetHandler{passwordResetService: passwordResetService}
+}
+
+// @Summary 비밀번호 재설정 메일 요청
+// @Description 가입된 이메일이면 1시간 동안 유효한 재설정 링크를 보냅니다. 가입 여부와 관계없이 같은 응답을 반환합니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.PasswordResetRequest true "이메일"
+// @Success 202 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Router /auth/password-reset/request [post]
+func (h *PasswordResetHandler) RequestReset(c *gin.Context) {
+    var req dto.PasswordResetRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    if err := h.passwordResetService.RequestReset(c.Request.Context(), req.Email); err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+        return
+    }
+
+    c.JSON(http.StatusAccepted, gin.H{
+        "success": true,
+        "message": "가입된 이메일이라면 비밀번호 재설정 메일이 발송됩니다",
+    })
+}
+
+// @Summary 비밀번호 재설정
+// @Description 메일로 받은 토큰으로 비밀번호를 변경합니다. 성공하면 모든 기기에서 로그아웃됩니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ResetPasswordRequest true "토큰과 새 비밀번호"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Router /auth/password-reset [post]
+func (h *PasswordResetHandler) ResetPassword(c *gin.Context) {
+    var req dto.ResetPasswordRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    warnings, err := h.passwordResetService.ResetPassword(c.Request.Context(), req.Token, req.NewPassword)
+    if err != nil {
+        switch {
+        case errors.Is(err, service.ErrInvalidResetToken):
+            c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 재설정 링크입니다", "code": "INVALID_TOKEN"})
+        case errors.Is(err, service.ErrResetTokenExpired):
+            c.JSON(http.StatusBadRequest, gin.H{"error": "만료된 재설정 링크입니다", "code": "TOKEN_EXPIRED"})
+        case errors.Is(err, service.ErrWeakPassword):
+            c.JSON(http.StatusBadRequest, gin.H{"error": "비밀번호 정책을 만족하지 않습니다", "code": "WEAK_PASSWORD"})
+        case errors.Is(err, service.ErrBreachedPassword):
+            c.JSON(http.StatusBadRequest, gin.H{"error": "유출된 비밀번호 목록에 포함된 비밀번호는 사용할 수 없습니다", "code": "BREACHED_PASSWORD"})
+        default:
+            c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+        }
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":  true,
+        "message":  "비밀번호가 변경되었습니다. 다시 로그인해주세요",
+        "warnings": warnings,
+    })
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/dto"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type PersonalAccessTokenHandler struct {
+    tokenService service.PersonalAccessTokenService
+}
+
+func NewPersonalAccessTokenHandler(tokenService service.PersonalAccessTokenService) *PersonalAccessTokenHandler {
+    return &PersonalAccessTokenHandler{tokenService: tokenService}
+}
+
+// @Summary 개인 액세스 토큰 발급
+// @Description 스크립트/연동용 토큰을 발급합니다. scopes에는 내 역할이 가진 권한만 지정할 수 있으며 원본 토큰은 이 응답에서만 확인할 수 있습니다
+// @Tags tokens
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.CreatePersonalAccessTokenRequest true "토큰 정보"
+// @Success 201 {object} dto.PersonalAccessTokenCreatedResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /users/me/tokens [post]
+func (h *PersonalAccessTokenHandler) Create(c *gin.Context) {
+    var req dto.CreatePersonalAccessTokenRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.tokenService.Create(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(resp))
+}
+
+// @Summary 개인 액세스 토큰 목록
+// @Tags tokens
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.PersonalAccessTokenResponse
+// @Router /users/me/tokens [get]
+func (h *PersonalAccessTokenHandler) List(c *gin.Context) {
+    tokens, err := h.tokenService.List(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    resp := make([]dto.PersonalAccessTokenResponse, 0, len(tokens))
+    for _, token := range tokens {
+        resp = append(resp, dto.ToPersonalAccessTokenResponse(token))
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 개인 액세스 토큰 폐기
+// @Tags tokens
+// @Produce json
+// @Security Bearer
+// @Param id path int true "토큰 ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} ErrorResponse
+// @Router /users/me/tokens/{id} [delete]
+func (h *PersonalAccessTokenHandler) Revoke(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 토큰 ID"})
+        return
+    }
+
+    if err := h.tokenService.Revoke(c.Request.Context(), uint(id)); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "토큰이 폐기되었습니다",
+    })
+}
+
+func (h *PersonalAccessTokenHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, service.ErrPersonalTokenNotAllowed):
+        c.JSON(http.StatusForbidden, gin.H{"error": "개인 액세스 토큰으로는 토큰을 관리할 수 없습니다", "code": "INTERACTIVE_LOGIN_REQUIRED"})
+    case errors.Is(err, service.ErrInvalidTokenScope):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "부여할 수 없는 권한이 포함되어 있습니다", "code": "INVALID_SCOPE"})
+    case errors.Is(err, service.ErrTokenExpiryTooLong):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "토큰 유효 기간은 최대 365일입니다"})
+    case errors.Is(err, service.ErrTooManyPersonalTokens):
+        c.JSON(http.StatusConflict, gin.H{"error": "발급할 수 있는 토큰 수를 초과했습니다"})
+    case errors.Is(err, service.ErrPersonalTokenNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "토큰을 찾을 수 없습니다"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
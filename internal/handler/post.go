@@ -3,7 +3,79 @@ func (h *PostHandler) Create(c *gin.Context) {
 
     log.Info("게시글 생성 시작")
 
-    // ... 게시글 생성 로직
+    var req dto.CreatePostRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    // 제재/이메일 인증/금칙어 확인과 팔로워 피드 반영은 PostWriteService에서 처리
+    post, err := h.postWriteService.Create(c.Request.Context(), &req)
+    if err != nil {
+        h.handleWriteError(c, err)
+        return
+    }
 
     log.Info("게시글 생성 완료", "post_id", post.ID)
+    c.JSON(http.StatusCreated, dto.SuccessResponse(dto.ToPostResponse(post)))
+}
+
+func (h *PostHandler) Update(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게시글 ID"})
+        return
+    }
+
+    var req dto.UpdatePostRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    // 작성자 수정 기한과 모더레이터 권한은 PostWriteService가 접근 정책으로 확인
+    post, err := h.postWriteService.Update(c.Request.Context(), uint(id), &req)
+    if err != nil {
+        h.handleWriteError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(dto.ToPostResponse(post)))
+}
+
+func (h *PostHandler) Delete(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게시글 ID"})
+        return
+    }
+
+    if err := h.postWriteService.Delete(c.Request.Context(), uint(id)); err != nil {
+        h.handleWriteError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "게시글이 삭제되었습니다",
+    })
+}
+
+func (h *PostHandler) handleWriteError(c *gin.Context, err error) {
+    if respondWordFilterError(c, err) || respondSanctionError(c, err) {
+        return
+    }
+
+    switch {
+    case errors.Is(err, service.ErrUnauthorized), errors.Is(err, auth.ErrNotAuthenticated):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, auth.ErrNoPermission):
+        c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+    case errors.Is(err, repository.ErrPostNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "게시글을 찾을 수 없습니다"})
+    case errors.Is(err, service.ErrEmailNotVerified):
+        c.JSON(http.StatusForbidden, gin.H{"error": "이메일 인증 후 이용할 수 있습니다", "code": "EMAIL_NOT_VERIFIED"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
 }
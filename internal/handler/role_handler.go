@@ -0,0 +1,344 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type RoleHandler struct {
+    roleService service.RoleService
+}
+
+func NewRoleHandler(roleService service.RoleService) *RoleHandler {
+    return &RoleHandler{roleService: roleService}
+}
+
+// @Summary 역할 목록 (관리자)
+// @Description 역할과 부여된 권한을 조회합니다 (role:manage 권한 필요)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.RoleResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /admin/roles [get]
+func (h *RoleHandler) ListRoles(c *gin.Context) {
+    resp, err := h.roleService.ListRoles(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 역할 생성 (관리자)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.CreateRoleRequest true "역할"
+// @Success 201 {object} dto.RoleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/roles [post]
+func (h *RoleHandler) CreateRole(c *gin.Context) {
+    var req dto.CreateRoleRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.roleService.CreateRole(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(resp))
+}
+
+// @Summary 역할 삭제 (관리자)
+// @Description 기본 역할과 사용자가 있는 역할은 삭제할 수 없습니다
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param name path string true "역할 이름"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/roles/{name} [delete]
+func (h *RoleHandler) DeleteRole(c *gin.Context) {
+    if err := h.roleService.DeleteRole(c.Request.Context(), c.Param("name")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Status(http.StatusNoContent)
+}
+
+// @Summary 권한 목록 (관리자)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.PermissionResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /admin/permissions [get]
+func (h *RoleHandler) ListPermissions(c *gin.Context) {
+    resp, err := h.roleService.ListPermissions(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 권한 생성 (관리자)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.CreatePermissionRequest true "권한 (resource:action)"
+// @Success 201 {object} dto.PermissionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/permissions [post]
+func (h *RoleHandler) CreatePermission(c *gin.Context) {
+    var req dto.CreatePermissionRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.roleService.CreatePermission(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(resp))
+}
+
+// @Summary 권한 삭제 (관리자)
+// @Description 모든 역할에서 회수한 뒤 삭제합니다. 기본 권한은 삭제할 수 없습니다
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param name path string true "권한 이름"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/permissions/{name} [delete]
+func (h *RoleHandler) DeletePermission(c *gin.Context) {
+    if err := h.roleService.DeletePermission(c.Request.Context(), c.Param("name")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Status(http.StatusNoContent)
+}
+
+// @Summary 역할에 권한 부여 (관리자)
+// @Description 모든 서버 인스턴스에 바로 반영됩니다
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param name path string true "역할 이름"
+// @Param permission path string true "권한 이름"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/roles/{name}/permissions/{permission} [put]
+func (h *RoleHandler) Grant(c *gin.Context) {
+    if err := h.roleService.Grant(c.Request.Context(), c.Param("name"), c.Param("permission")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Status(http.StatusNoContent)
+}
+
+// @Summary 역할의 권한 회수 (관리자)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param name path string true "역할 이름"
+// @Param permission path string true "권한 이름"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/roles/{name}/permissions/{permission} [delete]
+func (h *RoleHandler) Revoke(c *gin.Context) {
+    if err := h.roleService.Revoke(c.Request.Context(), c.Param("name"), c.Param("permission")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Status(http.StatusNoContent)
+}
+
+// @Summary 사용자 역할 변경 (관리자)
+// @Description 새 역할은 사용자의 다음 토큰 갱신부터 적용됩니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "사용자 ID"
+// @Param request body dto.ChangeRoleRequest true "역할"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/users/{id}/role [put]
+func (h *RoleHandler) AssignUser(c *gin.Context) {
+    userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 사용자 ID"})
+        return
+    }
+
+    var req dto.ChangeRoleRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    if err := h.roleService.AssignUser(c.Request.Context(), uint(userID), req.Role); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Status(http.StatusNoContent)
+}
+
+// @Summary 게시판 역할 목록 (관리자)
+// @Description 게시판 안에서만 유효하게 부여된 역할을 조회합니다
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param id path int true "게시판 ID"
+// @Success 200 {array} dto.RoleAssignmentResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/boards/{id}/roles [get]
+func (h *RoleHandler) ListBoardRoles(c *gin.Context) {
+    boardID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게시판 ID"})
+        return
+    }
+
+    resp, err := h.roleService.ListBoardRoles(c.Request.Context(), uint(boardID))
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 게시판 역할 부여 (관리자)
+// @Description 사용자에게 이 게시판에서만 유효한 역할(예: 모더레이터)을 부여합니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "게시판 ID"
+// @Param request body dto.AssignBoardRoleRequest true "사용자와 역할"
+// @Success 201 {object} dto.RoleAssignmentResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/boards/{id}/roles [post]
+func (h *RoleHandler) AssignBoardRole(c *gin.Context) {
+    boardID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게시판 ID"})
+        return
+    }
+
+    var req dto.AssignBoardRoleRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.roleService.AssignBoardRole(c.Request.Context(), uint(boardID), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(resp))
+}
+
+// @Summary 게시판 역할 회수 (관리자)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param id path int true "게시판 ID"
+// @Param assignment_id path int true "부여 ID"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/boards/{id}/roles/{assignment_id} [delete]
+func (h *RoleHandler) RemoveBoardRole(c *gin.Context) {
+    boardID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게시판 ID"})
+        return
+    }
+    assignmentID, err := strconv.ParseUint(c.Param("assignment_id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 부여 ID"})
+        return
+    }
+
+    if err := h.roleService.RemoveBoardRole(c.Request.Context(), uint(boardID), uint(assignmentID)); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Status(http.StatusNoContent)
+}
+
+func (h *RoleHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, auth.ErrNotAuthenticated):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, auth.ErrNoPermission):
+        c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+    case errors.Is(err, service.ErrInvalidRoleName):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "역할 이름은 영문 소문자, 숫자, 밑줄 2~20자입니다", "code": "INVALID_ROLE_NAME"})
+    case errors.Is(err, service.ErrInvalidPermissionName):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "권한 이름은 resource:action 형식입니다", "code": "INVALID_PERMISSION_NAME"})
+    case errors.Is(err, repository.ErrRoleNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "역할을 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrPermissionNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "권한을 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrBoardNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "게시판을 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrAssignmentNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "역할 부여를 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrAssignmentExists):
+        c.JSON(http.StatusConflict, gin.H{"error": "이미 부여된 역할입니다", "code": "ASSIGNMENT_EXISTS"})
+    case errors.Is(err, repository.ErrRoleExists):
+        c.JSON(http.StatusConflict, gin.H{"error": "이미 있는 역할입니다", "code": "ROLE_EXISTS"})
+    case errors.Is(err, repository.ErrPermissionExists):
+        c.JSON(http.StatusConflict, gin.H{"error": "이미 있는 권한입니다", "code": "PERMISSION_EXISTS"})
+    case errors.Is(err, service.ErrSystemRole):
+        c.JSON(http.StatusConflict, gin.H{"error": "기본 역할은 삭제할 수 없습니다", "code": "SYSTEM_ROLE"})
+    case errors.Is(err, service.ErrSystemPermission):
+        c.JSON(http.StatusConflict, gin.H{"error": "기본 권한은 삭제할 수 없습니다", "code": "SYSTEM_PERMISSION"})
+    case errors.Is(err, service.ErrRoleInUse):
+        c.JSON(http.StatusConflict, gin.H{"error": "사용자가 있는 역할은 삭제할 수 없습니다", "code": "ROLE_IN_USE"})
+    case errors.Is(err, service.ErrProtectedGrant):
+        c.JSON(http.StatusConflict, gin.H{"error": "관리자의 역할 관리 권한은 회수할 수 없습니다", "code": "PROTECTED_GRANT"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
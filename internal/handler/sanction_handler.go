@@ -0,0 +1,167 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type SanctionHandler struct {
+    sanctionService service.SanctionService
+}
+
+func NewSanctionHandler(sanctionService service.SanctionService) *SanctionHandler {
+    return &SanctionHandler{sanctionService: sanctionService}
+}
+
+// @Summary 이용 제재 (관리자)
+// @Description 작성 제한(write_restricted), 기간 정지(suspended), 정지(banned)를 내립니다. 시작된 제재는 바로 세션과 실시간 연결을 끊고, 사용자에게 메일로 알립니다 (user:manage 권한 필요)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "사용자 ID"
+// @Param request body dto.SanctionRequest true "제재 종류, 사유, 기간"
+// @Success 201 {object} dto.SanctionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/users/{id}/sanctions [post]
+func (h *SanctionHandler) Issue(c *gin.Context) {
+    userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 사용자 ID"})
+        return
+    }
+
+    var req dto.SanctionRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.sanctionService.Issue(c.Request.Context(), uint(userID), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(resp))
+}
+
+// @Summary 사용자의 제재 이력 (관리자)
+// @Description 해제되거나 끝난 제재를 포함해 최근 순으로 돌려줍니다
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param id path int true "사용자 ID"
+// @Success 200 {array} dto.SanctionResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /admin/users/{id}/sanctions [get]
+func (h *SanctionHandler) ListByUser(c *gin.Context) {
+    userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 사용자 ID"})
+        return
+    }
+
+    resp, err := h.sanctionService.ListByUser(c.Request.Context(), uint(userID))
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 이용 제재 해제 (관리자)
+// @Description 기간 전에 제재를 풉니다. 이력은 해제 사유와 함께 남습니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "제재 ID"
+// @Param request body dto.RevokeSanctionRequest false "해제 사유"
+// @Success 200 {object} dto.SanctionResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/sanctions/{id} [delete]
+func (h *SanctionHandler) Revoke(c *gin.Context) {
+    sanctionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 제재 ID"})
+        return
+    }
+
+    var req dto.RevokeSanctionRequest
+    // 사유는 선택이라 본문이 없어도 된다
+    if c.Request.ContentLength > 0 {
+        if err := c.ShouldBindJSON(&req); err != nil {
+            c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+            return
+        }
+    }
+
+    resp, err := h.sanctionService.Revoke(c.Request.Context(), uint(sanctionID), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 내 제재 이력
+// @Tags users
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.SanctionResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /users/me/sanctions [get]
+func (h *SanctionHandler) ListMine(c *gin.Context) {
+    resp, err := h.sanctionService.ListMine(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+func (h *SanctionHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, auth.ErrNotAuthenticated), errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, auth.ErrNoPermission):
+        c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+    case errors.Is(err, service.ErrInvalidSanctionType):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "제재 종류가 올바르지 않습니다", "code": "INVALID_SANCTION_TYPE"})
+    case errors.Is(err, service.ErrInvalidSanctionPeriod):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "제재 기간이 올바르지 않습니다", "code": "INVALID_SANCTION_PERIOD"})
+    case errors.Is(err, service.ErrCannotSanctionUser):
+        c.JSON(http.StatusForbidden, gin.H{"error": "자기 자신이나 관리자는 제재할 수 없습니다", "code": "CANNOT_SANCTION_USER"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrSanctionNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "진행 중인 제재를 찾을 수 없습니다"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
+
+// respondSanctionError 제재로 거부된 경우 종류와 해제 시각을 응답 (아니면 false)
+func respondSanctionError(c *gin.Context, err error) bool {
+    var sanctioned *service.SanctionError
+    if !errors.As(err, &sanctioned) {
+        return false
+    }
+
+    c.JSON(http.StatusForbidden, middleware.SanctionResponse(sanctioned.Sanction))
+    return true
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type SessionHandler struct {
+    sessionService service.SessionService
+}
+
+func NewSessionHandler(sessionService service.SessionService) *SessionHandler {
+    return &SessionHandler{sessionService: sessionService}
+}
+
+// @Summary 로그인 세션 목록
+// @Description 로그인되어 있는 기기 목록을 최근 접속 순으로 조회합니다
+// @Tags sessions
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.SessionResponse
+// @Router /users/me/sessions [get]
+func (h *SessionHandler) List(c *gin.Context) {
+    sessions, err := h.sessionService.List(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    var currentID uint
+    if claims, ok := middleware.GetCurrentUser(c); ok {
+        currentID = claims.SessionID
+    }
+
+    resp := make([]dto.SessionResponse, 0, len(sessions))
+    for _, session := range sessions {
+        resp = append(resp, dto.ToSessionResponse(session, currentID))
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 세션 로그아웃
+// @Description 선택한 기기를 로그아웃합니다. 해당 기기의 토큰 갱신과 실시간 연결이 즉시 끊깁니다
+// @Tags sessions
+// @Produce json
+// @Security Bearer
+// @Param id path int true "세션 ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} ErrorResponse
+// @Router /users/me/sessions/{id} [delete]
+func (h *SessionHandler) Revoke(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 세션 ID"})
+        return
+    }
+
+    if err := h.sessionService.Revoke(c.Request.Context(), uint(id)); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "로그아웃되었습니다",
+    })
+}
+
+// @Summary 다른 기기 모두 로그아웃
+// @Description 현재 세션을 제외한 모든 세션을 로그아웃합니다
+// @Tags sessions
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} map[string]interface{}
+// @Router /users/me/sessions [delete]
+func (h *SessionHandler) RevokeOthers(c *gin.Context) {
+    if err := h.sessionService.RevokeOthers(c.Request.Context()); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "다른 기기에서 모두 로그아웃되었습니다",
+    })
+}
+
+func (h *SessionHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, service.ErrPersonalTokenNotAllowed):
+        c.JSON(http.StatusForbidden, gin.H{"error": "개인 액세스 토큰으로는 세션을 관리할 수 없습니다", "code": "INTERACTIVE_LOGIN_REQUIRED"})
+    case errors.Is(err, service.ErrSessionNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "세션을 찾을 수 없습니다"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
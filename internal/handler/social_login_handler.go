@@ -0,0 +1,163 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type SocialLoginHandler struct {
+    socialLoginService service.SocialLoginService
+}
+
+func NewSocialLoginHandler(socialLoginService service.SocialLoginService) *SocialLoginHandler {
+    return &SocialLoginHandler{socialLoginService: socialLoginService}
+}
+
+// @Summary 외부 로그인 시작
+// @Description 설정된 OpenID Connect 제공자의 인증 페이지로 이동합니다 (PKCE, state, nonce 적용)
+// @Tags auth
+// @Param provider path string true "제공자 이름" example(google)
+// @Success 302
+// @Failure 404 {object} ErrorResponse
+// @Router /auth/oidc/{provider} [get]
+func (h *SocialLoginHandler) Start(c *gin.Context) {
+    authURL, err := h.socialLoginService.StartLogin(c.Request.Context(), c.Param("provider"))
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Redirect(http.StatusFound, authURL)
+}
+
+// @Summary 외부 로그인 콜백
+// @Description 인가 코드를 교환해 로그인합니다. 처음이면 검증된 이메일로 기존 계정에 연결하거나 새 계정을 만듭니다
+// @Tags auth
+// @Produce json
+// @Param provider path string true "제공자 이름"
+// @Param state query string true "state"
+// @Param code query string true "인가 코드"
+// @Success 200 {object} dto.SocialLoginResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /auth/oidc/{provider}/callback [get]
+func (h *SocialLoginHandler) Callback(c *gin.Context) {
+    // 사용자가 제공자 화면에서 동의를 거부한 경우
+    if errCode := c.Query("error"); errCode != "" {
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "외부 인증이 취소되었습니다", "code": "OIDC_DENIED"})
+        return
+    }
+
+    state, code := c.Query("state"), c.Query("code")
+    if state == "" || code == "" {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "state와 code가 필요합니다"})
+        return
+    }
+
+    resp, err := h.socialLoginService.Callback(middleware.WithClientInfo(c), c.Param("provider"), state, code)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 외부 계정 연결 시작
+// @Description 로그인한 계정에 외부 계정을 연결하기 위한 인증 페이지 주소를 발급합니다
+// @Tags auth
+// @Produce json
+// @Security Bearer
+// @Param provider path string true "제공자 이름"
+// @Success 200 {object} dto.AuthURLResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/me/identities/{provider} [post]
+func (h *SocialLoginHandler) Link(c *gin.Context) {
+    authURL, err := h.socialLoginService.StartLink(c.Request.Context(), c.Param("provider"))
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(dto.AuthURLResponse{AuthURL: authURL}))
+}
+
+// @Summary 연결된 외부 계정 목록
+// @Tags auth
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.ExternalIdentityResponse
+// @Router /users/me/identities [get]
+func (h *SocialLoginHandler) ListIdentities(c *gin.Context) {
+    identities, err := h.socialLoginService.ListIdentities(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    resp := make([]dto.ExternalIdentityResponse, 0, len(identities))
+    for _, identity := range identities {
+        resp = append(resp, dto.ToExternalIdentityResponse(identity))
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 외부 계정 연결 해제
+// @Description 비밀번호가 설정된 계정만 해제할 수 있습니다 (로그인 수단이 사라지는 것을 방지)
+// @Tags auth
+// @Produce json
+// @Security Bearer
+// @Param provider path string true "제공자 이름"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /users/me/identities/{provider} [delete]
+func (h *SocialLoginHandler) Unlink(c *gin.Context) {
+    if err := h.socialLoginService.Unlink(c.Request.Context(), c.Param("provider")); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "외부 계정 연결이 해제되었습니다",
+    })
+}
+
+func (h *SocialLoginHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, service.ErrPersonalTokenNotAllowed):
+        c.JSON(http.StatusForbidden, gin.H{"error": "개인 액세스 토큰으로는 소셜 계정 연결을 변경할 수 없습니다", "code": "INTERACTIVE_LOGIN_REQUIRED"})
+    case errors.Is(err, service.ErrUnknownProvider):
+        c.JSON(http.StatusNotFound, gin.H{"error": "지원하지 않는 로그인 제공자입니다"})
+    case errors.Is(err, service.ErrInvalidOIDCState):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "로그인 요청이 만료되었거나 올바르지 않습니다", "code": "INVALID_OIDC_STATE"})
+    case errors.Is(err, service.ErrOIDCAuthenticationFailed):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "외부 인증에 실패했습니다", "code": "OIDC_FAILED"})
+    case errors.Is(err, service.ErrOIDCEmailNotVerified):
+        c.JSON(http.StatusForbidden, gin.H{"error": "제공자에서 인증된 이메일이 필요합니다", "code": "OIDC_EMAIL_NOT_VERIFIED"})
+    case errors.Is(err, service.ErrIdentityLinkedToOtherUser):
+        c.JSON(http.StatusConflict, gin.H{"error": "다른 계정에 연결된 외부 계정입니다"})
+    case errors.Is(err, service.ErrAccountDormant):
+        c.JSON(http.StatusForbidden, gin.H{"error": "휴면 계정입니다. 이메일 인증으로 재활성화해주세요", "code": "ACCOUNT_DORMANT"})
+    case errors.Is(err, service.ErrLinkRequiresLogin):
+        c.JSON(http.StatusConflict, gin.H{"error": "같은 이메일로 가입된 계정이 있습니다. 비밀번호로 로그인한 뒤 계정 연결을 진행해주세요", "code": "LINK_REQUIRES_LOGIN"})
+    case errors.Is(err, service.ErrProviderAlreadyLinked):
+        c.JSON(http.StatusConflict, gin.H{"error": "이미 같은 제공자의 계정이 연결되어 있습니다"})
+    case errors.Is(err, service.ErrIdentityNotLinked):
+        c.JSON(http.StatusNotFound, gin.H{"error": "연결된 외부 계정이 없습니다"})
+    case errors.Is(err, service.ErrPasswordRequiredToUnlink):
+        c.JSON(http.StatusConflict, gin.H{"error": "비밀번호를 먼저 설정해야 연결을 해제할 수 있습니다", "code": "PASSWORD_REQUIRED"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
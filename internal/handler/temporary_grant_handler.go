@@ -0,0 +1,122 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type TemporaryGrantHandler struct {
+    grantService service.TemporaryGrantService
+}
+
+func NewTemporaryGrantHandler(grantService service.TemporaryGrantService) *TemporaryGrantHandler {
+    return &TemporaryGrantHandler{grantService: grantService}
+}
+
+// @Summary 기간 한정 권한 부여 (관리자)
+// @Description 역할 또는 권한 하나를 시작/종료 시각 사이에만 부여합니다. board_id를 넘기면 그 게시판에서만 유효합니다 (role:manage 권한 필요)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.TemporaryGrantRequest true "대상 사용자, 역할 또는 권한, 기간, 사유"
+// @Success 201 {object} dto.TemporaryGrantResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/temporary-grants [post]
+func (h *TemporaryGrantHandler) Grant(c *gin.Context) {
+    var req dto.TemporaryGrantRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    resp, err := h.grantService.Grant(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(resp))
+}
+
+// @Summary 사용자의 기간 한정 권한 목록 (관리자)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param id path int true "사용자 ID"
+// @Success 200 {array} dto.TemporaryGrantResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /admin/users/{id}/temporary-grants [get]
+func (h *TemporaryGrantHandler) ListByUser(c *gin.Context) {
+    userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 사용자 ID"})
+        return
+    }
+
+    resp, err := h.grantService.ListByUser(c.Request.Context(), uint(userID))
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 기간 한정 권한 회수 (관리자)
+// @Description 종료 시각을 지금으로 당겨 바로 적용을 멈춥니다
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param id path int true "부여 ID"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/temporary-grants/{id} [delete]
+func (h *TemporaryGrantHandler) Revoke(c *gin.Context) {
+    grantID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 부여 ID"})
+        return
+    }
+
+    if err := h.grantService.Revoke(c.Request.Context(), uint(grantID)); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Status(http.StatusNoContent)
+}
+
+func (h *TemporaryGrantHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, auth.ErrNotAuthenticated):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, auth.ErrNoPermission):
+        c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+    case errors.Is(err, service.ErrInvalidGrantTarget):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "역할과 권한 중 하나만 지정해야 합니다", "code": "INVALID_GRANT_TARGET"})
+    case errors.Is(err, service.ErrInvalidGrantPeriod):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "부여 기간이 올바르지 않습니다", "code": "INVALID_GRANT_PERIOD"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrBoardNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "게시판을 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrRoleNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "역할을 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrPermissionNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "권한을 찾을 수 없습니다"})
+    case errors.Is(err, repository.ErrTemporaryGrantNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "진행 중인 부여를 찾을 수 없습니다"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
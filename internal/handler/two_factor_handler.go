@@ -0,0 +1,138 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+
+    "goboardapi/internal/dto"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type TwoFactorHandler struct {
+    twoFactorService service.TwoFactorService
+}
+
+func NewTwoFactorHandler(twoFactorService service.TwoFactorService) *TwoFactorHandler {
+    return &TwoFactorHandler{twoFactorService: twoFactorService}
+}
+
+// @Summary 2단계 인증 등록 시작
+// @Description TOTP 비밀키와 인증 앱 등록용 otpauth URI를 발급합니다. 확인 전까지는 로그인에 적용되지 않습니다
+// @Tags 2fa
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} dto.TwoFactorSetupResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /users/me/2fa/setup [post]
+func (h *TwoFactorHandler) Setup(c *gin.Context) {
+    resp, err := h.twoFactorService.Setup(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 2단계 인증 등록 확인
+// @Description 인증 앱의 코드로 등록을 확정하고 복구 코드를 발급합니다. 복구 코드는 이 응답에서만 확인할 수 있습니다
+// @Tags 2fa
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.TwoFactorCodeRequest true "인증 코드"
+// @Success 200 {object} dto.RecoveryCodesResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/me/2fa/confirm [post]
+func (h *TwoFactorHandler) Confirm(c *gin.Context) {
+    var req dto.TwoFactorCodeRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    codes, err := h.twoFactorService.Confirm(c.Request.Context(), req.Code)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(dto.RecoveryCodesResponse{RecoveryCodes: codes}))
+}
+
+// @Summary 복구 코드 재발급
+// @Description 기존 복구 코드를 폐기하고 새로 발급합니다
+// @Tags 2fa
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.TwoFactorCodeRequest true "인증 코드"
+// @Success 200 {object} dto.RecoveryCodesResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /users/me/2fa/recovery-codes [post]
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c *gin.Context) {
+    var req dto.TwoFactorCodeRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    codes, err := h.twoFactorService.RegenerateRecoveryCodes(c.Request.Context(), req.Code)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(dto.RecoveryCodesResponse{RecoveryCodes: codes}))
+}
+
+// @Summary 2단계 인증 해제
+// @Description 비밀번호와 인증 코드(또는 복구 코드)를 확인한 뒤 2단계 인증을 해제합니다. 의무 대상 역할은 해제할 수 없습니다
+// @Tags 2fa
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.DisableTwoFactorRequest true "비밀번호와 코드"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Router /users/me/2fa [delete]
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+    var req dto.DisableTwoFactorRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    if err := h.twoFactorService.Disable(c.Request.Context(), req.Password, req.Code); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "2단계 인증이 해제되었습니다",
+    })
+}
+
+func (h *TwoFactorHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, service.ErrPersonalTokenNotAllowed):
+        c.JSON(http.StatusForbidden, gin.H{"error": "개인 액세스 토큰으로는 2단계 인증을 변경할 수 없습니다", "code": "INTERACTIVE_LOGIN_REQUIRED"})
+    case errors.Is(err, service.ErrWrongPassword):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "비밀번호가 올바르지 않습니다"})
+    case errors.Is(err, service.ErrInvalidTwoFactorCode):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "인증 코드가 올바르지 않습니다", "code": "INVALID_2FA_CODE"})
+    case errors.Is(err, service.ErrTwoFactorAlreadyEnabled):
+        c.JSON(http.StatusConflict, gin.H{"error": "이미 2단계 인증을 사용 중입니다"})
+    case errors.Is(err, service.ErrTwoFactorNotEnabled), errors.Is(err, service.ErrTwoFactorSetupNotStarted):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "2단계 인증이 설정되지 않았습니다"})
+    case errors.Is(err, service.ErrTwoFactorRequiredForRole):
+        c.JSON(http.StatusForbidden, gin.H{"error": "관리자는 2단계 인증을 해제할 수 없습니다", "code": "TWO_FACTOR_REQUIRED"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
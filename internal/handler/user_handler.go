@@ -1,3 +1,10 @@
+type UserHandler struct {
+    profileService service.ProfileService
+}
+
+func NewUserHandler(profileService service.ProfileService) *UserHandler {
+    return &UserHandler{profileService: profileService}
+}
 
 // @Summary 내 프로필 조회
 // @Description 로그인한 사용자의 프로필을 조회합니다
@@ -7,7 +14,15 @@
 // @Success 200 {object} UserResponse
 // @Failure 401 {object} ErrorResponse
 // @Router /users/me [get]
-func (h *UserHandler) GetMe(c *gin.Context) {}
+func (h *UserHandler) GetMe(c *gin.Context) {
+    user, err := h.profileService.GetMe(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(dto.ToUserResponse(user)))
+}
 
 // @Summary 프로필 수정
 // @Description 로그인한 사용자의 프로필을 수정합니다
@@ -19,4 +34,111 @@ func (h *UserHandler) GetMe(c *gin.Context) {}
 // @Success 200 {object} UserResponse
 // @Failure 401 {object} ErrorResponse
 // @Router /users/me [put]
-func (h *UserHandler) UpdateMe(c *gin.Context) {}
+func (h *UserHandler) UpdateMe(c *gin.Context) {
+    var req dto.UpdateProfileRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    user, err := h.profileService.UpdateMe(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(dto.ToUserResponse(user)))
+}
+
+// @Summary 공개 프로필 조회
+// @Description 사용자 이름으로 공개 프로필과 활동 통계(게시글, 댓글, 좋아요 수)를 조회합니다
+// @Tags users
+// @Produce json
+// @Param username path string true "사용자 이름"
+// @Success 200 {object} PublicProfileResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /users/{username} [get]
+func (h *UserHandler) GetPublicProfile(c *gin.Context) {
+    profile, err := h.profileService.GetPublic(c.Request.Context(), c.Param("username"))
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(profile))
+}
+
+// @Summary 프로필 이미지 업로드
+// @Description JPEG/PNG/GIF 이미지를 업로드합니다. 서버에서 가운데 기준 정사각형으로 잘라 축소한 뒤 저장합니다
+// @Tags users
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param avatar formData file true "프로필 이미지"
+// @Success 200 {object} UserResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 413 {object} ErrorResponse
+// @Router /users/me/avatar [put]
+func (h *UserHandler) UploadAvatar(c *gin.Context) {
+    file, err := c.FormFile("avatar")
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "avatar 파일이 필요합니다"})
+        return
+    }
+
+    f, err := file.Open()
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "파일을 읽을 수 없습니다"})
+        return
+    }
+    defer f.Close()
+
+    user, err := h.profileService.UploadAvatar(c.Request.Context(), f)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(dto.ToUserResponse(user)))
+}
+
+// @Summary 프로필 이미지 삭제
+// @Tags users
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} map[string]interface{}
+// @Router /users/me/avatar [delete]
+func (h *UserHandler) DeleteAvatar(c *gin.Context) {
+    if err := h.profileService.DeleteAvatar(c.Request.Context()); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "프로필 이미지가 삭제되었습니다",
+    })
+}
+
+func (h *UserHandler) handleError(c *gin.Context, err error) {
+    if respondWordFilterError(c, err) {
+        return
+    }
+
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, service.ErrPersonalTokenNotAllowed):
+        c.JSON(http.StatusForbidden, gin.H{"error": "개인 액세스 토큰으로는 프로필을 변경할 수 없습니다", "code": "INTERACTIVE_LOGIN_REQUIRED"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    case errors.Is(err, service.ErrInvalidWebsite):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "웹사이트는 http 또는 https 주소여야 합니다", "code": "INVALID_WEBSITE"})
+    case errors.Is(err, service.ErrInvalidAvatar):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "JPEG, PNG, GIF 이미지만 업로드할 수 있습니다", "code": "INVALID_AVATAR"})
+    case errors.Is(err, service.ErrAvatarTooLarge):
+        c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "이미지 크기가 너무 큽니다", "code": "AVATAR_TOO_LARGE"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
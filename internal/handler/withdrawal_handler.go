@@ -0,0 +1,68 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type WithdrawalHandler struct {
+    withdrawalService service.WithdrawalService
+}
+
+func NewWithdrawalHandler(withdrawalService service.WithdrawalService) *WithdrawalHandler {
+    return &WithdrawalHandler{withdrawalService: withdrawalService}
+}
+
+// @Summary 회원 탈퇴
+// @Description 탈퇴하면 모든 기기에서 로그아웃되며 30일 안에는 계정을 복구할 수 있습니다. 이후 작성한 글은 content 방식(anonymize, delete, transfer)으로 처리되고 개인 정보는 삭제됩니다
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.WithdrawRequest true "비밀번호 확인과 콘텐츠 처리 방식"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /users/me [delete]
+func (h *WithdrawalHandler) Withdraw(c *gin.Context) {
+    var req dto.WithdrawRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    if err := h.withdrawalService.Withdraw(c.Request.Context(), &req); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "탈퇴했습니다. 30일 안에는 계정을 복구할 수 있습니다",
+    })
+}
+
+func (h *WithdrawalHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, service.ErrPersonalTokenNotAllowed):
+        c.JSON(http.StatusForbidden, gin.H{"error": "개인 액세스 토큰으로는 탈퇴할 수 없습니다", "code": "INTERACTIVE_LOGIN_REQUIRED"})
+    case errors.Is(err, repository.ErrUserNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "사용자를 찾을 수 없습니다"})
+    case errors.Is(err, service.ErrWrongPassword):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "비밀번호가 일치하지 않습니다", "code": "WRONG_PASSWORD"})
+    case errors.Is(err, service.ErrCannotWithdrawAdmin):
+        c.JSON(http.StatusForbidden, gin.H{"error": "관리자 계정은 탈퇴할 수 없습니다", "code": "CANNOT_WITHDRAW_ADMIN"})
+    case errors.Is(err, repository.ErrUnknownContentPolicy):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "지원하지 않는 콘텐츠 처리 방식입니다", "code": "INVALID_CONTENT_POLICY"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
@@ -0,0 +1,159 @@
+package handler
+
+import (
+    "errors"
+    "net/http"
+    "strconv"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/service"
+
+    "github.com/gin-gonic/gin"
+)
+
+type WordFilterHandler struct {
+    wordFilter *service.WordFilterService
+}
+
+func NewWordFilterHandler(wordFilter *service.WordFilterService) *WordFilterHandler {
+    return &WordFilterHandler{wordFilter: wordFilter}
+}
+
+// @Summary 금칙어 목록 (관리자)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Success 200 {array} dto.BannedWordResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /admin/banned-words [get]
+func (h *WordFilterHandler) List(c *gin.Context) {
+    words, err := h.wordFilter.List(c.Request.Context())
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    resp := make([]*dto.BannedWordResponse, 0, len(words))
+    for _, word := range words {
+        resp = append(resp, dto.ToBannedWordResponse(word))
+    }
+    c.JSON(http.StatusOK, dto.SuccessResponse(resp))
+}
+
+// @Summary 금칙어 등록 (관리자)
+// @Description 제목, 본문, 댓글, 사용자 이름에 적용됩니다. action은 mask(가리고 저장), reject(거부), flag(저장 후 검토 큐), allow(예외 단어: 이 단어 안에서 걸린 금칙어는 무시)입니다.
+// @Description 띄어쓰기, 기호, 대소문자, 받침 옮겨 쓰기 같은 우회 표기도 같은 단어로 봅니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body dto.BannedWordRequest true "단어와 처리"
+// @Success 201 {object} dto.BannedWordResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/banned-words [post]
+func (h *WordFilterHandler) Create(c *gin.Context) {
+    var req dto.BannedWordRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    word, err := h.wordFilter.Create(c.Request.Context(), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, dto.SuccessResponse(dto.ToBannedWordResponse(word)))
+}
+
+// @Summary 금칙어 수정 (관리자)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path int true "금칙어 ID"
+// @Param request body dto.BannedWordRequest true "단어와 처리"
+// @Success 200 {object} dto.BannedWordResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /admin/banned-words/{id} [put]
+func (h *WordFilterHandler) Update(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 금칙어 ID"})
+        return
+    }
+
+    var req dto.BannedWordRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+        return
+    }
+
+    word, err := h.wordFilter.Update(c.Request.Context(), uint(id), &req)
+    if err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, dto.SuccessResponse(dto.ToBannedWordResponse(word)))
+}
+
+// @Summary 금칙어 삭제 (관리자)
+// @Tags admin
+// @Produce json
+// @Security Bearer
+// @Param id path int true "금칙어 ID"
+// @Success 204
+// @Failure 404 {object} ErrorResponse
+// @Router /admin/banned-words/{id} [delete]
+func (h *WordFilterHandler) Delete(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 금칙어 ID"})
+        return
+    }
+
+    if err := h.wordFilter.Delete(c.Request.Context(), uint(id)); err != nil {
+        h.handleError(c, err)
+        return
+    }
+
+    c.Status(http.StatusNoContent)
+}
+
+func (h *WordFilterHandler) handleError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, auth.ErrNotAuthenticated), errors.Is(err, service.ErrUnauthorized):
+        c.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+    case errors.Is(err, auth.ErrNoPermission):
+        c.JSON(http.StatusForbidden, gin.H{"error": "권한이 없습니다"})
+    case errors.Is(err, service.ErrInvalidBannedWord):
+        c.JSON(http.StatusBadRequest, gin.H{"error": "금칙어에는 글자나 숫자가 있어야 합니다", "code": "INVALID_BANNED_WORD"})
+    case errors.Is(err, repository.ErrBannedWordExists):
+        c.JSON(http.StatusConflict, gin.H{"error": "이미 등록된 금칙어입니다", "code": "BANNED_WORD_EXISTS"})
+    case errors.Is(err, repository.ErrBannedWordNotFound):
+        c.JSON(http.StatusNotFound, gin.H{"error": "금칙어를 찾을 수 없습니다"})
+    default:
+        c.JSON(http.StatusInternalServerError, gin.H{"error": "서버 오류"})
+    }
+}
+
+// respondWordFilterError 금칙어로 거부된 경우 걸린 부분을 details로 응답 (아니면 false)
+func respondWordFilterError(c *gin.Context, err error) bool {
+    var filtered *service.WordFilterError
+    if !errors.As(err, &filtered) {
+        return false
+    }
+
+    c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{
+        Error:   "사용할 수 없는 단어가 포함되어 있습니다",
+        Code:    "BANNED_WORD",
+        Details: filtered.Hits,
+    })
+    return true
+}
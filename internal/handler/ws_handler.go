@@ -5,6 +5,7 @@ import (
 
     "github.com/gin-gonic/gin"
     "github.com/gorilla/websocket"
+    "yourproject/internal/middleware"
     "yourproject/internal/ws"
 )
 
@@ -16,7 +17,7 @@ var upgrader = websocket.Upgrader{
 
 func (h *WSHandler) HandleWebSocket(c *gin.Context) {
     // 인증 확인
-    userID, exists := c.Get("userID")
+    claims, exists := middleware.GetCurrentUser(c)
     if !exists {
         c.JSON(http.StatusUnauthorized, gin.H{"error": "인증 필요"})
         return
@@ -27,5 +28,5 @@ func (h *WSHandler) HandleWebSocket(c *gin.Context) {
         return
     }
 
-    ws.ServeWS(h.hub, conn, userID.(uint))
+    ws.ServeWS(h.hub, conn, claims.UserID, claims.SessionID)
 }
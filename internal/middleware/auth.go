@@ -0,0 +1,159 @@
+package middleware
+
+import (
+    "context"
+    "net/http"
+    "strings"
+
+    "goboardapi/internal/domain"
+
+    "github.com/gin-gonic/gin"
+    "github.com/golang-jwt/jwt/v5"
+)
+
+// Claims 액세스 토큰 클레임
+type Claims struct {
+    UserID uint   `json:"user_id"`
+    Email  string `json:"email"`
+    Role   string `json:"role"`
+    MFA    bool   `json:"mfa,omitempty"` // 2단계 인증을 거쳐 발급된 토큰
+    // SessionID 토큰이 속한 로그인 세션 (세션 폐기 시 RequireActiveSession에서 거부)
+    SessionID uint `json:"sid,omitempty"`
+    // 개인 액세스 토큰으로 인증한 경우에만 채워진다 (JWT에는 들어가지 않음)
+    PersonalTokenID uint                `json:"-"`
+    Scopes          []domain.Permission `json:"-"`
+    jwt.RegisteredClaims
+}
+
+// IsPersonalToken 개인 액세스 토큰으로 인증한 요청인지
+func (c *Claims) IsPersonalToken() bool {
+    return c.PersonalTokenID != 0
+}
+
+// HasPermission 역할 권한 확인 (개인 액세스 토큰이면 토큰 범위도 함께 확인)
+// RBAC 미들웨어와 auth.Checker가 모두 이 메서드를 거쳐 DB 저장소(domain.HasPermission)를 조회한다.
+func (c *Claims) HasPermission(permission domain.Permission) bool {
+    if !domain.HasUserPermission(c.UserID, domain.Role(c.Role), permission) {
+        return false
+    }
+    return c.tokenAllows(permission)
+}
+
+// HasPermissionIn 전역 권한이 없어도 scope(게시판 등)에 부여된 역할로 권한을 가지는지 확인
+func (c *Claims) HasPermissionIn(ctx context.Context, permission domain.Permission, scope domain.Scope) bool {
+    if !domain.HasPermissionIn(ctx, c.UserID, domain.Role(c.Role), permission, scope) {
+        return false
+    }
+    return c.tokenAllows(permission)
+}
+
+// tokenAllows 개인 액세스 토큰이면 토큰 범위에 권한이 있는지
+func (c *Claims) tokenAllows(permission domain.Permission) bool {
+    if !c.IsPersonalToken() {
+        return true
+    }
+
+    for _, scope := range c.Scopes {
+        if scope == permission {
+            return true
+        }
+    }
+    return false
+}
+
+const userContextKey ctxKey = "user"
+
+// AccessTokenAudience 액세스 토큰 용도 (2단계 인증 대기 토큰과 구분)
+const AccessTokenAudience = "access"
+
+// PersonalTokenAuthenticator 개인 액세스 토큰 검증 (유효하지 않으면 에러)
+type PersonalTokenAuthenticator func(ctx context.Context, rawToken string) (*Claims, error)
+
+// Auth 액세스 토큰 검증 미들웨어
+func Auth(secret string) gin.HandlerFunc {
+    return AuthWithPersonalTokens(secret, nil)
+}
+
+// AuthWithPersonalTokens JWT와 함께 개인 액세스 토큰(gbpat_ 접두사)도 허용하는 인증 미들웨어
+// 이용 정지된 사용자는 토큰이 유효해도 거부한다 (SetSanctionChecker).
+func AuthWithPersonalTokens(secret string, authenticatePAT PersonalTokenAuthenticator) gin.HandlerFunc {
+    return func(c *gin.Context) {
+        header := c.GetHeader("Authorization")
+        tokenString, ok := strings.CutPrefix(header, "Bearer ")
+        if !ok || tokenString == "" {
+            c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+                "error": "인증이 필요합니다",
+            })
+            return
+        }
+
+        if strings.HasPrefix(tokenString, domain.PersonalAccessTokenPrefix) {
+            if authenticatePAT == nil {
+                c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+                    "error": "유효하지 않은 토큰입니다",
+                })
+                return
+            }
+
+            claims, err := authenticatePAT(c.Request.Context(), tokenString)
+            if err != nil {
+                c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+                    "error": "유효하지 않은 토큰입니다",
+                })
+                return
+            }
+
+            SetCurrentUser(c, claims)
+            if abortIfSuspended(c, claims.UserID) {
+                return
+            }
+            c.Next()
+            return
+        }
+
+        claims := &Claims{}
+        token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+            return []byte(secret), nil
+        }, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithAudience(AccessTokenAudience))
+        if err != nil || !token.Valid {
+            c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+                "error": "유효하지 않은 토큰입니다",
+            })
+            return
+        }
+
+        SetCurrentUser(c, claims)
+        if abortIfSuspended(c, claims.UserID) {
+            return
+        }
+        c.Next()
+    }
+}
+
+// SetCurrentUser 인증된 사용자 정보를 Gin 컨텍스트와 요청 컨텍스트에 저장
+func SetCurrentUser(c *gin.Context, claims *Claims) {
+    c.Set(string(userContextKey), claims)
+    c.Set("userID", claims.UserID)
+    c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), userContextKey, claims))
+}
+
+// GetCurrentUser Gin 컨텍스트에서 인증된 사용자 정보 조회
+func GetCurrentUser(c *gin.Context) (*Claims, bool) {
+    value, exists := c.Get(string(userContextKey))
+    if !exists {
+        return nil, false
+    }
+    claims, ok := value.(*Claims)
+    return claims, ok
+}
+
+// GetUserFromContext 요청 컨텍스트에서 인증된 사용자 정보 조회 (서비스 계층용)
+func GetUserFromContext(ctx context.Context) (*Claims, bool) {
+    claims, ok := ctx.Value(userContextKey).(*Claims)
+    return claims, ok
+}
+
+// WithUser 요청 컨텍스트가 없는 경로(WebSocket 메시지 등)에서 서비스 호출용 컨텍스트 생성
+func WithUser(ctx context.Context, claims *Claims) context.Context {
+    return context.WithValue(ctx, userContextKey, claims)
+}
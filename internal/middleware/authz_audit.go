@@ -0,0 +1,41 @@
+package middleware
+
+import (
+    "context"
+
+    "goboardapi/internal/domain"
+)
+
+// DenialRecorder 권한 거부 기록 저장 (서버 시작 시 SetDenialRecorder로 등록)
+// 요청 처리 경로에서 호출되므로 구현은 막히지 않아야 한다.
+type DenialRecorder interface {
+    RecordDenial(denial *domain.AuthzDenial)
+}
+
+var denialRecorder DenialRecorder
+
+// SetDenialRecorder 권한 거부 기록 저장소 등록
+func SetDenialRecorder(recorder DenialRecorder) {
+    denialRecorder = recorder
+}
+
+// RecordDenial 인증된 사용자의 권한 거부를 요청 ID와 함께 기록 (미인증 요청은 기록하지 않음)
+func RecordDenial(ctx context.Context, check, action, resource, reason string) {
+    if denialRecorder == nil {
+        return
+    }
+    claims, ok := GetUserFromContext(ctx)
+    if !ok {
+        return
+    }
+
+    denialRecorder.RecordDenial(&domain.AuthzDenial{
+        RequestID: RequestIDFromContext(ctx),
+        UserID:    claims.UserID,
+        Role:      claims.Role,
+        Check:     check,
+        Action:    action,
+        Resource:  resource,
+        Reason:    reason,
+    })
+}
@@ -10,14 +10,18 @@ func RequireAnyPermission(permissions ...domain.Permission) gin.HandlerFunc {
             return
         }
 
-        userRole := domain.Role(claims.Role)
         for _, p := range permissions {
-            if domain.HasPermission(userRole, p) {
+            if claims.HasPermission(p) {
                 c.Next()
                 return
             }
         }
 
+        names := make([]string, len(permissions))
+        for i, p := range permissions {
+            names[i] = string(p)
+        }
+        RecordDenial(c.Request.Context(), "RequireAnyPermission", strings.Join(names, ","), "", "none of the permissions granted")
         c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
             "error": "권한이 없습니다",
         })
@@ -35,9 +39,9 @@ func RequireAllPermissions(permissions ...domain.Permission) gin.HandlerFunc {
             return
         }
 
-        userRole := domain.Role(claims.Role)
         for _, p := range permissions {
-            if !domain.HasPermission(userRole, p) {
+            if !claims.HasPermission(p) {
+                RecordDenial(c.Request.Context(), "RequireAllPermissions", string(p), "", "permission not granted")
                 c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
                     "error":               "권한이 없습니다",
                     "missing_permission": string(p),
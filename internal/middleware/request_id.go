@@ -21,6 +21,8 @@ func RequestID() gin.HandlerFunc {
 
         // Gin 컨텍스트에 저장
         c.Set(string(RequestIDKey), requestID)
+        // 서비스 계층에서도 쓸 수 있도록 요청 컨텍스트에 저장
+        c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), RequestIDKey, requestID))
 
         // 응답 헤더에도 추가
         c.Header("X-Request-ID", requestID)
@@ -34,3 +36,14 @@ func LoggerFromContext(c *gin.Context) *slog.Logger {
     requestID, _ := c.Get(string(RequestIDKey))
     return slog.Default().With("request_id", requestID)
 }
+
+// RequestIDFromContext 요청 컨텍스트에서 요청 ID 조회 (서비스 계층용)
+func RequestIDFromContext(ctx context.Context) string {
+    requestID, _ := ctx.Value(RequestIDKey).(string)
+    return requestID
+}
+
+// LoggerFromRequestContext 요청 ID가 포함된 로거 반환 (서비스 계층용)
+func LoggerFromRequestContext(ctx context.Context) *slog.Logger {
+    return slog.Default().With("request_id", RequestIDFromContext(ctx))
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+    "context"
+    "net/http"
+
+    "goboardapi/internal/domain"
+
+    "github.com/gin-gonic/gin"
+)
+
+// SanctionChecker 사용자에게 지금 적용 중인 가장 무거운 제재 조회 (없으면 nil)
+type SanctionChecker func(ctx context.Context, userID uint) (*domain.Sanction, error)
+
+var sanctionChecker SanctionChecker
+
+// SetSanctionChecker 인증 미들웨어가 쓰는 제재 조회 등록 (등록하지 않으면 확인하지 않음)
+func SetSanctionChecker(check SanctionChecker) {
+    sanctionChecker = check
+}
+
+// abortIfSuspended 이용 정지(suspended, banned) 사용자의 요청을 거부 (거부했으면 true)
+// 작성 제한은 읽기와 계정 관리를 막지 않도록 서비스의 작성 확인(SanctionStore.CheckWrite)에 맡긴다.
+func abortIfSuspended(c *gin.Context, userID uint) bool {
+    if sanctionChecker == nil {
+        return false
+    }
+
+    sanction, err := sanctionChecker(c.Request.Context(), userID)
+    if err != nil {
+        c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+            "error": "서버 오류",
+        })
+        return true
+    }
+
+    if sanction != nil && sanction.Type.BlocksAccess() {
+        c.AbortWithStatusJSON(http.StatusForbidden, SanctionResponse(sanction))
+        return true
+    }
+    return false
+}
+
+// SanctionResponse 제재로 거부할 때의 응답 (until은 해제 시각, 영구 제재면 없음)
+func SanctionResponse(sanction *domain.Sanction) gin.H {
+    resp := gin.H{"reason": sanction.Reason}
+    switch sanction.Type {
+    case domain.SanctionBanned:
+        resp["error"] = "이용이 정지된 계정입니다"
+        resp["code"] = "ACCOUNT_BANNED"
+    case domain.SanctionSuspended:
+        resp["error"] = "일시적으로 이용이 정지된 계정입니다"
+        resp["code"] = "ACCOUNT_SUSPENDED"
+    default:
+        resp["error"] = "글 작성이 제한된 계정입니다"
+        resp["code"] = "WRITE_RESTRICTED"
+    }
+    if sanction.EndsAt != nil {
+        resp["until"] = sanction.EndsAt
+    }
+    return resp
+}
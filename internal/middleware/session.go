@@ -0,0 +1,72 @@
+package middleware
+
+import (
+    "context"
+    "net/http"
+
+    "github.com/gin-gonic/gin"
+)
+
+// SessionValidator 세션이 아직 유효한지 확인 (마지막 접속 시각 갱신 포함)
+type SessionValidator func(ctx context.Context, sessionID uint, ip string) (bool, error)
+
+// RequireActiveSession 폐기된 세션의 액세스 토큰은 만료 전이라도 거부
+// 세션이 없는 토큰(세션 도입 이전 토큰, 개인 액세스 토큰)은 그대로 허용한다.
+func RequireActiveSession(validate SessionValidator) gin.HandlerFunc {
+    return func(c *gin.Context) {
+        claims, ok := GetCurrentUser(c)
+        if !ok {
+            c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+                "error": "인증이 필요합니다",
+            })
+            return
+        }
+
+        if claims.SessionID == 0 {
+            c.Next()
+            return
+        }
+
+        active, err := validate(c.Request.Context(), claims.SessionID, c.ClientIP())
+        if err != nil {
+            c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+                "error": "서버 오류",
+            })
+            return
+        }
+
+        if !active {
+            c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+                "error": "로그아웃된 세션입니다. 다시 로그인해주세요",
+                "code":  "SESSION_REVOKED",
+            })
+            return
+        }
+
+        c.Next()
+    }
+}
+
+// ClientInfo 세션 생성에 쓰는 접속 기기 정보
+type ClientInfo struct {
+    UserAgent  string
+    IP         string
+    DeviceName string // 클라이언트가 X-Device-Name 헤더로 지정한 이름 (선택)
+}
+
+const clientInfoContextKey ctxKey = "client_info"
+
+// WithClientInfo 요청의 접속 기기 정보를 담은 컨텍스트 반환 (로그인 계열 핸들러용)
+func WithClientInfo(c *gin.Context) context.Context {
+    return context.WithValue(c.Request.Context(), clientInfoContextKey, ClientInfo{
+        UserAgent:  c.Request.UserAgent(),
+        IP:         c.ClientIP(),
+        DeviceName: c.GetHeader("X-Device-Name"),
+    })
+}
+
+// ClientInfoFromContext 컨텍스트에서 접속 기기 정보 조회
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+    info, _ := ctx.Value(clientInfoContextKey).(ClientInfo)
+    return info
+}
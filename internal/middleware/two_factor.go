@@ -0,0 +1,33 @@
+package middleware
+
+import (
+    "net/http"
+
+    "goboardapi/internal/domain"
+
+    "github.com/gin-gonic/gin"
+)
+
+// RequireTwoFactor 2단계 인증이 의무인 역할은 2단계 인증을 거친 토큰으로만 통과
+// isRequired에는 TwoFactorService.IsRequired를 넘긴다.
+func RequireTwoFactor(isRequired func(role domain.Role) bool) gin.HandlerFunc {
+    return func(c *gin.Context) {
+        claims, ok := GetCurrentUser(c)
+        if !ok {
+            c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+                "error": "인증이 필요합니다",
+            })
+            return
+        }
+
+        if isRequired(domain.Role(claims.Role)) && !claims.MFA {
+            c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+                "error": "2단계 인증을 등록한 뒤 다시 로그인해주세요",
+                "code":  "TWO_FACTOR_REQUIRED",
+            })
+            return
+        }
+
+        c.Next()
+    }
+}
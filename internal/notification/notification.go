@@ -8,10 +8,16 @@ import (
 type NotificationType string
 
 const (
-    NotificationNewComment NotificationType = "new_comment"
-    NotificationNewLike    NotificationType = "new_like"
-    NotificationNewMessage NotificationType = "new_message"
-    NotificationMention    NotificationType = "mention"
+    NotificationNewComment      NotificationType = "new_comment"
+    NotificationNewLike         NotificationType = "new_like"
+    NotificationNewMessage      NotificationType = "new_message"
+    NotificationMention         NotificationType = "mention"
+    NotificationNewFollower     NotificationType = "new_follower"
+    NotificationMessageRead     NotificationType = "message_read"
+    NotificationRoleGranted     NotificationType = "role_granted"
+    NotificationRoleExpired     NotificationType = "role_expired"
+    NotificationReportResolved  NotificationType = "report_resolved"
+    NotificationReportDismissed NotificationType = "report_dismissed"
 )
 
 type Notification struct {
@@ -0,0 +1,167 @@
+package oidc
+
+import (
+    "context"
+    "crypto/ecdsa"
+    "crypto/elliptic"
+    "crypto/rsa"
+    "encoding/base64"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "math/big"
+    "net/http"
+    "sync"
+    "time"
+)
+
+var ErrKeyNotFound = errors.New("oidc: signing key not found")
+
+// jwk JSON Web Key (RFC 7517) 중 서명 검증에 필요한 필드
+type jwk struct {
+    Kid string `json:"kid"`
+    Kty string `json:"kty"`
+    Use string `json:"use"`
+    N   string `json:"n"`
+    E   string `json:"e"`
+    Crv string `json:"crv"`
+    X   string `json:"x"`
+    Y   string `json:"y"`
+}
+
+// keySet JWKS 캐시
+// 모르는 kid가 오면 키 교체로 보고 최소 간격을 지켜 다시 받아온다.
+type keySet struct {
+    url        string
+    client     *http.Client
+    minRefresh time.Duration
+
+    mu        sync.RWMutex
+    keys      map[string]interface{}
+    fetchedAt time.Time
+}
+
+func newKeySet(url string, client *http.Client) *keySet {
+    return &keySet{
+        url:        url,
+        client:     client,
+        minRefresh: time.Minute,
+        keys:       make(map[string]interface{}),
+    }
+}
+
+// Key kid에 해당하는 공개키 조회
+func (s *keySet) Key(ctx context.Context, kid string) (interface{}, error) {
+    s.mu.RLock()
+    key, ok := s.keys[kid]
+    fetchedAt := s.fetchedAt
+    s.mu.RUnlock()
+
+    if ok {
+        return key, nil
+    }
+    if !fetchedAt.IsZero() && time.Since(fetchedAt) < s.minRefresh {
+        return nil, ErrKeyNotFound
+    }
+
+    if err := s.refresh(ctx); err != nil {
+        return nil, err
+    }
+
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    if key, ok := s.keys[kid]; ok {
+        return key, nil
+    }
+    return nil, ErrKeyNotFound
+}
+
+func (s *keySet) refresh(ctx context.Context) error {
+    req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+    if err != nil {
+        return err
+    }
+
+    resp, err := s.client.Do(req)
+    if err != nil {
+        return err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return fmt.Errorf("oidc: jwks request failed: %s", resp.Status)
+    }
+
+    var body struct {
+        Keys []jwk `json:"keys"`
+    }
+    if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+        return err
+    }
+
+    keys := make(map[string]interface{})
+    for _, k := range body.Keys {
+        if k.Use != "" && k.Use != "sig" {
+            continue
+        }
+        key, err := k.publicKey()
+        if err != nil {
+            continue // 지원하지 않는 키 형식은 건너뜀
+        }
+        keys[k.Kid] = key
+    }
+
+    s.mu.Lock()
+    s.keys = keys
+    s.fetchedAt = time.Now()
+    s.mu.Unlock()
+
+    return nil
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+    switch k.Kty {
+    case "RSA":
+        n, err := decodeBigInt(k.N)
+        if err != nil {
+            return nil, err
+        }
+        e, err := decodeBigInt(k.E)
+        if err != nil {
+            return nil, err
+        }
+        return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+    case "EC":
+        var curve elliptic.Curve
+        switch k.Crv {
+        case "P-256":
+            curve = elliptic.P256()
+        case "P-384":
+            curve = elliptic.P384()
+        case "P-521":
+            curve = elliptic.P521()
+        default:
+            return nil, fmt.Errorf("oidc: unsupported curve %q", k.Crv)
+        }
+        x, err := decodeBigInt(k.X)
+        if err != nil {
+            return nil, err
+        }
+        y, err := decodeBigInt(k.Y)
+        if err != nil {
+            return nil, err
+        }
+        return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+    }
+
+    return nil, fmt.Errorf("oidc: unsupported key type %q", k.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+    b, err := base64.RawURLEncoding.DecodeString(s)
+    if err != nil {
+        return nil, err
+    }
+    return new(big.Int).SetBytes(b), nil
+}
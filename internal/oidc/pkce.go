@@ -0,0 +1,22 @@
+package oidc
+
+import (
+    "crypto/rand"
+    "crypto/sha256"
+    "encoding/base64"
+)
+
+// RandomString URL-safe 난수 문자열 (state, nonce, code_verifier 용)
+func RandomString(n int) (string, error) {
+    buf := make([]byte, n)
+    if _, err := rand.Read(buf); err != nil {
+        return "", err
+    }
+    return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// CodeChallenge PKCE S256 code_challenge 계산 (RFC 7636)
+func CodeChallenge(verifier string) string {
+    sum := sha256.Sum256([]byte(verifier))
+    return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,230 @@
+package oidc
+
+import (
+    "context"
+    "crypto/subtle"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "net/http"
+    "net/url"
+    "strings"
+    "time"
+
+    "github.com/golang-jwt/jwt/v5"
+)
+
+var (
+    ErrInvalidIDToken = errors.New("oidc: invalid id token")
+    ErrNonceMismatch  = errors.New("oidc: nonce mismatch")
+)
+
+// ProviderConfig 외부 인증 제공자 설정
+type ProviderConfig struct {
+    Name         string   `mapstructure:"name"`   // 라우트와 DB에 쓰이는 식별자 (예: google)
+    Issuer       string   `mapstructure:"issuer"` // Discovery 기준 주소
+    ClientID     string   `mapstructure:"client_id"`
+    ClientSecret string   `mapstructure:"client_secret"`
+    RedirectURL  string   `mapstructure:"redirect_url"`
+    Scopes       []string `mapstructure:"scopes"`
+}
+
+// providerMetadata OpenID Provider Metadata 중 사용하는 항목
+type providerMetadata struct {
+    Issuer                string `json:"issuer"`
+    AuthorizationEndpoint string `json:"authorization_endpoint"`
+    TokenEndpoint         string `json:"token_endpoint"`
+    JWKSURI               string `json:"jwks_uri"`
+}
+
+// Token 토큰 엔드포인트 응답
+type Token struct {
+    AccessToken string `json:"access_token"`
+    TokenType   string `json:"token_type"`
+    IDToken     string `json:"id_token"`
+    ExpiresIn   int    `json:"expires_in"`
+}
+
+// IDToken 검증된 ID 토큰 정보
+type IDToken struct {
+    Issuer        string
+    Subject       string
+    Email         string
+    EmailVerified bool
+    Name          string
+}
+
+type idTokenClaims struct {
+    Nonce           string   `json:"nonce"`
+    Email           string   `json:"email"`
+    EmailVerified   flexBool `json:"email_verified"`
+    Name            string   `json:"name"`
+    AuthorizedParty string   `json:"azp"`
+    jwt.RegisteredClaims
+}
+
+// Provider OpenID Connect 제공자 클라이언트
+type Provider struct {
+    config   ProviderConfig
+    client   *http.Client
+    metadata providerMetadata
+    keys     *keySet
+}
+
+// NewProvider Discovery 문서를 읽어 제공자 클라이언트 생성
+func NewProvider(ctx context.Context, config ProviderConfig, client *http.Client) (*Provider, error) {
+    if client == nil {
+        client = &http.Client{Timeout: 10 * time.Second}
+    }
+
+    wellKnown := strings.TrimSuffix(config.Issuer, "/") + "/.well-known/openid-configuration"
+    req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+    if err != nil {
+        return nil, err
+    }
+
+    resp, err := client.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("oidc: discovery failed for %s: %s", config.Name, resp.Status)
+    }
+
+    var metadata providerMetadata
+    if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+        return nil, err
+    }
+
+    // 발급자 위조 방지 (OpenID Connect Discovery 4.3)
+    if metadata.Issuer != config.Issuer {
+        return nil, fmt.Errorf("oidc: issuer mismatch: expected %q, got %q", config.Issuer, metadata.Issuer)
+    }
+
+    if len(config.Scopes) == 0 {
+        config.Scopes = []string{"openid", "email", "profile"}
+    }
+
+    return &Provider{
+        config:   config,
+        client:   client,
+        metadata: metadata,
+        keys:     newKeySet(metadata.JWKSURI, client),
+    }, nil
+}
+
+// Name 제공자 식별자
+func (p *Provider) Name() string {
+    return p.config.Name
+}
+
+// AuthCodeURL 인가 요청 URL 생성 (PKCE S256)
+func (p *Provider) AuthCodeURL(state, nonce, codeVerifier string) string {
+    params := url.Values{}
+    params.Set("response_type", "code")
+    params.Set("client_id", p.config.ClientID)
+    params.Set("redirect_uri", p.config.RedirectURL)
+    params.Set("scope", strings.Join(p.config.Scopes, " "))
+    params.Set("state", state)
+    params.Set("nonce", nonce)
+    params.Set("code_challenge", CodeChallenge(codeVerifier))
+    params.Set("code_challenge_method", "S256")
+
+    sep := "?"
+    if strings.Contains(p.metadata.AuthorizationEndpoint, "?") {
+        sep = "&"
+    }
+    return p.metadata.AuthorizationEndpoint + sep + params.Encode()
+}
+
+// Exchange 인가 코드를 토큰으로 교환
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (*Token, error) {
+    form := url.Values{}
+    form.Set("grant_type", "authorization_code")
+    form.Set("code", code)
+    form.Set("redirect_uri", p.config.RedirectURL)
+    form.Set("code_verifier", codeVerifier)
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+    req.Header.Set("Accept", "application/json")
+    req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+
+    resp, err := p.client.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("oidc: token exchange failed: %s", resp.Status)
+    }
+
+    var token Token
+    if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+        return nil, err
+    }
+    if token.IDToken == "" {
+        return nil, fmt.Errorf("oidc: token response has no id_token")
+    }
+
+    return &token, nil
+}
+
+// VerifyIDToken ID 토큰의 서명(JWKS), 발급자, 대상, 만료, nonce 검증
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*IDToken, error) {
+    claims := &idTokenClaims{}
+    token, err := jwt.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (interface{}, error) {
+        kid, _ := t.Header["kid"].(string)
+        return p.keys.Key(ctx, kid)
+    },
+        jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "ES256", "ES384"}),
+        jwt.WithIssuer(p.metadata.Issuer),
+        jwt.WithAudience(p.config.ClientID),
+        jwt.WithExpirationRequired(),
+        jwt.WithIssuedAt(),
+        jwt.WithLeeway(time.Minute),
+    )
+    if err != nil || !token.Valid {
+        return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+    }
+
+    // 대상이 여러 개면 azp가 우리 클라이언트여야 한다 (OpenID Connect Core 3.1.3.7)
+    if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+        return nil, fmt.Errorf("%w: unexpected azp %q", ErrInvalidIDToken, claims.AuthorizedParty)
+    }
+
+    if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+        return nil, ErrNonceMismatch
+    }
+
+    if claims.Subject == "" {
+        return nil, fmt.Errorf("%w: missing sub", ErrInvalidIDToken)
+    }
+
+    return &IDToken{
+        Issuer:        claims.Issuer,
+        Subject:       claims.Subject,
+        Email:         claims.Email,
+        EmailVerified: bool(claims.EmailVerified),
+        Name:          claims.Name,
+    }, nil
+}
+
+// flexBool 일부 제공자는 email_verified를 문자열 "true"로 보낸다
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+    switch strings.Trim(string(data), `"`) {
+    case "true":
+        *b = true
+    default:
+        *b = false
+    }
+    return nil
+}
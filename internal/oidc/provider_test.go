@@ -0,0 +1,285 @@
+package oidc
+
+import (
+    "context"
+    "crypto/rand"
+    "crypto/rsa"
+    "encoding/base64"
+    "encoding/json"
+    "errors"
+    "math/big"
+    "net/http"
+    "net/http/httptest"
+    "net/url"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+    testClientID     = "board-client"
+    testClientSecret = "board-secret"
+    testRedirectURL  = "http://localhost:8080/api/v1/auth/oidc/stub/callback"
+)
+
+// stubServer 테스트용 로컬 OpenID Provider
+type stubServer struct {
+    *httptest.Server
+    t *testing.T
+
+    mu        sync.Mutex
+    key       *rsa.PrivateKey
+    kid       string
+    codes     map[string]stubAuthorization // code -> 인가 요청 정보
+    claims    func(c jwt.MapClaims)        // ID 토큰 클레임 조작용
+    jwksCalls int
+}
+
+type stubAuthorization struct {
+    nonce     string
+    challenge string
+}
+
+func newStubServer(t *testing.T) *stubServer {
+    t.Helper()
+
+    s := &stubServer{t: t, codes: make(map[string]stubAuthorization)}
+    s.rotateKey("key-1")
+
+    mux := http.NewServeMux()
+    mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+        json.NewEncoder(w).Encode(map[string]string{
+            "issuer":                 s.URL,
+            "authorization_endpoint": s.URL + "/authorize",
+            "token_endpoint":         s.URL + "/token",
+            "jwks_uri":               s.URL + "/jwks",
+        })
+    })
+    mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+        s.mu.Lock()
+        defer s.mu.Unlock()
+        s.jwksCalls++
+
+        json.NewEncoder(w).Encode(map[string]interface{}{
+            "keys": []map[string]string{{
+                "kid": s.kid,
+                "kty": "RSA",
+                "use": "sig",
+                "alg": "RS256",
+                "n":   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+                "e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+            }},
+        })
+    })
+    mux.HandleFunc("/token", s.handleToken)
+
+    s.Server = httptest.NewServer(mux)
+    t.Cleanup(s.Close)
+    return s
+}
+
+func (s *stubServer) rotateKey(kid string) {
+    key, err := rsa.GenerateKey(rand.Reader, 2048)
+    if err != nil {
+        s.t.Fatal(err)
+    }
+    s.mu.Lock()
+    s.key, s.kid = key, kid
+    s.mu.Unlock()
+}
+
+// authorize 사용자가 로그인 화면에서 동의한 것처럼 인가 코드를 발급
+func (s *stubServer) authorize(authURL string) (code, state string) {
+    u, err := url.Parse(authURL)
+    if err != nil {
+        s.t.Fatal(err)
+    }
+    q := u.Query()
+    if q.Get("code_challenge_method") != "S256" {
+        s.t.Fatalf("expected S256 code challenge, got %q", q.Get("code_challenge_method"))
+    }
+
+    code = "code-" + q.Get("state")
+    s.mu.Lock()
+    s.codes[code] = stubAuthorization{nonce: q.Get("nonce"), challenge: q.Get("code_challenge")}
+    s.mu.Unlock()
+    return code, q.Get("state")
+}
+
+func (s *stubServer) handleToken(w http.ResponseWriter, r *http.Request) {
+    id, secret, _ := r.BasicAuth()
+    if id != testClientID || secret != testClientSecret {
+        http.Error(w, "invalid_client", http.StatusUnauthorized)
+        return
+    }
+
+    s.mu.Lock()
+    authz, ok := s.codes[r.FormValue("code")]
+    delete(s.codes, r.FormValue("code"))
+    key, kid := s.key, s.kid
+    s.mu.Unlock()
+
+    if !ok || CodeChallenge(r.FormValue("code_verifier")) != authz.challenge {
+        http.Error(w, "invalid_grant", http.StatusBadRequest)
+        return
+    }
+
+    now := time.Now()
+    claims := jwt.MapClaims{
+        "iss":            s.URL,
+        "sub":            "stub-user-1",
+        "aud":            testClientID,
+        "iat":            now.Unix(),
+        "exp":            now.Add(time.Hour).Unix(),
+        "nonce":          authz.nonce,
+        "email":          "user@example.com",
+        "email_verified": true,
+        "name":           "Stub User",
+    }
+    if s.claims != nil {
+        s.claims(claims)
+    }
+
+    token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+    token.Header["kid"] = kid
+    idToken, err := token.SignedString(key)
+    if err != nil {
+        http.Error(w, err.Error(), http.StatusInternalServerError)
+        return
+    }
+
+    json.NewEncoder(w).Encode(Token{AccessToken: "access", TokenType: "Bearer", IDToken: idToken, ExpiresIn: 3600})
+}
+
+func (s *stubServer) provider(t *testing.T) *Provider {
+    t.Helper()
+    p, err := NewProvider(context.Background(), ProviderConfig{
+        Name:         "stub",
+        Issuer:       s.URL,
+        ClientID:     testClientID,
+        ClientSecret: testClientSecret,
+        RedirectURL:  testRedirectURL,
+    }, s.Client())
+    if err != nil {
+        t.Fatalf("NewProvider() error = %v", err)
+    }
+    return p
+}
+
+// login 인가 요청부터 ID 토큰 검증까지 전체 흐름 실행
+func (s *stubServer) login(t *testing.T, p *Provider, verifierOverride, nonceOverride string) (*IDToken, error) {
+    t.Helper()
+
+    state, _ := RandomString(16)
+    nonce, _ := RandomString(16)
+    verifier, _ := RandomString(32)
+
+    code, gotState := s.authorize(p.AuthCodeURL(state, nonce, verifier))
+    if gotState != state {
+        t.Fatalf("state = %q, want %q", gotState, state)
+    }
+
+    if verifierOverride != "" {
+        verifier = verifierOverride
+    }
+    token, err := p.Exchange(context.Background(), code, verifier)
+    if err != nil {
+        return nil, err
+    }
+
+    if nonceOverride != "" {
+        nonce = nonceOverride
+    }
+    return p.VerifyIDToken(context.Background(), token.IDToken, nonce)
+}
+
+func TestProvider_LoginFlow(t *testing.T) {
+    s := newStubServer(t)
+    p := s.provider(t)
+
+    idToken, err := s.login(t, p, "", "")
+    if err != nil {
+        t.Fatalf("login error = %v", err)
+    }
+
+    if idToken.Subject != "stub-user-1" || idToken.Email != "user@example.com" || !idToken.EmailVerified {
+        t.Errorf("unexpected id token: %+v", idToken)
+    }
+}
+
+func TestProvider_RejectsWrongCodeVerifier(t *testing.T) {
+    s := newStubServer(t)
+    p := s.provider(t)
+
+    if _, err := s.login(t, p, "not-the-verifier", ""); err == nil {
+        t.Fatal("expected token exchange to fail with wrong PKCE verifier")
+    }
+}
+
+func TestProvider_RejectsNonceMismatch(t *testing.T) {
+    s := newStubServer(t)
+    p := s.provider(t)
+
+    _, err := s.login(t, p, "", "other-nonce")
+    if !errors.Is(err, ErrNonceMismatch) {
+        t.Fatalf("error = %v, want ErrNonceMismatch", err)
+    }
+}
+
+func TestProvider_RejectsInvalidClaims(t *testing.T) {
+    tests := []struct {
+        name   string
+        mutate func(c jwt.MapClaims)
+    }{
+        {"wrong audience", func(c jwt.MapClaims) { c["aud"] = "other-client" }},
+        {"wrong issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }},
+        {"expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }},
+        {"multiple audiences without azp", func(c jwt.MapClaims) { c["aud"] = []string{testClientID, "other"} }},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            s := newStubServer(t)
+            s.claims = tt.mutate
+            p := s.provider(t)
+
+            _, err := s.login(t, p, "", "")
+            if !errors.Is(err, ErrInvalidIDToken) {
+                t.Fatalf("error = %v, want ErrInvalidIDToken", err)
+            }
+        })
+    }
+}
+
+func TestProvider_RefreshesKeysOnRotation(t *testing.T) {
+    s := newStubServer(t)
+    p := s.provider(t)
+    p.keys.minRefresh = 0
+
+    if _, err := s.login(t, p, "", ""); err != nil {
+        t.Fatalf("first login error = %v", err)
+    }
+
+    s.rotateKey("key-2")
+
+    if _, err := s.login(t, p, "", ""); err != nil {
+        t.Fatalf("login after key rotation error = %v", err)
+    }
+    if s.jwksCalls != 2 {
+        t.Errorf("jwks fetched %d times, want 2", s.jwksCalls)
+    }
+}
+
+func TestNewProvider_IssuerMismatch(t *testing.T) {
+    s := newStubServer(t)
+
+    _, err := NewProvider(context.Background(), ProviderConfig{
+        Name:   "stub",
+        Issuer: s.URL + "/", // Discovery 문서의 issuer와 다르다
+    }, s.Client())
+    if err == nil {
+        t.Fatal("expected discovery to fail")
+    }
+}
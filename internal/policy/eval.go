@@ -0,0 +1,494 @@
+package policy
+
+import (
+    "errors"
+    "fmt"
+    "math"
+    "reflect"
+    "strings"
+    "time"
+)
+
+// ErrNoSuchAttribute 조건식이 없는 속성을 참조함
+var ErrNoSuchAttribute = errors.New("no such attribute")
+
+// Function 요청마다 바인딩되는 조건식 함수 (예: has_permission)
+type Function func(args ...any) (any, error)
+
+type env struct {
+    vars  map[string]any
+    funcs map[string]Function
+}
+
+func (n literalNode) eval(*env) (any, error) {
+    return n.value, nil
+}
+
+func (n identNode) eval(e *env) (any, error) {
+    return normalize(e.vars[n.name]), nil
+}
+
+func (n selectNode) eval(e *env) (any, error) {
+    operand, err := n.operand.eval(e)
+    if err != nil {
+        return nil, err
+    }
+    m, ok := operand.(map[string]any)
+    if !ok {
+        return nil, fmt.Errorf("cannot select %q from %s", n.field, typeName(operand))
+    }
+    value, ok := m[n.field]
+    if !ok {
+        return nil, fmt.Errorf("%w: %s", ErrNoSuchAttribute, n.field)
+    }
+    return normalize(value), nil
+}
+
+func (n indexNode) eval(e *env) (any, error) {
+    operand, err := n.operand.eval(e)
+    if err != nil {
+        return nil, err
+    }
+    index, err := n.index.eval(e)
+    if err != nil {
+        return nil, err
+    }
+
+    switch operand := operand.(type) {
+    case map[string]any:
+        key, ok := index.(string)
+        if !ok {
+            return nil, fmt.Errorf("map key must be string, got %s", typeName(index))
+        }
+        value, ok := operand[key]
+        if !ok {
+            return nil, fmt.Errorf("%w: %s", ErrNoSuchAttribute, key)
+        }
+        return normalize(value), nil
+    case []any:
+        i, ok := index.(int64)
+        if !ok {
+            return nil, fmt.Errorf("list index must be int, got %s", typeName(index))
+        }
+        if i < 0 || i >= int64(len(operand)) {
+            return nil, fmt.Errorf("list index %d out of range", i)
+        }
+        return operand[i], nil
+    }
+    return nil, fmt.Errorf("cannot index %s", typeName(operand))
+}
+
+func (n listNode) eval(e *env) (any, error) {
+    items := make([]any, 0, len(n.items))
+    for _, item := range n.items {
+        v, err := item.eval(e)
+        if err != nil {
+            return nil, err
+        }
+        items = append(items, v)
+    }
+    return items, nil
+}
+
+func (n callNode) eval(e *env) (any, error) {
+    // has(x.y) 속성이 있는지 (없는 속성 참조 오류를 false로)
+    if n.name == "has" {
+        if len(n.args) != 1 {
+            return nil, fmt.Errorf("has() takes 1 argument")
+        }
+        if _, err := n.args[0].eval(e); err != nil {
+            if errors.Is(err, ErrNoSuchAttribute) {
+                return false, nil
+            }
+            return nil, err
+        }
+        return true, nil
+    }
+
+    args := make([]any, 0, len(n.args))
+    for _, arg := range n.args {
+        v, err := arg.eval(e)
+        if err != nil {
+            return nil, err
+        }
+        args = append(args, v)
+    }
+
+    if fn, ok := builtins[n.name]; ok {
+        return fn(args...)
+    }
+    if fn, ok := e.funcs[n.name]; ok {
+        v, err := fn(args...)
+        return normalize(v), err
+    }
+    return nil, fmt.Errorf("unknown function %q", n.name)
+}
+
+// builtins 항상 쓸 수 있는 함수
+var builtins = map[string]Function{
+    "duration": func(args ...any) (any, error) {
+        s, err := stringArg("duration", args)
+        if err != nil {
+            return nil, err
+        }
+        return time.ParseDuration(s)
+    },
+    "timestamp": func(args ...any) (any, error) {
+        s, err := stringArg("timestamp", args)
+        if err != nil {
+            return nil, err
+        }
+        return time.Parse(time.RFC3339, s)
+    },
+    "size": func(args ...any) (any, error) {
+        if len(args) != 1 {
+            return nil, fmt.Errorf("size() takes 1 argument")
+        }
+        switch v := args[0].(type) {
+        case string:
+            return int64(len([]rune(v))), nil
+        case []any:
+            return int64(len(v)), nil
+        case map[string]any:
+            return int64(len(v)), nil
+        }
+        return nil, fmt.Errorf("size() of %s", typeName(args[0]))
+    },
+}
+
+func stringArg(name string, args []any) (string, error) {
+    if len(args) != 1 {
+        return "", fmt.Errorf("%s() takes 1 argument", name)
+    }
+    s, ok := args[0].(string)
+    if !ok {
+        return "", fmt.Errorf("%s() argument must be string", name)
+    }
+    return s, nil
+}
+
+func (n unaryNode) eval(e *env) (any, error) {
+    v, err := n.operand.eval(e)
+    if err != nil {
+        return nil, err
+    }
+
+    switch n.op {
+    case "!":
+        b, ok := v.(bool)
+        if !ok {
+            return nil, fmt.Errorf("! requires bool, got %s", typeName(v))
+        }
+        return !b, nil
+    case "-":
+        switch v := v.(type) {
+        case int64:
+            return -v, nil
+        case float64:
+            return -v, nil
+        case time.Duration:
+            return -v, nil
+        }
+        return nil, fmt.Errorf("- requires number, got %s", typeName(v))
+    }
+    return nil, fmt.Errorf("unknown operator %q", n.op)
+}
+
+func (n binaryNode) eval(e *env) (any, error) {
+    left, err := n.left.eval(e)
+    if err != nil {
+        return nil, err
+    }
+
+    // && || 는 왼쪽만으로 결과가 정해지면 오른쪽을 평가하지 않는다
+    if n.op == "&&" || n.op == "||" {
+        l, ok := left.(bool)
+        if !ok {
+            return nil, fmt.Errorf("%s requires bool, got %s", n.op, typeName(left))
+        }
+        if (n.op == "&&" && !l) || (n.op == "||" && l) {
+            return l, nil
+        }
+        right, err := n.right.eval(e)
+        if err != nil {
+            return nil, err
+        }
+        r, ok := right.(bool)
+        if !ok {
+            return nil, fmt.Errorf("%s requires bool, got %s", n.op, typeName(right))
+        }
+        return r, nil
+    }
+
+    right, err := n.right.eval(e)
+    if err != nil {
+        return nil, err
+    }
+
+    switch n.op {
+    case "==":
+        return equal(left, right), nil
+    case "!=":
+        return !equal(left, right), nil
+    case "<", "<=", ">", ">=":
+        c, err := compare(left, right)
+        if err != nil {
+            return nil, err
+        }
+        switch n.op {
+        case "<":
+            return c < 0, nil
+        case "<=":
+            return c <= 0, nil
+        case ">":
+            return c > 0, nil
+        default:
+            return c >= 0, nil
+        }
+    case "in":
+        return contains(right, left)
+    default:
+        return arithmetic(n.op, left, right)
+    }
+}
+
+func equal(a, b any) bool {
+    if x, y, ok := numbers(a, b); ok {
+        return x == y
+    }
+    switch a := a.(type) {
+    case time.Time:
+        b, ok := b.(time.Time)
+        return ok && a.Equal(b)
+    case []any:
+        b, ok := b.([]any)
+        if !ok || len(a) != len(b) {
+            return false
+        }
+        for i := range a {
+            if !equal(a[i], b[i]) {
+                return false
+            }
+        }
+        return true
+    case map[string]any:
+        return reflect.DeepEqual(a, b)
+    }
+    return a == b
+}
+
+func compare(a, b any) (int, error) {
+    if x, y, ok := numbers(a, b); ok {
+        switch {
+        case x < y:
+            return -1, nil
+        case x > y:
+            return 1, nil
+        }
+        return 0, nil
+    }
+    switch a := a.(type) {
+    case string:
+        if b, ok := b.(string); ok {
+            return strings.Compare(a, b), nil
+        }
+    case time.Time:
+        if b, ok := b.(time.Time); ok {
+            return a.Compare(b), nil
+        }
+    case time.Duration:
+        if b, ok := b.(time.Duration); ok {
+            switch {
+            case a < b:
+                return -1, nil
+            case a > b:
+                return 1, nil
+            }
+            return 0, nil
+        }
+    }
+    return 0, fmt.Errorf("cannot compare %s and %s", typeName(a), typeName(b))
+}
+
+func contains(container, item any) (bool, error) {
+    switch c := container.(type) {
+    case []any:
+        for _, v := range c {
+            if equal(v, item) {
+                return true, nil
+            }
+        }
+        return false, nil
+    case map[string]any:
+        key, ok := item.(string)
+        if !ok {
+            return false, nil
+        }
+        _, ok = c[key]
+        return ok, nil
+    }
+    return false, fmt.Errorf("in requires list or map, got %s", typeName(container))
+}
+
+func arithmetic(op string, a, b any) (any, error) {
+    if x, ok := a.(int64); ok {
+        if y, ok := b.(int64); ok {
+            switch op {
+            case "+":
+                return x + y, nil
+            case "-":
+                return x - y, nil
+            case "*":
+                return x * y, nil
+            case "/", "%":
+                if y == 0 {
+                    return nil, fmt.Errorf("division by zero")
+                }
+                if op == "/" {
+                    return x / y, nil
+                }
+                return x % y, nil
+            }
+        }
+    }
+    if x, y, ok := numbers(a, b); ok {
+        switch op {
+        case "+":
+            return x + y, nil
+        case "-":
+            return x - y, nil
+        case "*":
+            return x * y, nil
+        case "/":
+            return x / y, nil
+        case "%":
+            return math.Mod(x, y), nil
+        }
+    }
+
+    switch a := a.(type) {
+    case string:
+        if b, ok := b.(string); ok && op == "+" {
+            return a + b, nil
+        }
+    case time.Time:
+        switch b := b.(type) {
+        case time.Time:
+            if op == "-" {
+                return a.Sub(b), nil
+            }
+        case time.Duration:
+            switch op {
+            case "+":
+                return a.Add(b), nil
+            case "-":
+                return a.Add(-b), nil
+            }
+        }
+    case time.Duration:
+        switch b := b.(type) {
+        case time.Duration:
+            switch op {
+            case "+":
+                return a + b, nil
+            case "-":
+                return a - b, nil
+            }
+        case time.Time:
+            if op == "+" {
+                return b.Add(a), nil
+            }
+        }
+    }
+    return nil, fmt.Errorf("invalid operation %s %s %s", typeName(a), op, typeName(b))
+}
+
+// numbers 둘 다 숫자면 float64로 변환
+func numbers(a, b any) (float64, float64, bool) {
+    x, ok := toFloat(a)
+    if !ok {
+        return 0, 0, false
+    }
+    y, ok := toFloat(b)
+    if !ok {
+        return 0, 0, false
+    }
+    return x, y, true
+}
+
+func toFloat(v any) (float64, bool) {
+    switch v := v.(type) {
+    case int64:
+        return float64(v), true
+    case float64:
+        return v, true
+    }
+    return 0, false
+}
+
+// normalize 속성 값을 조건식 값 타입(nil, bool, int64, float64, string, time.Time, time.Duration, []any, map[string]any)으로 변환
+func normalize(v any) any {
+    switch v := v.(type) {
+    case nil, bool, int64, float64, string, time.Time, time.Duration, []any, map[string]any:
+        return v
+    case int:
+        return int64(v)
+    case int32:
+        return int64(v)
+    case uint:
+        return int64(v)
+    case uint32:
+        return int64(v)
+    case uint64:
+        return int64(v)
+    case float32:
+        return float64(v)
+    case *time.Time:
+        if v == nil {
+            return nil
+        }
+        return *v
+    case []string:
+        items := make([]any, len(v))
+        for i, s := range v {
+            items[i] = s
+        }
+        return items
+    }
+
+    rv := reflect.ValueOf(v)
+    switch rv.Kind() {
+    case reflect.Pointer:
+        if rv.IsNil() {
+            return nil
+        }
+        return normalize(rv.Elem().Interface())
+    case reflect.String:
+        // domain.Role 같은 문자열 타입
+        return rv.String()
+    case reflect.Slice, reflect.Array:
+        items := make([]any, rv.Len())
+        for i := range items {
+            items[i] = normalize(rv.Index(i).Interface())
+        }
+        return items
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return rv.Int()
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        return int64(rv.Uint())
+    }
+    return v
+}
+
+func typeName(v any) string {
+    if v == nil {
+        return "null"
+    }
+    switch v.(type) {
+    case []any:
+        return "list"
+    case map[string]any:
+        return "map"
+    }
+    return fmt.Sprintf("%T", v)
+}
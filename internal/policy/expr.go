@@ -0,0 +1,364 @@
+package policy
+
+import (
+    "fmt"
+    "strconv"
+    "strings"
+    "unicode"
+)
+
+// 조건식 문법 (CEL의 작은 부분집합)
+//
+//  expr    = or
+//  or      = and { "||" and }
+//  and     = compare { "&&" compare }
+//  compare = sum [ ("==" | "!=" | "<" | "<=" | ">" | ">=" | "in") sum ]
+//  sum     = product { ("+" | "-") product }
+//  product = unary { ("*" | "/" | "%") unary }
+//  unary   = ("!" | "-") unary | postfix
+//  postfix = primary { "." ident | "[" expr "]" }
+//  primary = literal | ident | ident "(" [ expr { "," expr } ] ")" | "(" expr ")" | "[" [ expr { "," expr } ] "]"
+
+// roots 조건식에서 쓸 수 있는 최상위 이름
+var roots = map[string]bool{
+    "subject":  true,
+    "resource": true,
+    "context":  true,
+    "action":   true,
+}
+
+type tokenKind int
+
+const (
+    tokenEOF tokenKind = iota
+    tokenIdent
+    tokenInt
+    tokenFloat
+    tokenString
+    tokenOp
+)
+
+type token struct {
+    kind tokenKind
+    text string
+    pos  int
+}
+
+func tokenize(src string) ([]token, error) {
+    var tokens []token
+    for i := 0; i < len(src); {
+        c := rune(src[i])
+        switch {
+        case unicode.IsSpace(c):
+            i++
+        case c == '_' || unicode.IsLetter(c):
+            start := i
+            for i < len(src) && (src[i] == '_' || unicode.IsLetter(rune(src[i])) || unicode.IsDigit(rune(src[i]))) {
+                i++
+            }
+            tokens = append(tokens, token{kind: tokenIdent, text: src[start:i], pos: start})
+        case unicode.IsDigit(c):
+            start := i
+            kind := tokenInt
+            for i < len(src) && (unicode.IsDigit(rune(src[i])) || src[i] == '.') {
+                if src[i] == '.' {
+                    kind = tokenFloat
+                }
+                i++
+            }
+            tokens = append(tokens, token{kind: kind, text: src[start:i], pos: start})
+        case c == '"' || c == '\'':
+            start := i
+            var sb strings.Builder
+            i++
+            for i < len(src) && rune(src[i]) != c {
+                if src[i] == '\\' && i+1 < len(src) {
+                    i++
+                }
+                sb.WriteByte(src[i])
+                i++
+            }
+            if i >= len(src) {
+                return nil, fmt.Errorf("unterminated string at %d", start)
+            }
+            i++
+            tokens = append(tokens, token{kind: tokenString, text: sb.String(), pos: start})
+        default:
+            op := ""
+            for _, candidate := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "+", "-", "*", "/", "%", "(", ")", "[", "]", ",", "."} {
+                if strings.HasPrefix(src[i:], candidate) {
+                    op = candidate
+                    break
+                }
+            }
+            if op == "" {
+                return nil, fmt.Errorf("unexpected character %q at %d", c, i)
+            }
+            tokens = append(tokens, token{kind: tokenOp, text: op, pos: i})
+            i += len(op)
+        }
+    }
+    return append(tokens, token{kind: tokenEOF, pos: len(src)}), nil
+}
+
+// node 파싱된 조건식
+type node interface {
+    eval(env *env) (any, error)
+}
+
+type (
+    literalNode struct{ value any }
+    identNode   struct{ name string }
+    selectNode  struct {
+        operand node
+        field   string
+    }
+    indexNode struct{ operand, index node }
+    listNode  struct{ items []node }
+    callNode  struct {
+        name string
+        args []node
+    }
+    unaryNode struct {
+        op      string
+        operand node
+    }
+    binaryNode struct {
+        op          string
+        left, right node
+    }
+)
+
+type parser struct {
+    tokens []token
+    pos    int
+}
+
+// parse 조건식을 파싱한다 (빈 조건식은 항상 true)
+func parse(src string) (node, error) {
+    if strings.TrimSpace(src) == "" {
+        return literalNode{value: true}, nil
+    }
+
+    tokens, err := tokenize(src)
+    if err != nil {
+        return nil, err
+    }
+    p := &parser{tokens: tokens}
+    n, err := p.parseOr()
+    if err != nil {
+        return nil, err
+    }
+    if tok := p.peek(); tok.kind != tokenEOF {
+        return nil, fmt.Errorf("unexpected %q at %d", tok.text, tok.pos)
+    }
+    return n, nil
+}
+
+func (p *parser) peek() token {
+    return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+    tok := p.tokens[p.pos]
+    if tok.kind != tokenEOF {
+        p.pos++
+    }
+    return tok
+}
+
+// accept 다음 토큰이 ops 중 하나면 소비하고 반환
+func (p *parser) accept(ops ...string) (string, bool) {
+    tok := p.peek()
+    if tok.kind != tokenOp && !(tok.kind == tokenIdent && tok.text == "in") {
+        return "", false
+    }
+    for _, op := range ops {
+        if tok.text == op {
+            p.next()
+            return op, true
+        }
+    }
+    return "", false
+}
+
+func (p *parser) expect(op string) error {
+    if _, ok := p.accept(op); !ok {
+        tok := p.peek()
+        return fmt.Errorf("expected %q at %d", op, tok.pos)
+    }
+    return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+    return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *parser) parseAnd() (node, error) {
+    return p.parseBinary(p.parseCompare, "&&")
+}
+
+func (p *parser) parseCompare() (node, error) {
+    left, err := p.parseSum()
+    if err != nil {
+        return nil, err
+    }
+    if op, ok := p.accept("==", "!=", "<=", ">=", "<", ">", "in"); ok {
+        right, err := p.parseSum()
+        if err != nil {
+            return nil, err
+        }
+        return binaryNode{op: op, left: left, right: right}, nil
+    }
+    return left, nil
+}
+
+func (p *parser) parseSum() (node, error) {
+    return p.parseBinary(p.parseProduct, "+", "-")
+}
+
+func (p *parser) parseProduct() (node, error) {
+    return p.parseBinary(p.parseUnary, "*", "/", "%")
+}
+
+func (p *parser) parseBinary(operand func() (node, error), ops ...string) (node, error) {
+    left, err := operand()
+    if err != nil {
+        return nil, err
+    }
+    for {
+        op, ok := p.accept(ops...)
+        if !ok {
+            return left, nil
+        }
+        right, err := operand()
+        if err != nil {
+            return nil, err
+        }
+        left = binaryNode{op: op, left: left, right: right}
+    }
+}
+
+func (p *parser) parseUnary() (node, error) {
+    if op, ok := p.accept("!", "-"); ok {
+        operand, err := p.parseUnary()
+        if err != nil {
+            return nil, err
+        }
+        return unaryNode{op: op, operand: operand}, nil
+    }
+    return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (node, error) {
+    n, err := p.parsePrimary()
+    if err != nil {
+        return nil, err
+    }
+    for {
+        if _, ok := p.accept("."); ok {
+            tok := p.next()
+            if tok.kind != tokenIdent {
+                return nil, fmt.Errorf("expected field name at %d", tok.pos)
+            }
+            n = selectNode{operand: n, field: tok.text}
+            continue
+        }
+        if _, ok := p.accept("["); ok {
+            index, err := p.parseOr()
+            if err != nil {
+                return nil, err
+            }
+            if err := p.expect("]"); err != nil {
+                return nil, err
+            }
+            n = indexNode{operand: n, index: index}
+            continue
+        }
+        return n, nil
+    }
+}
+
+func (p *parser) parsePrimary() (node, error) {
+    tok := p.next()
+    switch tok.kind {
+    case tokenInt:
+        v, err := strconv.ParseInt(tok.text, 10, 64)
+        if err != nil {
+            return nil, fmt.Errorf("invalid number %q at %d", tok.text, tok.pos)
+        }
+        return literalNode{value: v}, nil
+    case tokenFloat:
+        v, err := strconv.ParseFloat(tok.text, 64)
+        if err != nil {
+            return nil, fmt.Errorf("invalid number %q at %d", tok.text, tok.pos)
+        }
+        return literalNode{value: v}, nil
+    case tokenString:
+        return literalNode{value: tok.text}, nil
+    case tokenIdent:
+        switch tok.text {
+        case "true":
+            return literalNode{value: true}, nil
+        case "false":
+            return literalNode{value: false}, nil
+        case "null":
+            return literalNode{value: nil}, nil
+        }
+        if _, ok := p.accept("("); ok {
+            args, err := p.parseList(")")
+            if err != nil {
+                return nil, err
+            }
+            return callNode{name: tok.text, args: args}, nil
+        }
+        if !roots[tok.text] {
+            return nil, fmt.Errorf("unknown name %q at %d", tok.text, tok.pos)
+        }
+        return identNode{name: tok.text}, nil
+    case tokenOp:
+        switch tok.text {
+        case "(":
+            n, err := p.parseOr()
+            if err != nil {
+                return nil, err
+            }
+            if err := p.expect(")"); err != nil {
+                return nil, err
+            }
+            return n, nil
+        case "[":
+            items, err := p.parseList("]")
+            if err != nil {
+                return nil, err
+            }
+            return listNode{items: items}, nil
+        }
+    }
+    if tok.kind == tokenEOF {
+        return nil, fmt.Errorf("unexpected end of expression")
+    }
+    return nil, fmt.Errorf("unexpected %q at %d", tok.text, tok.pos)
+}
+
+// parseList 쉼표로 구분된 식을 closing까지 읽는다
+func (p *parser) parseList(closing string) ([]node, error) {
+    var items []node
+    if _, ok := p.accept(closing); ok {
+        return items, nil
+    }
+    for {
+        item, err := p.parseOr()
+        if err != nil {
+            return nil, err
+        }
+        items = append(items, item)
+        if _, ok := p.accept(","); ok {
+            continue
+        }
+        if err := p.expect(closing); err != nil {
+            return nil, err
+        }
+        return items, nil
+    }
+}
@@ -0,0 +1,51 @@
+package policy
+
+import (
+    "fmt"
+    "os"
+    "path/filepath"
+    "sort"
+
+    "gopkg.in/yaml.v3"
+)
+
+// file 정책 파일 형식
+type file struct {
+    Policies []Policy `yaml:"policies"`
+}
+
+// Parse YAML 문서에서 정책 목록 읽기
+func Parse(data []byte) ([]Policy, error) {
+    var f file
+    if err := yaml.Unmarshal(data, &f); err != nil {
+        return nil, err
+    }
+    return f.Policies, nil
+}
+
+// LoadDir 디렉터리의 *.yaml, *.yml 파일을 이름 순으로 읽어 엔진 생성
+func LoadDir(dir string) (*Engine, error) {
+    var paths []string
+    for _, pattern := range []string{"*.yaml", "*.yml"} {
+        matched, err := filepath.Glob(filepath.Join(dir, pattern))
+        if err != nil {
+            return nil, err
+        }
+        paths = append(paths, matched...)
+    }
+    sort.Strings(paths)
+
+    var policies []Policy
+    for _, path := range paths {
+        data, err := os.ReadFile(path)
+        if err != nil {
+            return nil, err
+        }
+        parsed, err := Parse(data)
+        if err != nil {
+            return nil, fmt.Errorf("%s: %w", path, err)
+        }
+        policies = append(policies, parsed...)
+    }
+    return NewEngine(policies)
+}
@@ -0,0 +1,257 @@
+package policy
+
+import (
+    "fmt"
+    "strings"
+)
+
+// Effect 정책이 적용될 때의 결과
+type Effect string
+
+const (
+    EffectAllow Effect = "allow"
+    EffectDeny  Effect = "deny"
+)
+
+// Policy 선언형 접근 정책 (YAML 파일 한 항목)
+//
+//   - name: post-owner-edit-window
+//     effect: allow
+//     actions: [post:update]
+//     resources: [post]
+//     condition: resource.author_id == subject.id && context.now - resource.created_at < duration("24h")
+//
+// actions와 resources는 정확히 같은 이름, "post:*" 같은 접두사, "*" 중 하나로 지정한다.
+type Policy struct {
+    Name        string   `yaml:"name"`
+    Description string   `yaml:"description"`
+    Effect      Effect   `yaml:"effect"`
+    Actions     []string `yaml:"actions"`
+    Resources   []string `yaml:"resources"`
+    Condition   string   `yaml:"condition"`
+}
+
+// Resource 정책을 평가할 대상 (domain.Post 등이 구현)
+type Resource interface {
+    PolicyType() string
+    PolicyAttributes() map[string]any
+}
+
+type object struct {
+    typ   string
+    attrs map[string]any
+}
+
+func (o object) PolicyType() string               { return o.typ }
+func (o object) PolicyAttributes() map[string]any { return o.attrs }
+
+// NewResource 도메인 타입이 없는 대상 (예: 게시판 설정) 생성
+func NewResource(typ string, attrs map[string]any) Resource {
+    return object{typ: typ, attrs: attrs}
+}
+
+// Request 평가 요청
+type Request struct {
+    Action   string
+    Subject  map[string]any
+    Resource Resource
+    Context  map[string]any
+    // Functions 조건식에서 호출할 수 있는 요청별 함수
+    Functions map[string]Function
+}
+
+// Decision 평가 결과
+type Decision struct {
+    Allowed bool
+    // Policy 결정을 내린 정책 이름 (적용된 정책이 없으면 빈 문자열)
+    Policy string
+    Reason string
+}
+
+// TraceResult 정책 하나의 평가 결과
+type TraceResult string
+
+const (
+    // TraceNotMatched action이나 resource가 맞지 않아 평가하지 않음
+    TraceNotMatched TraceResult = "not_matched"
+    TraceApplied    TraceResult = "applied"
+    TraceNotApplied TraceResult = "not_applied"
+    TraceError      TraceResult = "error"
+)
+
+// Trace Explain에서 정책마다 남기는 평가 기록
+type Trace struct {
+    Policy string
+    Effect Effect
+    Result TraceResult
+    Error  string
+}
+
+type rule struct {
+    Policy
+    condition node
+}
+
+// Engine deny-overrides 방식으로 정책을 평가한다
+//
+// 적용되는 deny 정책이 하나라도 있으면 거부하고, 없을 때 allow 정책이 있으면 허용한다.
+// 적용되는 정책이 없으면 거부한다. 조건식 평가가 실패하면 deny 정책은 적용된 것으로,
+// allow 정책은 적용되지 않은 것으로 본다 (항상 거부 쪽으로 실패).
+type Engine struct {
+    rules []rule
+}
+
+// NewEngine 정책을 검증하고 조건식을 미리 파싱한다
+func NewEngine(policies []Policy) (*Engine, error) {
+    names := make(map[string]bool, len(policies))
+    rules := make([]rule, 0, len(policies))
+    for _, p := range policies {
+        if p.Name == "" {
+            return nil, fmt.Errorf("policy without name")
+        }
+        if names[p.Name] {
+            return nil, fmt.Errorf("duplicate policy %q", p.Name)
+        }
+        names[p.Name] = true
+
+        if p.Effect != EffectAllow && p.Effect != EffectDeny {
+            return nil, fmt.Errorf("policy %q: effect must be allow or deny", p.Name)
+        }
+        if len(p.Actions) == 0 || len(p.Resources) == 0 {
+            return nil, fmt.Errorf("policy %q: actions and resources are required", p.Name)
+        }
+
+        condition, err := parse(p.Condition)
+        if err != nil {
+            return nil, fmt.Errorf("policy %q: %w", p.Name, err)
+        }
+        rules = append(rules, rule{Policy: p, condition: condition})
+    }
+    return &Engine{rules: rules}, nil
+}
+
+// Policies 등록된 정책
+func (e *Engine) Policies() []Policy {
+    policies := make([]Policy, len(e.rules))
+    for i, r := range e.rules {
+        policies[i] = r.Policy
+    }
+    return policies
+}
+
+// Evaluate 요청에 적용되는 정책을 평가해 결정한다 (deny가 나오면 나머지는 평가하지 않음)
+func (e *Engine) Evaluate(req Request) Decision {
+    decision, _ := e.run(req, false)
+    return decision
+}
+
+// Explain Evaluate와 같은 결정과 함께 모든 정책의 평가 기록을 반환한다
+func (e *Engine) Explain(req Request) (Decision, []Trace) {
+    return e.run(req, true)
+}
+
+func (e *Engine) run(req Request, explain bool) (Decision, []Trace) {
+    resourceType := ""
+    resourceAttrs := map[string]any{}
+    if req.Resource != nil {
+        resourceType = req.Resource.PolicyType()
+        if attrs := req.Resource.PolicyAttributes(); attrs != nil {
+            resourceAttrs = attrs
+        }
+    }
+
+    ev := &env{
+        vars: map[string]any{
+            "subject":  orEmpty(req.Subject),
+            "resource": resourceAttrs,
+            "context":  orEmpty(req.Context),
+            "action":   req.Action,
+        },
+        funcs: req.Functions,
+    }
+
+    var (
+        traces []Trace
+        deny   *Decision
+        allow  *rule
+    )
+    for i := range e.rules {
+        r := &e.rules[i]
+        trace := Trace{Policy: r.Name, Effect: r.Effect}
+        if !matches(r.Actions, req.Action) || !matches(r.Resources, resourceType) {
+            if explain {
+                trace.Result = TraceNotMatched
+                traces = append(traces, trace)
+            }
+            continue
+        }
+
+        applies, err := r.evaluate(ev)
+        switch {
+        case err != nil:
+            trace.Result, trace.Error = TraceError, err.Error()
+        case applies:
+            trace.Result = TraceApplied
+        default:
+            trace.Result = TraceNotApplied
+        }
+        if explain {
+            traces = append(traces, trace)
+        }
+
+        if r.Effect == EffectDeny && deny == nil {
+            switch {
+            case err != nil:
+                deny = &Decision{Policy: r.Name, Reason: "condition error: " + err.Error()}
+            case applies:
+                deny = &Decision{Policy: r.Name, Reason: "denied by policy"}
+            }
+            if deny != nil && !explain {
+                return *deny, nil
+            }
+        }
+        if r.Effect == EffectAllow && applies && err == nil && allow == nil {
+            allow = r
+        }
+    }
+
+    switch {
+    case deny != nil:
+        return *deny, traces
+    case allow != nil:
+        return Decision{Allowed: true, Policy: allow.Name, Reason: "allowed by policy"}, traces
+    }
+    return Decision{Reason: "no applicable policy"}, traces
+}
+
+func (r *rule) evaluate(ev *env) (bool, error) {
+    v, err := r.condition.eval(ev)
+    if err != nil {
+        return false, err
+    }
+    b, ok := v.(bool)
+    if !ok {
+        return false, fmt.Errorf("condition must be bool, got %s", typeName(v))
+    }
+    return b, nil
+}
+
+// matches 정확히 같은 이름, "prefix:*", "*" 지원
+func matches(patterns []string, name string) bool {
+    for _, pattern := range patterns {
+        if pattern == "*" || pattern == name {
+            return true
+        }
+        if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(name, prefix) {
+            return true
+        }
+    }
+    return false
+}
+
+func orEmpty(m map[string]any) map[string]any {
+    if m == nil {
+        return map[string]any{}
+    }
+    return m
+}
@@ -0,0 +1,263 @@
+package policy
+
+import (
+    "os"
+    "path/filepath"
+    "testing"
+    "time"
+)
+
+func TestExpressions(t *testing.T) {
+    now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+    boardID := uint(3)
+    ev := &env{
+        vars: map[string]any{
+            "subject":  map[string]any{"id": uint(7), "role": "user", "tags": []string{"a", "b"}},
+            "resource": map[string]any{"author_id": uint(7), "board_id": &boardID, "parent_id": (*uint)(nil), "created_at": now.Add(-2 * time.Hour)},
+            "context":  map[string]any{"now": now},
+            "action":   "post:update",
+        },
+        funcs: map[string]Function{
+            "is_even": func(args ...any) (any, error) { return args[0].(int64)%2 == 0, nil },
+        },
+    }
+
+    tests := []struct {
+        expr string
+        want any
+    }{
+        {`resource.author_id == subject.id`, true},
+        {`resource.board_id == 3`, true},
+        {`resource.parent_id == null`, true},
+        {`subject.role in ["admin", "moderator"]`, false},
+        {`"b" in subject.tags`, true},
+        {`!(subject.role == "admin") && action == "post:update"`, true},
+        {`context.now - resource.created_at < duration("24h")`, true},
+        {`resource.created_at + duration("3h") > context.now`, true},
+        {`1 + 2 * 3 == 7`, true},
+        {`7 / 2`, int64(3)},
+        {`7.0 / 2 == 3.5`, true},
+        {`size("안녕") == 2 && size(subject.tags) == 2`, true},
+        {`has(resource.locked) || has(subject.id)`, true},
+        {`subject["role"] == "user"`, true},
+        {`is_even(4)`, true},
+        // 왼쪽으로 결과가 정해지면 오른쪽의 오류는 평가하지 않는다
+        {`false && resource.missing`, false},
+        {`true || resource.missing`, true},
+        {``, true},
+    }
+    for _, tt := range tests {
+        t.Run(tt.expr, func(t *testing.T) {
+            n, err := parse(tt.expr)
+            if err != nil {
+                t.Fatalf("parse() error = %v", err)
+            }
+            got, err := n.eval(ev)
+            if err != nil {
+                t.Fatalf("eval() error = %v", err)
+            }
+            if !equal(got, tt.want) {
+                t.Errorf("eval() = %v, want %v", got, tt.want)
+            }
+        })
+    }
+}
+
+func TestExpressionErrors(t *testing.T) {
+    parseErrors := []string{
+        `resource.author_id ==`,
+        `user.id == 1`,
+        `"unterminated`,
+        `(1 + 2`,
+        `1 # 2`,
+    }
+    for _, expr := range parseErrors {
+        if _, err := parse(expr); err == nil {
+            t.Errorf("parse(%q) expected error", expr)
+        }
+    }
+
+    ev := &env{vars: map[string]any{"resource": map[string]any{"n": 1}}}
+    evalErrors := []string{
+        `resource.missing == 1`,
+        `resource.n && true`,
+        `resource.n < "a"`,
+        `resource.n / 0`,
+        `unknown_fn()`,
+    }
+    for _, expr := range evalErrors {
+        n, err := parse(expr)
+        if err != nil {
+            t.Fatalf("parse(%q) error = %v", expr, err)
+        }
+        if _, err := n.eval(ev); err == nil {
+            t.Errorf("eval(%q) expected error", expr)
+        }
+    }
+}
+
+func TestEngineDenyOverrides(t *testing.T) {
+    engine, err := NewEngine([]Policy{
+        {Name: "owner-edit", Effect: EffectAllow, Actions: []string{"post:update"}, Resources: []string{"post"},
+            Condition: `resource.author_id == subject.id && context.now - resource.created_at < duration("24h")`},
+        {Name: "admin-all", Effect: EffectAllow, Actions: []string{"post:*"}, Resources: []string{"*"},
+            Condition: `subject.role == "admin"`},
+        {Name: "locked", Effect: EffectDeny, Actions: []string{"post:update"}, Resources: []string{"post"},
+            Condition: `resource.locked`},
+    })
+    if err != nil {
+        t.Fatalf("NewEngine() error = %v", err)
+    }
+
+    now := time.Now()
+    post := func(authorID uint, age time.Duration, locked bool) Resource {
+        return NewResource("post", map[string]any{"author_id": authorID, "created_at": now.Add(-age), "locked": locked})
+    }
+    owner := map[string]any{"id": uint(1), "role": "user"}
+    admin := map[string]any{"id": uint(2), "role": "admin"}
+
+    tests := []struct {
+        name       string
+        subject    map[string]any
+        resource   Resource
+        wantAllow  bool
+        wantPolicy string
+    }{
+        {"owner within window", owner, post(1, time.Hour, false), true, "owner-edit"},
+        {"owner after window", owner, post(1, 48*time.Hour, false), false, ""},
+        {"other user", map[string]any{"id": uint(9), "role": "user"}, post(1, time.Hour, false), false, ""},
+        {"admin", admin, post(1, 48*time.Hour, false), true, "admin-all"},
+        {"deny overrides owner", owner, post(1, time.Hour, true), false, "locked"},
+        {"deny overrides admin", admin, post(1, time.Hour, true), false, "locked"},
+        // 조건식 오류: deny 정책은 적용된 것으로 본다
+        {"deny condition error", admin, NewResource("post", map[string]any{"author_id": uint(1), "created_at": now}), false, "locked"},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got := engine.Evaluate(Request{
+                Action:   "post:update",
+                Subject:  tt.subject,
+                Resource: tt.resource,
+                Context:  map[string]any{"now": now},
+            })
+            if got.Allowed != tt.wantAllow || got.Policy != tt.wantPolicy {
+                t.Errorf("Evaluate() = %+v, want allowed=%v policy=%q", got, tt.wantAllow, tt.wantPolicy)
+            }
+        })
+    }
+
+    if got := engine.Evaluate(Request{Action: "comment:delete", Subject: admin}); got.Allowed {
+        t.Errorf("unmatched action should be denied, got %+v", got)
+    }
+}
+
+func TestNewEngineValidation(t *testing.T) {
+    invalid := [][]Policy{
+        {{Effect: EffectAllow, Actions: []string{"a"}, Resources: []string{"r"}}},
+        {{Name: "x", Effect: "maybe", Actions: []string{"a"}, Resources: []string{"r"}}},
+        {{Name: "x", Effect: EffectAllow, Resources: []string{"r"}}},
+        {{Name: "x", Effect: EffectAllow, Actions: []string{"a"}, Resources: []string{"r"}, Condition: "subject.id =="}},
+        {
+            {Name: "x", Effect: EffectAllow, Actions: []string{"a"}, Resources: []string{"r"}},
+            {Name: "x", Effect: EffectDeny, Actions: []string{"a"}, Resources: []string{"r"}},
+        },
+    }
+    for i, policies := range invalid {
+        if _, err := NewEngine(policies); err == nil {
+            t.Errorf("case %d: expected error", i)
+        }
+    }
+}
+
+func TestLoadDir(t *testing.T) {
+    dir := t.TempDir()
+    writeFile(t, filepath.Join(dir, "a.yaml"), `
+policies:
+  - name: read
+    effect: allow
+    actions: [post:read]
+    resources: [post]
+`)
+    writeFile(t, filepath.Join(dir, "b.yml"), `
+policies:
+  - name: guest-deny
+    effect: deny
+    actions: ["*"]
+    resources: ["*"]
+    condition: "!subject.authenticated"
+`)
+    writeFile(t, filepath.Join(dir, "ignored.txt"), `not yaml`)
+
+    engine, err := LoadDir(dir)
+    if err != nil {
+        t.Fatalf("LoadDir() error = %v", err)
+    }
+    if got := len(engine.Policies()); got != 2 {
+        t.Fatalf("len(Policies()) = %d, want 2", got)
+    }
+
+    post := NewResource("post", nil)
+    if d := engine.Evaluate(Request{Action: "post:read", Subject: map[string]any{"authenticated": true}, Resource: post}); !d.Allowed {
+        t.Errorf("authenticated read should be allowed, got %+v", d)
+    }
+    if d := engine.Evaluate(Request{Action: "post:read", Subject: map[string]any{"authenticated": false}, Resource: post}); d.Allowed {
+        t.Errorf("guest read should be denied, got %+v", d)
+    }
+}
+
+func TestShippedPolicies(t *testing.T) {
+    if _, err := LoadDir(filepath.Join("..", "..", "config", "policies")); err != nil {
+        t.Fatalf("config/policies: %v", err)
+    }
+}
+
+func writeFile(t *testing.T, path, content string) {
+    t.Helper()
+    if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+        t.Fatal(err)
+    }
+}
+
+func TestEngineExplain(t *testing.T) {
+    engine, err := NewEngine([]Policy{
+        {Name: "locked", Effect: EffectDeny, Actions: []string{"post:update"}, Resources: []string{"post"}, Condition: `resource.locked`},
+        {Name: "owner", Effect: EffectAllow, Actions: []string{"post:update"}, Resources: []string{"post"}, Condition: `resource.author_id == subject.id`},
+        {Name: "broken", Effect: EffectAllow, Actions: []string{"post:*"}, Resources: []string{"post"}, Condition: `resource.missing`},
+        {Name: "comments", Effect: EffectAllow, Actions: []string{"comment:*"}, Resources: []string{"comment"}},
+    })
+    if err != nil {
+        t.Fatalf("NewEngine() error = %v", err)
+    }
+
+    req := Request{
+        Action:   "post:update",
+        Subject:  map[string]any{"id": uint(1)},
+        Resource: NewResource("post", map[string]any{"author_id": uint(1), "locked": true}),
+    }
+    decision, traces := engine.Explain(req)
+    if decision != engine.Evaluate(req) {
+        t.Errorf("Explain() decision %+v differs from Evaluate()", decision)
+    }
+    if decision.Allowed || decision.Policy != "locked" {
+        t.Errorf("decision = %+v, want denied by locked", decision)
+    }
+
+    // deny 이후의 정책도 모두 기록한다
+    want := map[string]TraceResult{
+        "locked":   TraceApplied,
+        "owner":    TraceApplied,
+        "broken":   TraceError,
+        "comments": TraceNotMatched,
+    }
+    if len(traces) != len(want) {
+        t.Fatalf("len(traces) = %d, want %d", len(traces), len(want))
+    }
+    for _, trace := range traces {
+        if trace.Result != want[trace.Policy] {
+            t.Errorf("trace %s = %s, want %s", trace.Policy, trace.Result, want[trace.Policy])
+        }
+        if trace.Result == TraceError && trace.Error == "" {
+            t.Errorf("trace %s should carry the error", trace.Policy)
+        }
+    }
+}
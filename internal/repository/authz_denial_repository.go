@@ -0,0 +1,70 @@
+package repository
+
+import (
+    "context"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+// AuthzDenialQuery 권한 거부 기록 조회 조건 (zero 값인 조건은 무시)
+type AuthzDenialQuery struct {
+    UserID    uint
+    RequestID string
+    Since     time.Time
+    // BeforeID 이 ID보다 이전 기록 (0이면 최신부터)
+    BeforeID uint
+    Limit    int
+}
+
+type AuthzDenialRepository interface {
+    CreateBatch(ctx context.Context, denials []*domain.AuthzDenial) error
+    // Find 최신순 조회
+    Find(ctx context.Context, q AuthzDenialQuery) ([]*domain.AuthzDenial, error)
+    // DeleteOlderThan 보관 기간이 지난 기록 정리
+    DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
+}
+
+type authzDenialRepository struct {
+    db *gorm.DB
+}
+
+func NewAuthzDenialRepository(db *gorm.DB) AuthzDenialRepository {
+    return &authzDenialRepository{db: db}
+}
+
+func (r *authzDenialRepository) CreateBatch(ctx context.Context, denials []*domain.AuthzDenial) error {
+    if len(denials) == 0 {
+        return nil
+    }
+    return r.db.WithContext(ctx).CreateInBatches(denials, 100).Error
+}
+
+func (r *authzDenialRepository) Find(ctx context.Context, q AuthzDenialQuery) ([]*domain.AuthzDenial, error) {
+    query := r.db.WithContext(ctx).Model(&domain.AuthzDenial{})
+    if q.UserID > 0 {
+        query = query.Where("user_id = ?", q.UserID)
+    }
+    if q.RequestID != "" {
+        query = query.Where("request_id = ?", q.RequestID)
+    }
+    if !q.Since.IsZero() {
+        query = query.Where("created_at >= ?", q.Since)
+    }
+    if q.BeforeID > 0 {
+        query = query.Where("id < ?", q.BeforeID)
+    }
+
+    var denials []*domain.AuthzDenial
+    err := query.Order("id DESC").Limit(q.Limit).Find(&denials).Error
+    return denials, err
+}
+
+func (r *authzDenialRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+    result := r.db.WithContext(ctx).
+        Where("created_at < ?", before).
+        Delete(&domain.AuthzDenial{})
+    return result.RowsAffected, result.Error
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+    "context"
+    "errors"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+var (
+    ErrBannedWordNotFound = errors.New("banned word not found")
+    ErrBannedWordExists   = errors.New("banned word already exists")
+)
+
+type BannedWordRepository interface {
+    // List 전체 금칙어 (등록 순, 검사기 캐시용)
+    List(ctx context.Context) ([]*domain.BannedWord, error)
+    // Create 같은 단어가 있으면 ErrBannedWordExists
+    Create(ctx context.Context, word *domain.BannedWord) error
+    // Update 단어와 처리 변경 (다른 항목과 단어가 겹치면 ErrBannedWordExists)
+    Update(ctx context.Context, word *domain.BannedWord) error
+    Delete(ctx context.Context, id uint) error
+}
+
+type bannedWordRepository struct {
+    db *gorm.DB
+}
+
+func NewBannedWordRepository(db *gorm.DB) BannedWordRepository {
+    return &bannedWordRepository{db: db}
+}
+
+func (r *bannedWordRepository) List(ctx context.Context) ([]*domain.BannedWord, error) {
+    var words []*domain.BannedWord
+    err := r.db.WithContext(ctx).
+        Order("id ASC").
+        Find(&words).Error
+    return words, err
+}
+
+func (r *bannedWordRepository) Create(ctx context.Context, word *domain.BannedWord) error {
+    result := r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(word)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrBannedWordExists
+    }
+    return nil
+}
+
+func (r *bannedWordRepository) Update(ctx context.Context, word *domain.BannedWord) error {
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var count int64
+        if err := tx.Model(&domain.BannedWord{}).
+            Where("word = ? AND id <> ?", word.Word, word.ID).
+            Count(&count).Error; err != nil {
+            return err
+        }
+        if count > 0 {
+            return ErrBannedWordExists
+        }
+
+        result := tx.Model(&domain.BannedWord{}).
+            Where("id = ?", word.ID).
+            Updates(map[string]interface{}{"word": word.Word, "action": word.Action})
+        if result.Error != nil {
+            return result.Error
+        }
+        if result.RowsAffected == 0 {
+            return ErrBannedWordNotFound
+        }
+        return tx.First(word, word.ID).Error
+    })
+}
+
+func (r *bannedWordRepository) Delete(ctx context.Context, id uint) error {
+    result := r.db.WithContext(ctx).Delete(&domain.BannedWord{}, id)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrBannedWordNotFound
+    }
+    return nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type BatchCheckpointRepository interface {
+    // Get 마지막으로 처리한 ID (기록이 없으면 0)
+    Get(ctx context.Context, name string) (uint, error)
+    // Save 처리 위치 저장 (0이면 다음 실행은 처음부터)
+    Save(ctx context.Context, name string, lastID uint, now time.Time) error
+}
+
+type batchCheckpointRepository struct {
+    db *gorm.DB
+}
+
+func NewBatchCheckpointRepository(db *gorm.DB) BatchCheckpointRepository {
+    return &batchCheckpointRepository{db: db}
+}
+
+func (r *batchCheckpointRepository) Get(ctx context.Context, name string) (uint, error) {
+    var checkpoint domain.BatchCheckpoint
+    err := r.db.WithContext(ctx).
+        Where("name = ?", name).
+        First(&checkpoint).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return 0, nil
+    }
+    return checkpoint.LastID, err
+}
+
+func (r *batchCheckpointRepository) Save(ctx context.Context, name string, lastID uint, now time.Time) error {
+    return r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{
+            Columns:   []clause.Column{{Name: "name"}},
+            DoUpdates: clause.AssignmentColumns([]string{"last_id", "updated_at"}),
+        }).
+        Create(&domain.BatchCheckpoint{Name: name, LastID: lastID, UpdatedAt: now}).Error
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+    "context"
+    "errors"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var (
+    ErrBoardNotFound = errors.New("board not found")
+)
+
+type BoardRepository interface {
+    FindByID(ctx context.Context, id uint) (*domain.Board, error)
+    FindBySlug(ctx context.Context, slug string) (*domain.Board, error)
+}
+
+type boardRepository struct {
+    db *gorm.DB
+}
+
+func NewBoardRepository(db *gorm.DB) BoardRepository {
+    return &boardRepository{db: db}
+}
+
+func (r *boardRepository) FindByID(ctx context.Context, id uint) (*domain.Board, error) {
+    var board domain.Board
+    err := r.db.WithContext(ctx).First(&board, id).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrBoardNotFound
+    }
+    return &board, err
+}
+
+func (r *boardRepository) FindBySlug(ctx context.Context, slug string) (*domain.Board, error) {
+    var board domain.Board
+    err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&board).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrBoardNotFound
+    }
+    return &board, err
+}
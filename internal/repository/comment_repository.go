@@ -27,3 +27,15 @@ func (r *commentRepository) FindByAuthorIDWithPaging(ctx context.Context, author
 
     return comments, total, err
 }
+
+// FindByPostID 게시글의 댓글 목록 (숨겨진 댓글, viewer가 차단/뮤트한 작성자와 viewer를 차단한 작성자의 댓글 제외)
+func (r *commentRepository) FindByPostID(ctx context.Context, postID, viewerID uint) ([]*domain.Comment, error) {
+    var comments []*domain.Comment
+    err := r.db.WithContext(ctx).
+        Preload("Author").
+        Scopes(HideModerated("comments.hidden_at"), HideBlockedAuthors(viewerID, "comments.author_id")).
+        Where("post_id = ?", postID).
+        Order("created_at ASC").
+        Find(&comments).Error
+    return comments, err
+}
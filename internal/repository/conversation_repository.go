@@ -0,0 +1,191 @@
+package repository
+
+import (
+    "context"
+    "errors"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var (
+    ErrConversationNotFound = errors.New("conversation not found")
+)
+
+type ConversationRepository interface {
+    // Create 대화방과 참여자 생성
+    Create(ctx context.Context, conversation *domain.Conversation, memberIDs []uint) error
+    FindByID(ctx context.Context, id uint) (*domain.Conversation, error)
+    // FindByDirectKey 1:1 대화방 조회
+    FindByDirectKey(ctx context.Context, key string) (*domain.Conversation, error)
+    // FindMember 참여자 조회 (참여자가 아니면 ErrConversationNotFound)
+    FindMember(ctx context.Context, conversationID, userID uint) (*domain.ConversationMember, error)
+    FindMembers(ctx context.Context, conversationID uint) ([]*domain.ConversationMember, error)
+    // FindByUserID 참여 중인 대화방 목록 (최근 메시지 순, 참여자 포함)
+    FindByUserID(ctx context.Context, userID uint, offset, limit int) ([]*domain.Conversation, error)
+
+    // CreateMessage 메시지 저장 후 대화방 최근 메시지 시각 갱신
+    CreateMessage(ctx context.Context, message *domain.Message) error
+    // FindMessages beforeID보다 이전 메시지를 최신순으로 조회 (beforeID가 0이면 최신부터, viewer와 차단 관계인 발신자 제외)
+    FindMessages(ctx context.Context, conversationID, viewerID, beforeID uint, limit int) ([]*domain.Message, error)
+    // MarkRead 읽음 위치를 앞으로만 이동 (변경되었으면 true)
+    MarkRead(ctx context.Context, conversationID, userID, messageID uint) (bool, error)
+    // CountUnread 대화방별 안 읽은 메시지 수 (내가 보낸 메시지 제외)
+    CountUnread(ctx context.Context, userID uint) (map[uint]int64, error)
+}
+
+type conversationRepository struct {
+    db *gorm.DB
+}
+
+func NewConversationRepository(db *gorm.DB) ConversationRepository {
+    return &conversationRepository{db: db}
+}
+
+func (r *conversationRepository) Create(ctx context.Context, conversation *domain.Conversation, memberIDs []uint) error {
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Create(conversation).Error; err != nil {
+            return err
+        }
+
+        members := make([]*domain.ConversationMember, 0, len(memberIDs))
+        for _, userID := range memberIDs {
+            members = append(members, &domain.ConversationMember{
+                ConversationID: conversation.ID,
+                UserID:         userID,
+                JoinedAt:       conversation.CreatedAt,
+            })
+        }
+        return tx.Create(&members).Error
+    })
+}
+
+func (r *conversationRepository) FindByID(ctx context.Context, id uint) (*domain.Conversation, error) {
+    var conversation domain.Conversation
+    err := r.db.WithContext(ctx).
+        Preload("Members.User").
+        First(&conversation, id).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrConversationNotFound
+    }
+    return &conversation, err
+}
+
+func (r *conversationRepository) FindByDirectKey(ctx context.Context, key string) (*domain.Conversation, error) {
+    var conversation domain.Conversation
+    err := r.db.WithContext(ctx).
+        Preload("Members.User").
+        Where("direct_key = ?", key).
+        First(&conversation).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrConversationNotFound
+    }
+    return &conversation, err
+}
+
+func (r *conversationRepository) FindMember(ctx context.Context, conversationID, userID uint) (*domain.ConversationMember, error) {
+    var member domain.ConversationMember
+    err := r.db.WithContext(ctx).
+        Where("conversation_id = ? AND user_id = ?", conversationID, userID).
+        First(&member).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrConversationNotFound
+    }
+    return &member, err
+}
+
+func (r *conversationRepository) FindMembers(ctx context.Context, conversationID uint) ([]*domain.ConversationMember, error) {
+    var members []*domain.ConversationMember
+    err := r.db.WithContext(ctx).
+        Where("conversation_id = ?", conversationID).
+        Find(&members).Error
+    return members, err
+}
+
+func (r *conversationRepository) FindByUserID(ctx context.Context, userID uint, offset, limit int) ([]*domain.Conversation, error) {
+    var conversations []*domain.Conversation
+    err := r.db.WithContext(ctx).
+        Preload("Members.User").
+        Joins("JOIN conversation_members ON conversation_members.conversation_id = conversations.id").
+        Where("conversation_members.user_id = ?", userID).
+        Order("COALESCE(conversations.last_message_at, conversations.created_at) DESC").
+        Offset(offset).
+        Limit(limit).
+        Find(&conversations).Error
+    return conversations, err
+}
+
+func (r *conversationRepository) CreateMessage(ctx context.Context, message *domain.Message) error {
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Create(message).Error; err != nil {
+            return err
+        }
+
+        // 보낸 사람은 자기 메시지까지 읽은 것으로 처리
+        if err := tx.Model(&domain.ConversationMember{}).
+            Where("conversation_id = ? AND user_id = ? AND last_read_message_id < ?", message.ConversationID, message.SenderID, message.ID).
+            Update("last_read_message_id", message.ID).Error; err != nil {
+            return err
+        }
+
+        return tx.Model(&domain.Conversation{}).
+            Where("id = ?", message.ConversationID).
+            Update("last_message_at", message.CreatedAt).Error
+    })
+}
+
+func (r *conversationRepository) FindMessages(ctx context.Context, conversationID, viewerID, beforeID uint, limit int) ([]*domain.Message, error) {
+    query := r.db.WithContext(ctx).
+        Scopes(HideBlockedAuthors(viewerID, "messages.sender_id")).
+        Where("messages.conversation_id = ?", conversationID)
+    if beforeID > 0 {
+        query = query.Where("messages.id < ?", beforeID)
+    }
+
+    var messages []*domain.Message
+    err := query.
+        Order("messages.id DESC").
+        Limit(limit).
+        Find(&messages).Error
+    return messages, err
+}
+
+func (r *conversationRepository) MarkRead(ctx context.Context, conversationID, userID, messageID uint) (bool, error) {
+    // 다른 대화방의 메시지 ID로 읽음 위치를 옮기지 못하도록 메시지 소속을 함께 확인한다
+    result := r.db.WithContext(ctx).
+        Model(&domain.ConversationMember{}).
+        Where("conversation_id = ? AND user_id = ? AND last_read_message_id < ?", conversationID, userID, messageID).
+        Where("EXISTS (SELECT 1 FROM messages WHERE messages.id = ? AND messages.conversation_id = ?)", messageID, conversationID).
+        Update("last_read_message_id", messageID)
+    if result.Error != nil {
+        return false, result.Error
+    }
+    return result.RowsAffected > 0, nil
+}
+
+func (r *conversationRepository) CountUnread(ctx context.Context, userID uint) (map[uint]int64, error) {
+    var rows []struct {
+        ConversationID uint
+        Unread         int64
+    }
+
+    err := r.db.WithContext(ctx).
+        Model(&domain.Message{}).
+        Select("messages.conversation_id, COUNT(*) AS unread").
+        Joins("JOIN conversation_members ON conversation_members.conversation_id = messages.conversation_id").
+        Where("conversation_members.user_id = ?", userID).
+        Where("messages.id > conversation_members.last_read_message_id").
+        Where("messages.sender_id <> ?", userID).
+        Group("messages.conversation_id").
+        Scan(&rows).Error
+    if err != nil {
+        return nil, err
+    }
+
+    counts := make(map[uint]int64, len(rows))
+    for _, row := range rows {
+        counts[row.ConversationID] = row.Unread
+    }
+    return counts, nil
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+var (
+    ErrDataExportNotFound   = errors.New("data export not found")
+    ErrDataExportInProgress = errors.New("data export already in progress")
+)
+
+type DataExportRepository interface {
+    // Create 대기 상태로 생성 (진행 중인 요청이 있으면 ErrDataExportInProgress)
+    Create(ctx context.Context, export *domain.DataExport) error
+    FindByID(ctx context.Context, id uint) (*domain.DataExport, error)
+    FindByTokenHash(ctx context.Context, tokenHash string) (*domain.DataExport, error)
+    FindLatestByUserID(ctx context.Context, userID uint) (*domain.DataExport, error)
+    // MarkProcessing 대기/생성 중인 요청을 생성 중으로 표시 (재시도 포함, 끝난 요청이면 ErrDataExportNotFound)
+    MarkProcessing(ctx context.Context, id uint) error
+    // MarkReady 다운로드 가능 상태로 변경하고 진행 중 표시 해제
+    MarkReady(ctx context.Context, id uint, fileKey string, fileSize int64, tokenHash string, expiresAt, completedAt time.Time) error
+    // MarkFailed 실패 처리하고 진행 중 표시 해제 (이미 끝난 요청은 그대로 둔다)
+    MarkFailed(ctx context.Context, id uint, reason string, completedAt time.Time) error
+    // MarkExpired 파일 삭제 후 만료 처리
+    MarkExpired(ctx context.Context, id uint) error
+    // FindExpired 링크가 만료된 완료 요청
+    FindExpired(ctx context.Context, now time.Time, limit int) ([]*domain.DataExport, error)
+    // FindStale before 이전에 만들어졌는데 아직 끝나지 않은 요청 (워커 중단 등)
+    FindStale(ctx context.Context, before time.Time, limit int) ([]*domain.DataExport, error)
+
+    // 내보낼 데이터 (afterID 이후를 ID 순으로 limit개)
+    FindPosts(ctx context.Context, userID, afterID uint, limit int) ([]*domain.Post, error)
+    FindComments(ctx context.Context, userID, afterID uint, limit int) ([]*domain.Comment, error)
+    FindLikes(ctx context.Context, userID, afterID uint, limit int) ([]*domain.Like, error)
+    FindNotifications(ctx context.Context, userID, afterID uint, limit int) ([]*domain.Notification, error)
+    FindWithdrawLogs(ctx context.Context, userID, afterID uint, limit int) ([]*domain.WithdrawLog, error)
+}
+
+type dataExportRepository struct {
+    db *gorm.DB
+}
+
+func NewDataExportRepository(db *gorm.DB) DataExportRepository {
+    return &dataExportRepository{db: db}
+}
+
+func (r *dataExportRepository) Create(ctx context.Context, export *domain.DataExport) error {
+    export.Status = domain.DataExportPending
+    export.ActiveUserID = &export.UserID
+
+    result := r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(export)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrDataExportInProgress
+    }
+    return nil
+}
+
+func (r *dataExportRepository) FindByID(ctx context.Context, id uint) (*domain.DataExport, error) {
+    var export domain.DataExport
+    err := r.db.WithContext(ctx).First(&export, id).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrDataExportNotFound
+    }
+    return &export, err
+}
+
+func (r *dataExportRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.DataExport, error) {
+    var export domain.DataExport
+    err := r.db.WithContext(ctx).
+        Where("token_hash = ?", tokenHash).
+        First(&export).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrDataExportNotFound
+    }
+    return &export, err
+}
+
+func (r *dataExportRepository) FindLatestByUserID(ctx context.Context, userID uint) (*domain.DataExport, error) {
+    var export domain.DataExport
+    err := r.db.WithContext(ctx).
+        Where("user_id = ?", userID).
+        Order("created_at DESC, id DESC").
+        First(&export).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrDataExportNotFound
+    }
+    return &export, err
+}
+
+func (r *dataExportRepository) MarkProcessing(ctx context.Context, id uint) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.DataExport{}).
+        Where("id = ? AND status IN ?", id, []domain.DataExportStatus{domain.DataExportPending, domain.DataExportProcessing}).
+        Update("status", domain.DataExportProcessing)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrDataExportNotFound
+    }
+    return nil
+}
+
+func (r *dataExportRepository) MarkReady(ctx context.Context, id uint, fileKey string, fileSize int64, tokenHash string, expiresAt, completedAt time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.DataExport{}).
+        Where("id = ? AND status = ?", id, domain.DataExportProcessing).
+        Updates(map[string]interface{}{
+            "status":         domain.DataExportReady,
+            "active_user_id": nil,
+            "file_key":       fileKey,
+            "file_size":      fileSize,
+            "token_hash":     tokenHash,
+            "expires_at":     expiresAt,
+            "completed_at":   completedAt,
+        })
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrDataExportNotFound
+    }
+    return nil
+}
+
+func (r *dataExportRepository) MarkFailed(ctx context.Context, id uint, reason string, completedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.DataExport{}).
+        Where("id = ? AND status IN ?", id, []domain.DataExportStatus{domain.DataExportPending, domain.DataExportProcessing}).
+        Updates(map[string]interface{}{
+            "status":         domain.DataExportFailed,
+            "active_user_id": nil,
+            "error":          reason,
+            "completed_at":   completedAt,
+        }).Error
+}
+
+func (r *dataExportRepository) MarkExpired(ctx context.Context, id uint) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.DataExport{}).
+        Where("id = ? AND status = ?", id, domain.DataExportReady).
+        Updates(map[string]interface{}{
+            "status":     domain.DataExportExpired,
+            "token_hash": nil,
+            "file_key":   "",
+        }).Error
+}
+
+func (r *dataExportRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]*domain.DataExport, error) {
+    var exports []*domain.DataExport
+    err := r.db.WithContext(ctx).
+        Where("status = ? AND expires_at <= ?", domain.DataExportReady, now).
+        Order("id ASC").
+        Limit(limit).
+        Find(&exports).Error
+    return exports, err
+}
+
+func (r *dataExportRepository) FindStale(ctx context.Context, before time.Time, limit int) ([]*domain.DataExport, error) {
+    var exports []*domain.DataExport
+    err := r.db.WithContext(ctx).
+        Where("status IN ? AND created_at < ?", []domain.DataExportStatus{domain.DataExportPending, domain.DataExportProcessing}, before).
+        Order("id ASC").
+        Limit(limit).
+        Find(&exports).Error
+    return exports, err
+}
+
+// ownedAfter 사용자 소유 행을 ID 순으로 나눠 읽기 위한 공통 조건
+func (r *dataExportRepository) ownedAfter(ctx context.Context, column string, userID, afterID uint, limit int) *gorm.DB {
+    return r.db.WithContext(ctx).
+        Where(column+" = ? AND id > ?", userID, afterID).
+        Order("id ASC").
+        Limit(limit)
+}
+
+func (r *dataExportRepository) FindPosts(ctx context.Context, userID, afterID uint, limit int) ([]*domain.Post, error) {
+    var posts []*domain.Post
+    err := r.ownedAfter(ctx, "author_id", userID, afterID, limit).Find(&posts).Error
+    return posts, err
+}
+
+func (r *dataExportRepository) FindComments(ctx context.Context, userID, afterID uint, limit int) ([]*domain.Comment, error) {
+    var comments []*domain.Comment
+    err := r.ownedAfter(ctx, "author_id", userID, afterID, limit).Find(&comments).Error
+    return comments, err
+}
+
+func (r *dataExportRepository) FindLikes(ctx context.Context, userID, afterID uint, limit int) ([]*domain.Like, error) {
+    var likes []*domain.Like
+    err := r.ownedAfter(ctx, "user_id", userID, afterID, limit).Find(&likes).Error
+    return likes, err
+}
+
+func (r *dataExportRepository) FindNotifications(ctx context.Context, userID, afterID uint, limit int) ([]*domain.Notification, error) {
+    var notifications []*domain.Notification
+    err := r.ownedAfter(ctx, "user_id", userID, afterID, limit).Find(&notifications).Error
+    return notifications, err
+}
+
+func (r *dataExportRepository) FindWithdrawLogs(ctx context.Context, userID, afterID uint, limit int) ([]*domain.WithdrawLog, error) {
+    var logs []*domain.WithdrawLog
+    err := r.ownedAfter(ctx, "user_id", userID, afterID, limit).Find(&logs).Error
+    return logs, err
+}
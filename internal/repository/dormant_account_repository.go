@@ -0,0 +1,172 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+var (
+    ErrDormantAccountNotFound = errors.New("dormant account not found")
+    ErrNotDormancyCandidate   = errors.New("user is not a dormancy candidate")
+)
+
+type DormantAccountRepository interface {
+    // MarkWarned 휴면 예정 안내 발송 표시 (마지막 활동 이후 이미 안내했거나 휴면이면 ErrNotDormancyCandidate)
+    MarkWarned(ctx context.Context, userID uint, warnedAt time.Time) error
+    // ClearWarned 안내 메일 발송에 실패했을 때 표시 되돌리기
+    ClearWarned(ctx context.Context, userID uint, warnedAt time.Time) error
+    // Archive 개인 정보를 분리 보관하고 users 행을 휴면 상태로 변경
+    // inactiveBefore 이후 활동했거나 이미 휴면이면 ErrNotDormancyCandidate (트랜잭션 안에서 호출)
+    Archive(ctx context.Context, userID uint, inactiveBefore, now time.Time) error
+    FindByEmail(ctx context.Context, email string) (*domain.DormantAccount, error)
+    FindByTokenHash(ctx context.Context, tokenHash string) (*domain.DormantAccount, error)
+    // SetToken 재활성화 링크 토큰 교체 (이전 링크는 무효)
+    SetToken(ctx context.Context, userID uint, tokenHash string, expiresAt, sentAt time.Time) error
+    // Restore 분리 보관한 개인 정보를 users 행에 되돌리고 보관 행 삭제 (트랜잭션 안에서 호출)
+    Restore(ctx context.Context, account *domain.DormantAccount, now time.Time) error
+}
+
+type dormantAccountRepository struct {
+    db *gorm.DB
+}
+
+func NewDormantAccountRepository(db *gorm.DB) DormantAccountRepository {
+    return &dormantAccountRepository{db: db}
+}
+
+func (r *dormantAccountRepository) MarkWarned(ctx context.Context, userID uint, warnedAt time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.User{}).
+        Where("id = ? AND dormant_at IS NULL", userID).
+        Where("dormancy_warned_at IS NULL OR dormancy_warned_at < COALESCE(last_login_at, created_at)").
+        Update("dormancy_warned_at", warnedAt)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrNotDormancyCandidate
+    }
+    return nil
+}
+
+func (r *dormantAccountRepository) ClearWarned(ctx context.Context, userID uint, warnedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.User{}).
+        Where("id = ? AND dormancy_warned_at = ?", userID, warnedAt).
+        Update("dormancy_warned_at", nil).Error
+}
+
+func (r *dormantAccountRepository) Archive(ctx context.Context, userID uint, inactiveBefore, now time.Time) error {
+    db := r.db.WithContext(ctx)
+
+    // 배치 조회 이후 로그인했으면 전환하지 않는다 (행을 잠가 동시 로그인과 겹치지 않도록)
+    var user domain.User
+    err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+        Where("id = ? AND dormant_at IS NULL", userID).
+        Where("COALESCE(last_login_at, created_at) < ?", inactiveBefore).
+        First(&user).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return ErrNotDormancyCandidate
+    }
+    if err != nil {
+        return err
+    }
+
+    account := &domain.DormantAccount{
+        UserID:          user.ID,
+        Email:           user.Email,
+        DisplayName:     user.DisplayName,
+        Bio:             user.Bio,
+        Website:         user.Website,
+        AvatarURL:       user.AvatarURL,
+        AvatarKey:       user.AvatarKey,
+        EmailVerifiedAt: user.EmailVerifiedAt,
+        CreatedAt:       now,
+    }
+    // 이전 재활성화가 보관 행을 남긴 채 끝났더라도 현재 값으로 덮어쓴다
+    if err := db.Clauses(clause.OnConflict{UpdateAll: true}).Create(account).Error; err != nil {
+        return err
+    }
+
+    // 유니크 컬럼인 이메일은 ID 기반 자리표시 값으로 바꾼다 (사용자 이름은 게시글 표시용으로 유지)
+    return db.Model(&domain.User{}).
+        Where("id = ?", user.ID).
+        Updates(map[string]interface{}{
+            "email":             fmt.Sprintf("dormant-%d@dormant.invalid", user.ID),
+            "display_name":      "",
+            "bio":               "",
+            "website":           "",
+            "avatar_url":        "",
+            "avatar_key":        "",
+            "email_verified_at": nil,
+            "dormant_at":        now,
+        }).Error
+}
+
+func (r *dormantAccountRepository) FindByEmail(ctx context.Context, email string) (*domain.DormantAccount, error) {
+    var account domain.DormantAccount
+    err := r.db.WithContext(ctx).
+        Where("email = ?", email).
+        First(&account).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrDormantAccountNotFound
+    }
+    return &account, err
+}
+
+func (r *dormantAccountRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.DormantAccount, error) {
+    var account domain.DormantAccount
+    err := r.db.WithContext(ctx).
+        Where("token_hash = ?", tokenHash).
+        First(&account).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrDormantAccountNotFound
+    }
+    return &account, err
+}
+
+func (r *dormantAccountRepository) SetToken(ctx context.Context, userID uint, tokenHash string, expiresAt, sentAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.DormantAccount{}).
+        Where("user_id = ?", userID).
+        Updates(map[string]interface{}{
+            "token_hash":       tokenHash,
+            "token_expires_at": expiresAt,
+            "token_sent_at":    sentAt,
+        }).Error
+}
+
+func (r *dormantAccountRepository) Restore(ctx context.Context, account *domain.DormantAccount, now time.Time) error {
+    db := r.db.WithContext(ctx)
+
+    result := db.Model(&domain.User{}).
+        Where("id = ? AND dormant_at IS NOT NULL", account.UserID).
+        Updates(map[string]interface{}{
+            "email":              account.Email,
+            "display_name":       account.DisplayName,
+            "bio":                account.Bio,
+            "website":            account.Website,
+            "avatar_url":         account.AvatarURL,
+            "avatar_key":         account.AvatarKey,
+            "email_verified_at":  account.EmailVerifiedAt,
+            "dormant_at":         nil,
+            "dormancy_warned_at": nil,
+            // 바로 다시 휴면 대상이 되지 않도록 활동 시각 갱신
+            "last_login_at": now,
+        })
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrDormantAccountNotFound
+    }
+
+    return db.Where("user_id = ?", account.UserID).Delete(&domain.DormantAccount{}).Error
+}
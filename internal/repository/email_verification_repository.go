@@ -0,0 +1,92 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var (
+    ErrVerificationNotFound = errors.New("email verification not found")
+)
+
+type EmailVerificationRepository interface {
+    Create(ctx context.Context, verification *domain.EmailVerification) error
+    FindByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailVerification, error)
+    FindLatestByUserID(ctx context.Context, userID uint) (*domain.EmailVerification, error)
+    CountSince(ctx context.Context, userID uint, since time.Time) (int64, error)
+    MarkUsed(ctx context.Context, id uint, usedAt time.Time) error
+    InvalidateByUserID(ctx context.Context, userID uint, usedAt time.Time) error
+}
+
+type emailVerificationRepository struct {
+    db *gorm.DB
+}
+
+func NewEmailVerificationRepository(db *gorm.DB) EmailVerificationRepository {
+    return &emailVerificationRepository{db: db}
+}
+
+func (r *emailVerificationRepository) Create(ctx context.Context, verification *domain.EmailVerification) error {
+    return r.db.WithContext(ctx).Create(verification).Error
+}
+
+func (r *emailVerificationRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailVerification, error) {
+    var verification domain.EmailVerification
+    err := r.db.WithContext(ctx).
+        Where("token_hash = ?", tokenHash).
+        First(&verification).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrVerificationNotFound
+    }
+    return &verification, err
+}
+
+func (r *emailVerificationRepository) FindLatestByUserID(ctx context.Context, userID uint) (*domain.EmailVerification, error) {
+    var verification domain.EmailVerification
+    err := r.db.WithContext(ctx).
+        Where("user_id = ?", userID).
+        Order("created_at DESC").
+        First(&verification).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrVerificationNotFound
+    }
+    return &verification, err
+}
+
+func (r *emailVerificationRepository) CountSince(ctx context.Context, userID uint, since time.Time) (int64, error) {
+    var count int64
+    err := r.db.WithContext(ctx).
+        Model(&domain.EmailVerification{}).
+        Where("user_id = ? AND created_at >= ?", userID, since).
+        Count(&count).Error
+    return count, err
+}
+
+// MarkUsed 토큰 사용 처리 (이미 사용된 토큰이면 ErrVerificationNotFound)
+func (r *emailVerificationRepository) MarkUsed(ctx context.Context, id uint, usedAt time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.EmailVerification{}).
+        Where("id = ? AND used_at IS NULL", id).
+        Update("used_at", usedAt)
+
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrVerificationNotFound
+    }
+    return nil
+}
+
+// InvalidateByUserID 사용자의 미사용 토큰을 모두 폐기
+func (r *emailVerificationRepository) InvalidateByUserID(ctx context.Context, userID uint, usedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.EmailVerification{}).
+        Where("user_id = ? AND used_at IS NULL", userID).
+        Update("used_at", usedAt).Error
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var (
+    ErrExternalIdentityNotFound = errors.New("external identity not found")
+    ErrLoginStateNotFound       = errors.New("oidc login state not found")
+)
+
+type ExternalIdentityRepository interface {
+    Create(ctx context.Context, identity *domain.ExternalIdentity) error
+    FindByProviderSubject(ctx context.Context, provider, subject string) (*domain.ExternalIdentity, error)
+    FindByUserID(ctx context.Context, userID uint) ([]*domain.ExternalIdentity, error)
+    Delete(ctx context.Context, userID uint, provider string) error
+
+    CreateState(ctx context.Context, state *domain.OIDCLoginState) error
+    // ConsumeState 상태를 조회하고 즉시 삭제 (같은 state로 두 번 콜백할 수 없다)
+    ConsumeState(ctx context.Context, stateHash string) (*domain.OIDCLoginState, error)
+    DeleteExpiredStates(ctx context.Context, before time.Time) (int64, error)
+}
+
+type externalIdentityRepository struct {
+    db *gorm.DB
+}
+
+func NewExternalIdentityRepository(db *gorm.DB) ExternalIdentityRepository {
+    return &externalIdentityRepository{db: db}
+}
+
+func (r *externalIdentityRepository) Create(ctx context.Context, identity *domain.ExternalIdentity) error {
+    return r.db.WithContext(ctx).Create(identity).Error
+}
+
+func (r *externalIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*domain.ExternalIdentity, error) {
+    var identity domain.ExternalIdentity
+    err := r.db.WithContext(ctx).
+        Where("provider = ? AND subject = ?", provider, subject).
+        First(&identity).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrExternalIdentityNotFound
+    }
+    return &identity, err
+}
+
+func (r *externalIdentityRepository) FindByUserID(ctx context.Context, userID uint) ([]*domain.ExternalIdentity, error) {
+    var identities []*domain.ExternalIdentity
+    err := r.db.WithContext(ctx).
+        Where("user_id = ?", userID).
+        Order("created_at ASC").
+        Find(&identities).Error
+    return identities, err
+}
+
+func (r *externalIdentityRepository) Delete(ctx context.Context, userID uint, provider string) error {
+    result := r.db.WithContext(ctx).
+        Where("user_id = ? AND provider = ?", userID, provider).
+        Delete(&domain.ExternalIdentity{})
+
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrExternalIdentityNotFound
+    }
+    return nil
+}
+
+func (r *externalIdentityRepository) CreateState(ctx context.Context, state *domain.OIDCLoginState) error {
+    return r.db.WithContext(ctx).Create(state).Error
+}
+
+func (r *externalIdentityRepository) ConsumeState(ctx context.Context, stateHash string) (*domain.OIDCLoginState, error) {
+    var state domain.OIDCLoginState
+
+    err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("state_hash = ?", stateHash).First(&state).Error; err != nil {
+            return err
+        }
+
+        result := tx.Delete(&domain.OIDCLoginState{}, state.ID)
+        if result.Error != nil {
+            return result.Error
+        }
+        if result.RowsAffected == 0 {
+            return gorm.ErrRecordNotFound // 다른 요청이 먼저 사용함
+        }
+        return nil
+    })
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrLoginStateNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    return &state, nil
+}
+
+func (r *externalIdentityRepository) DeleteExpiredStates(ctx context.Context, before time.Time) (int64, error) {
+    result := r.db.WithContext(ctx).
+        Where("expires_at < ?", before).
+        Delete(&domain.OIDCLoginState{})
+    return result.RowsAffected, result.Error
+}
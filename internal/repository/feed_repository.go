@@ -0,0 +1,114 @@
+package repository
+
+import (
+    "context"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+// HomeFeedQuery 홈 피드 조회 조건
+type HomeFeedQuery struct {
+    UserID uint
+    // PopularFollowerCount 팔로워가 이 수 이상인 작성자의 글은 feed_items 대신 posts에서 직접 합친다
+    PopularFollowerCount int64
+    // BeforeCreatedAt, BeforeID 커서 (zero 값이면 처음부터)
+    BeforeCreatedAt time.Time
+    BeforeID        uint
+    Limit           int
+}
+
+type FeedRepository interface {
+    // AddItems 피드 항목 추가 (이미 있는 항목은 무시)
+    AddItems(ctx context.Context, items []*domain.FeedItem) error
+    // Backfill 새로 팔로우한 작성자의 최근 글을 피드에 채움
+    Backfill(ctx context.Context, userID, authorID uint, limit int) error
+    // RemoveAuthor 언팔로우한 작성자의 글을 피드에서 제거
+    RemoveAuthor(ctx context.Context, userID, authorID uint) error
+    // DeleteOlderThan 오래된 피드 항목 정리
+    DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
+    // FindHome 홈 피드 (최신순)
+    FindHome(ctx context.Context, q HomeFeedQuery) ([]*domain.Post, error)
+}
+
+type feedRepository struct {
+    db *gorm.DB
+}
+
+func NewFeedRepository(db *gorm.DB) FeedRepository {
+    return &feedRepository{db: db}
+}
+
+func (r *feedRepository) AddItems(ctx context.Context, items []*domain.FeedItem) error {
+    if len(items) == 0 {
+        return nil
+    }
+    return r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(&items).Error
+}
+
+func (r *feedRepository) Backfill(ctx context.Context, userID, authorID uint, limit int) error {
+    return r.db.WithContext(ctx).Exec(`
+        INSERT INTO feed_items (user_id, post_id, author_id, created_at)
+        SELECT ?, id, author_id, created_at FROM posts
+        WHERE author_id = ? AND deleted_at IS NULL
+        ORDER BY created_at DESC
+        LIMIT ?
+        ON CONFLICT DO NOTHING`,
+        userID, authorID, limit).Error
+}
+
+func (r *feedRepository) RemoveAuthor(ctx context.Context, userID, authorID uint) error {
+    return r.db.WithContext(ctx).
+        Where("user_id = ? AND author_id = ?", userID, authorID).
+        Delete(&domain.FeedItem{}).Error
+}
+
+func (r *feedRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+    result := r.db.WithContext(ctx).
+        Where("created_at < ?", before).
+        Delete(&domain.FeedItem{})
+    return result.RowsAffected, result.Error
+}
+
+func (r *feedRepository) FindHome(ctx context.Context, q HomeFeedQuery) ([]*domain.Post, error) {
+    db := r.db.WithContext(ctx)
+
+    fannedOut := db.Model(&domain.FeedItem{}).
+        Select("post_id").
+        Where("user_id = ?", q.UserID)
+
+    popularAuthors := db.Model(&domain.Follow{}).
+        Select("follows.target_id").
+        Joins("JOIN users ON users.id = follows.target_id").
+        Where("follows.follower_id = ? AND follows.target_type = ?", q.UserID, domain.FollowTargetUser).
+        Where("users.follower_count >= ?", q.PopularFollowerCount)
+
+    boards := db.Model(&domain.Follow{}).
+        Select("target_id").
+        Where("follower_id = ? AND target_type = ?", q.UserID, domain.FollowTargetBoard)
+
+    query := db.Preload("Author").
+        Scopes(HideModerated("posts.hidden_at"), HideBlockedAuthors(q.UserID, "posts.author_id")).
+        Where(db.Where("posts.id IN (?)", fannedOut).
+            Or("posts.author_id IN (?)", popularAuthors).
+            Or("posts.board_id IN (?)", boards).
+            Or("posts.author_id = ?", q.UserID))
+
+    if !q.BeforeCreatedAt.IsZero() {
+        query = query.Where("(posts.created_at < ?) OR (posts.created_at = ? AND posts.id < ?)",
+            q.BeforeCreatedAt, q.BeforeCreatedAt, q.BeforeID)
+    }
+
+    var posts []*domain.Post
+    err := query.
+        Order("posts.created_at DESC, posts.id DESC").
+        Limit(q.Limit).
+        Find(&posts).Error
+
+    return posts, err
+}
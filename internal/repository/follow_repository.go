@@ -0,0 +1,122 @@
+package repository
+
+import (
+    "context"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type FollowRepository interface {
+    // Create 팔로우 추가 (이미 팔로우 중이면 false)
+    Create(ctx context.Context, follow *domain.Follow) (bool, error)
+    // Delete 팔로우 해제 (팔로우 중이 아니었으면 false)
+    Delete(ctx context.Context, followerID uint, targetType domain.FollowTargetType, targetID uint) (bool, error)
+    Exists(ctx context.Context, followerID uint, targetType domain.FollowTargetType, targetID uint) (bool, error)
+    // AdjustUserCounts 사용자 팔로우 수 증감 (follower의 following_count, followee의 follower_count)
+    AdjustUserCounts(ctx context.Context, followerID, followeeID uint, delta int) error
+    FindFollowers(ctx context.Context, userID uint, offset, limit int) ([]*domain.User, int64, error)
+    FindFollowing(ctx context.Context, userID uint, offset, limit int) ([]*domain.User, int64, error)
+    // FindFollowerIDsAfter fan-out용 팔로워 ID를 afterID 다음부터 limit개씩 조회
+    FindFollowerIDsAfter(ctx context.Context, userID, afterID uint, limit int) ([]uint, error)
+}
+
+type followRepository struct {
+    db *gorm.DB
+}
+
+func NewFollowRepository(db *gorm.DB) FollowRepository {
+    return &followRepository{db: db}
+}
+
+func (r *followRepository) Create(ctx context.Context, follow *domain.Follow) (bool, error) {
+    result := r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(follow)
+    if result.Error != nil {
+        return false, result.Error
+    }
+    return result.RowsAffected > 0, nil
+}
+
+func (r *followRepository) Delete(ctx context.Context, followerID uint, targetType domain.FollowTargetType, targetID uint) (bool, error) {
+    result := r.db.WithContext(ctx).
+        Where("follower_id = ? AND target_type = ? AND target_id = ?", followerID, targetType, targetID).
+        Delete(&domain.Follow{})
+    if result.Error != nil {
+        return false, result.Error
+    }
+    return result.RowsAffected > 0, nil
+}
+
+func (r *followRepository) Exists(ctx context.Context, followerID uint, targetType domain.FollowTargetType, targetID uint) (bool, error) {
+    var count int64
+    err := r.db.WithContext(ctx).
+        Model(&domain.Follow{}).
+        Where("follower_id = ? AND target_type = ? AND target_id = ?", followerID, targetType, targetID).
+        Count(&count).Error
+    return count > 0, err
+}
+
+func (r *followRepository) AdjustUserCounts(ctx context.Context, followerID, followeeID uint, delta int) error {
+    db := r.db.WithContext(ctx)
+    if err := db.Model(&domain.User{}).
+        Where("id = ?", followerID).
+        Update("following_count", gorm.Expr("following_count + ?", delta)).Error; err != nil {
+        return err
+    }
+    return db.Model(&domain.User{}).
+        Where("id = ?", followeeID).
+        Update("follower_count", gorm.Expr("follower_count + ?", delta)).Error
+}
+
+func (r *followRepository) FindFollowers(ctx context.Context, userID uint, offset, limit int) ([]*domain.User, int64, error) {
+    return r.findUsers(ctx,
+        "JOIN follows ON follows.follower_id = users.id",
+        "follows.target_type = ? AND follows.target_id = ?", domain.FollowTargetUser, userID,
+        offset, limit)
+}
+
+func (r *followRepository) FindFollowing(ctx context.Context, userID uint, offset, limit int) ([]*domain.User, int64, error) {
+    return r.findUsers(ctx,
+        "JOIN follows ON follows.target_id = users.id",
+        "follows.target_type = ? AND follows.follower_id = ?", domain.FollowTargetUser, userID,
+        offset, limit)
+}
+
+func (r *followRepository) findUsers(ctx context.Context, join, where string, targetType domain.FollowTargetType, userID uint, offset, limit int) ([]*domain.User, int64, error) {
+    var users []*domain.User
+    var total int64
+
+    query := func() *gorm.DB {
+        return r.db.WithContext(ctx).
+            Model(&domain.User{}).
+            Joins(join).
+            Where(where, targetType, userID)
+    }
+
+    if err := query().Count(&total).Error; err != nil {
+        return nil, 0, err
+    }
+
+    err := query().
+        Order("follows.created_at DESC").
+        Offset(offset).
+        Limit(limit).
+        Find(&users).Error
+
+    return users, total, err
+}
+
+func (r *followRepository) FindFollowerIDsAfter(ctx context.Context, userID, afterID uint, limit int) ([]uint, error) {
+    var ids []uint
+    err := r.db.WithContext(ctx).
+        Model(&domain.Follow{}).
+        Where("target_type = ? AND target_id = ? AND follower_id > ?", domain.FollowTargetUser, userID, afterID).
+        Order("follower_id ASC").
+        Limit(limit).
+        Pluck("follower_id", &ids).Error
+    return ids, err
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var (
+    ErrPasswordResetNotFound = errors.New("password reset not found")
+)
+
+type PasswordResetRepository interface {
+    Create(ctx context.Context, reset *domain.PasswordReset) error
+    FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordReset, error)
+    FindLatestByUserID(ctx context.Context, userID uint) (*domain.PasswordReset, error)
+    MarkUsed(ctx context.Context, id uint, usedAt time.Time) error
+    InvalidateByUserID(ctx context.Context, userID uint, usedAt time.Time) error
+}
+
+type passwordResetRepository struct {
+    db *gorm.DB
+}
+
+func NewPasswordResetRepository(db *gorm.DB) PasswordResetRepository {
+    return &passwordResetRepository{db: db}
+}
+
+func (r *passwordResetRepository) Create(ctx context.Context, reset *domain.PasswordReset) error {
+    return r.db.WithContext(ctx).Create(reset).Error
+}
+
+func (r *passwordResetRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordReset, error) {
+    var reset domain.PasswordReset
+    err := r.db.WithContext(ctx).
+        Where("token_hash = ?", tokenHash).
+        First(&reset).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrPasswordResetNotFound
+    }
+    return &reset, err
+}
+
+func (r *passwordResetRepository) FindLatestByUserID(ctx context.Context, userID uint) (*domain.PasswordReset, error) {
+    var reset domain.PasswordReset
+    err := r.db.WithContext(ctx).
+        Where("user_id = ?", userID).
+        Order("created_at DESC").
+        First(&reset).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrPasswordResetNotFound
+    }
+    return &reset, err
+}
+
+// MarkUsed 토큰 사용 처리 (이미 사용된 토큰이면 ErrPasswordResetNotFound)
+func (r *passwordResetRepository) MarkUsed(ctx context.Context, id uint, usedAt time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.PasswordReset{}).
+        Where("id = ? AND used_at IS NULL", id).
+        Update("used_at", usedAt)
+
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrPasswordResetNotFound
+    }
+    return nil
+}
+
+// InvalidateByUserID 사용자의 미사용 토큰을 모두 폐기
+func (r *passwordResetRepository) InvalidateByUserID(ctx context.Context, userID uint, usedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.PasswordReset{}).
+        Where("user_id = ? AND used_at IS NULL", userID).
+        Update("used_at", usedAt).Error
+}
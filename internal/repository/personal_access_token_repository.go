@@ -0,0 +1,98 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var (
+    ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+)
+
+type PersonalAccessTokenRepository interface {
+    Create(ctx context.Context, token *domain.PersonalAccessToken) error
+    FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error)
+    // FindActiveByUserID 폐기되지 않은 토큰 목록 (만료된 토큰 포함, 최신순)
+    FindActiveByUserID(ctx context.Context, userID uint) ([]*domain.PersonalAccessToken, error)
+    CountActiveByUserID(ctx context.Context, userID uint, now time.Time) (int64, error)
+    // Revoke 사용자의 토큰 폐기 (없거나 이미 폐기됐으면 ErrPersonalAccessTokenNotFound)
+    Revoke(ctx context.Context, id, userID uint, revokedAt time.Time) error
+    RevokeAllByUserID(ctx context.Context, userID uint, revokedAt time.Time) error
+    // TouchLastUsed 마지막 사용 시각 갱신 (since 이후에 이미 갱신됐다면 건너뜀)
+    TouchLastUsed(ctx context.Context, id uint, usedAt, since time.Time) error
+}
+
+type personalAccessTokenRepository struct {
+    db *gorm.DB
+}
+
+func NewPersonalAccessTokenRepository(db *gorm.DB) PersonalAccessTokenRepository {
+    return &personalAccessTokenRepository{db: db}
+}
+
+func (r *personalAccessTokenRepository) Create(ctx context.Context, token *domain.PersonalAccessToken) error {
+    return r.db.WithContext(ctx).Create(token).Error
+}
+
+func (r *personalAccessTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+    var token domain.PersonalAccessToken
+    err := r.db.WithContext(ctx).
+        Where("token_hash = ?", tokenHash).
+        First(&token).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrPersonalAccessTokenNotFound
+    }
+    return &token, err
+}
+
+func (r *personalAccessTokenRepository) FindActiveByUserID(ctx context.Context, userID uint) ([]*domain.PersonalAccessToken, error) {
+    var tokens []*domain.PersonalAccessToken
+    err := r.db.WithContext(ctx).
+        Where("user_id = ? AND revoked_at IS NULL", userID).
+        Order("created_at DESC").
+        Find(&tokens).Error
+    return tokens, err
+}
+
+func (r *personalAccessTokenRepository) CountActiveByUserID(ctx context.Context, userID uint, now time.Time) (int64, error) {
+    var count int64
+    err := r.db.WithContext(ctx).
+        Model(&domain.PersonalAccessToken{}).
+        Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+        Count(&count).Error
+    return count, err
+}
+
+func (r *personalAccessTokenRepository) Revoke(ctx context.Context, id, userID uint, revokedAt time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.PersonalAccessToken{}).
+        Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+        Update("revoked_at", revokedAt)
+
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrPersonalAccessTokenNotFound
+    }
+    return nil
+}
+
+func (r *personalAccessTokenRepository) RevokeAllByUserID(ctx context.Context, userID uint, revokedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.PersonalAccessToken{}).
+        Where("user_id = ? AND revoked_at IS NULL", userID).
+        Update("revoked_at", revokedAt).Error
+}
+
+func (r *personalAccessTokenRepository) TouchLastUsed(ctx context.Context, id uint, usedAt, since time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.PersonalAccessToken{}).
+        Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, since).
+        Update("last_used_at", usedAt).Error
+}
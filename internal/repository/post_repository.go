@@ -1,6 +1,8 @@
 package repository
 
 import (
+    "context"
+
     "gorm.io/plugin/dbresolver"
 )
 
@@ -22,3 +24,14 @@ func (r *PostRepository) FindByIDFromPrimary(id uint) (*Post, error) {
     err := r.db.Clauses(dbresolver.Write).First(&post, id).Error
     return &post, err
 }
+
+// CountByAuthorID - 작성자별 게시글 수 (Replica)
+func (r *PostRepository) CountByAuthorID(ctx context.Context, authorID uint) (int64, error) {
+    var count int64
+    err := r.db.WithContext(ctx).
+        Clauses(dbresolver.Read).
+        Model(&Post{}).
+        Where("author_id = ?", authorID).
+        Count(&count).Error
+    return count, err
+}
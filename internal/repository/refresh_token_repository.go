@@ -0,0 +1,82 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var (
+    ErrRefreshTokenNotFound = errors.New("refresh token not found")
+)
+
+type RefreshTokenRepository interface {
+    Create(ctx context.Context, token *domain.RefreshToken) error
+    FindByTokenHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+    Revoke(ctx context.Context, id uint, revokedAt time.Time) error
+    RevokeAllByUserID(ctx context.Context, userID uint, revokedAt time.Time) error
+    RevokeBySessionIDs(ctx context.Context, sessionIDs []uint, revokedAt time.Time) error
+}
+
+type refreshTokenRepository struct {
+    db *gorm.DB
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) RefreshTokenRepository {
+    return &refreshTokenRepository{db: db}
+}
+
+func (r *refreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+    return r.db.WithContext(ctx).Create(token).Error
+}
+
+func (r *refreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+    var token domain.RefreshToken
+    err := r.db.WithContext(ctx).
+        Where("token_hash = ?", tokenHash).
+        First(&token).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrRefreshTokenNotFound
+    }
+    return &token, err
+}
+
+// Revoke 토큰 폐기 (이미 폐기된 토큰이면 ErrRefreshTokenNotFound)
+func (r *refreshTokenRepository) Revoke(ctx context.Context, id uint, revokedAt time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.RefreshToken{}).
+        Where("id = ? AND revoked_at IS NULL", id).
+        Update("revoked_at", revokedAt)
+
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrRefreshTokenNotFound
+    }
+    return nil
+}
+
+// RevokeAllByUserID 사용자의 모든 토큰 폐기
+func (r *refreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID uint, revokedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.RefreshToken{}).
+        Where("user_id = ? AND revoked_at IS NULL", userID).
+        Update("revoked_at", revokedAt).Error
+}
+
+// RevokeBySessionIDs 세션에 속한 토큰 폐기
+func (r *refreshTokenRepository) RevokeBySessionIDs(ctx context.Context, sessionIDs []uint, revokedAt time.Time) error {
+    if len(sessionIDs) == 0 {
+        return nil
+    }
+
+    return r.db.WithContext(ctx).
+        Model(&domain.RefreshToken{}).
+        Where("session_id IN ? AND revoked_at IS NULL", sessionIDs).
+        Update("revoked_at", revokedAt).Error
+}
@@ -0,0 +1,238 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+var (
+    ErrReportExists              = errors.New("target already reported by this user")
+    ErrModerationCaseNotFound    = errors.New("moderation case not found")
+    ErrModerationCaseUnavailable = errors.New("moderation case is claimed by another moderator or already closed")
+)
+
+// ModerationQuery 모더레이션 큐 조회 조건 (비어 있는 조건은 무시)
+type ModerationQuery struct {
+    Statuses    []domain.ModerationStatus
+    Source      domain.ModerationSource
+    TargetTypes []domain.ReportTargetType
+    BoardID     uint
+    // BeforeID 이 ID보다 이전 건 (0이면 최신부터)
+    BeforeID uint
+    Limit    int
+}
+
+type ReportRepository interface {
+    // CreateReport 대상의 처리 건을 찾거나 만들고 신고를 추가한 뒤 최신 처리 건 반환
+    // 같은 신고자가 이미 신고했으면 ErrReportExists, 기각된 건이면 다시 연다.
+    CreateReport(ctx context.Context, report *domain.Report, c *domain.ModerationCase) (*domain.ModerationCase, error)
+    // CreateHold 스팸 판정으로 숨긴 채 저장한 콘텐츠를 보류 큐에 추가
+    CreateHold(ctx context.Context, c *domain.ModerationCase) error
+    // CreateFlag 검토 대상 금칙어가 포함된 콘텐츠를 큐에 추가 (대상의 처리 건이 이미 있으면 그대로 둠)
+    CreateFlag(ctx context.Context, c *domain.ModerationCase) error
+    FindCase(ctx context.Context, id uint) (*domain.ModerationCase, error)
+    // FindCases 최신순 조회
+    FindCases(ctx context.Context, q ModerationQuery) ([]*domain.ModerationCase, error)
+    // FindReports 처리 건이 (다시) 열린 뒤 들어온 신고
+    FindReports(ctx context.Context, c *domain.ModerationCase) ([]*domain.Report, error)
+    // Claim 열린 건이나 staleBefore 이전에 맡고 처리하지 않은 건을 맡는다 (아니면 ErrModerationCaseUnavailable)
+    Claim(ctx context.Context, id, moderatorID uint, now, staleBefore time.Time) error
+    // Close moderatorID가 맡은 건을 status로 닫는다 (아니면 ErrModerationCaseUnavailable)
+    Close(ctx context.Context, id, moderatorID uint, status domain.ModerationStatus, note string, now time.Time) error
+    MarkAutoHidden(ctx context.Context, id uint) error
+    // HideTarget 대상을 숨긴다 (이미 숨겨져 있으면 false)
+    HideTarget(ctx context.Context, targetType domain.ReportTargetType, targetID uint, now time.Time) (bool, error)
+    UnhideTarget(ctx context.Context, targetType domain.ReportTargetType, targetID uint) error
+}
+
+type reportRepository struct {
+    db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) ReportRepository {
+    return &reportRepository{db: db}
+}
+
+func (r *reportRepository) CreateReport(ctx context.Context, report *domain.Report, c *domain.ModerationCase) (*domain.ModerationCase, error) {
+    var current domain.ModerationCase
+    err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(c).Error; err != nil {
+            return err
+        }
+        if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+            Where("target_type = ? AND target_id = ?", c.TargetType, c.TargetID).
+            First(&current).Error; err != nil {
+            return err
+        }
+
+        report.CaseID = current.ID
+        result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+        if result.Error != nil {
+            return result.Error
+        }
+        if result.RowsAffected == 0 {
+            return ErrReportExists
+        }
+
+        updates := map[string]interface{}{"report_count": gorm.Expr("report_count + 1")}
+        if current.Status == domain.ModerationDismissed {
+            updates = map[string]interface{}{
+                "status":       domain.ModerationOpen,
+                "source":       domain.ModerationSourceReport,
+                "report_count": 1,
+                "opened_at":    report.CreatedAt,
+                "auto_hidden":  false,
+                "claimed_by":   nil,
+                "claimed_at":   nil,
+                "resolved_by":  nil,
+                "resolved_at":  nil,
+                "note":         "",
+            }
+        }
+        if err := tx.Model(&current).Updates(updates).Error; err != nil {
+            return err
+        }
+        return tx.First(&current, current.ID).Error
+    })
+    if err != nil {
+        return nil, err
+    }
+    return &current, nil
+}
+
+func (r *reportRepository) CreateHold(ctx context.Context, c *domain.ModerationCase) error {
+    return r.db.WithContext(ctx).Create(c).Error
+}
+
+func (r *reportRepository) CreateFlag(ctx context.Context, c *domain.ModerationCase) error {
+    return r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(c).Error
+}
+
+func (r *reportRepository) FindCase(ctx context.Context, id uint) (*domain.ModerationCase, error) {
+    var c domain.ModerationCase
+    err := r.db.WithContext(ctx).First(&c, id).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrModerationCaseNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+    return &c, nil
+}
+
+func (r *reportRepository) FindCases(ctx context.Context, q ModerationQuery) ([]*domain.ModerationCase, error) {
+    query := r.db.WithContext(ctx).Model(&domain.ModerationCase{})
+    if len(q.Statuses) > 0 {
+        query = query.Where("status IN ?", q.Statuses)
+    }
+    if q.Source != "" {
+        query = query.Where("source = ?", q.Source)
+    }
+    if len(q.TargetTypes) > 0 {
+        query = query.Where("target_type IN ?", q.TargetTypes)
+    }
+    if q.BoardID > 0 {
+        query = query.Where("board_id = ?", q.BoardID)
+    }
+    if q.BeforeID > 0 {
+        query = query.Where("id < ?", q.BeforeID)
+    }
+
+    var cases []*domain.ModerationCase
+    err := query.Order("id DESC").Limit(q.Limit).Find(&cases).Error
+    return cases, err
+}
+
+func (r *reportRepository) FindReports(ctx context.Context, c *domain.ModerationCase) ([]*domain.Report, error) {
+    var reports []*domain.Report
+    err := r.db.WithContext(ctx).
+        Where("case_id = ? AND created_at >= ?", c.ID, c.OpenedAt).
+        Order("id ASC").
+        Find(&reports).Error
+    return reports, err
+}
+
+func (r *reportRepository) Claim(ctx context.Context, id, moderatorID uint, now, staleBefore time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.ModerationCase{}).
+        Where("id = ?", id).
+        Where(r.db.Where("status = ?", domain.ModerationOpen).
+            Or("status = ? AND claimed_at < ?", domain.ModerationClaimed, staleBefore)).
+        Updates(map[string]interface{}{
+            "status":     domain.ModerationClaimed,
+            "claimed_by": moderatorID,
+            "claimed_at": now,
+        })
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrModerationCaseUnavailable
+    }
+    return nil
+}
+
+func (r *reportRepository) Close(ctx context.Context, id, moderatorID uint, status domain.ModerationStatus, note string, now time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.ModerationCase{}).
+        Where("id = ? AND status = ? AND claimed_by = ?", id, domain.ModerationClaimed, moderatorID).
+        Updates(map[string]interface{}{
+            "status":      status,
+            "resolved_by": moderatorID,
+            "resolved_at": now,
+            "note":        note,
+        })
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrModerationCaseUnavailable
+    }
+    return nil
+}
+
+func (r *reportRepository) MarkAutoHidden(ctx context.Context, id uint) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.ModerationCase{}).
+        Where("id = ?", id).
+        Update("auto_hidden", true).Error
+}
+
+// targetModel 신고 대상 종류의 테이블
+func targetModel(targetType domain.ReportTargetType) interface{} {
+    if targetType == domain.ReportTargetComment {
+        return &domain.Comment{}
+    }
+    return &domain.Post{}
+}
+
+func (r *reportRepository) HideTarget(ctx context.Context, targetType domain.ReportTargetType, targetID uint, now time.Time) (bool, error) {
+    result := r.db.WithContext(ctx).
+        Model(targetModel(targetType)).
+        Where("id = ? AND hidden_at IS NULL", targetID).
+        Update("hidden_at", now)
+    return result.RowsAffected > 0, result.Error
+}
+
+func (r *reportRepository) UnhideTarget(ctx context.Context, targetType domain.ReportTargetType, targetID uint) error {
+    return r.db.WithContext(ctx).
+        Model(targetModel(targetType)).
+        Where("id = ?", targetID).
+        Update("hidden_at", nil).Error
+}
+
+// HideModerated 목록 조회에서 숨겨진 게시글/댓글을 제외하는 scope
+// column은 숨김 시각 컬럼 (예: "posts.hidden_at").
+func HideModerated(column string) func(*gorm.DB) *gorm.DB {
+    return func(db *gorm.DB) *gorm.DB {
+        return db.Where(column + " IS NULL")
+    }
+}
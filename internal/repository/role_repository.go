@@ -0,0 +1,291 @@
+package repository
+
+import (
+    "context"
+    "errors"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+var (
+    ErrRoleNotFound       = errors.New("role not found")
+    ErrRoleExists         = errors.New("role already exists")
+    ErrPermissionNotFound = errors.New("permission not found")
+    ErrPermissionExists   = errors.New("permission already exists")
+    ErrAssignmentNotFound = errors.New("role assignment not found")
+    ErrAssignmentExists   = errors.New("role assignment already exists")
+)
+
+type RoleRepository interface {
+    ListRoles(ctx context.Context) ([]*domain.RoleDefinition, error)
+    FindRole(ctx context.Context, name domain.Role) (*domain.RoleDefinition, error)
+    // CreateRole 같은 이름이 있으면 ErrRoleExists
+    CreateRole(ctx context.Context, role *domain.RoleDefinition) error
+    // DeleteRole 역할과 부여된 권한 삭제
+    DeleteRole(ctx context.Context, name domain.Role) error
+    // CountUsers 역할을 가진 사용자 수 (범위 한정 부여 포함)
+    CountUsers(ctx context.Context, name domain.Role) (int64, error)
+    // AssignUser 사용자 역할 변경
+    AssignUser(ctx context.Context, userID uint, name domain.Role) error
+
+    ListPermissions(ctx context.Context) ([]*domain.PermissionDefinition, error)
+    FindPermission(ctx context.Context, name domain.Permission) (*domain.PermissionDefinition, error)
+    // CreatePermission 같은 이름이 있으면 ErrPermissionExists
+    CreatePermission(ctx context.Context, permission *domain.PermissionDefinition) error
+    // DeletePermission 권한과 모든 역할의 부여 관계 삭제
+    DeletePermission(ctx context.Context, name domain.Permission) error
+
+    ListGrants(ctx context.Context) ([]*domain.RolePermission, error)
+    // Grant 역할에 권한 부여 (이미 있으면 그대로)
+    Grant(ctx context.Context, role domain.Role, permission domain.Permission) error
+    // Revoke 부여 관계 삭제 (없으면 ErrPermissionNotFound)
+    Revoke(ctx context.Context, role domain.Role, permission domain.Permission) error
+
+    // ListAssignments 모든 범위 한정 역할 부여 (권한 캐시용)
+    ListAssignments(ctx context.Context) ([]*domain.RoleAssignment, error)
+    // FindAssignmentsByScope 범위에 부여된 역할 (사용자 포함)
+    FindAssignmentsByScope(ctx context.Context, scope domain.Scope) ([]*domain.RoleAssignment, error)
+    // CreateAssignment 같은 사용자/역할/범위가 있으면 ErrAssignmentExists
+    CreateAssignment(ctx context.Context, assignment *domain.RoleAssignment) error
+    // DeleteAssignment scope에 속한 부여만 삭제 (없으면 ErrAssignmentNotFound)
+    DeleteAssignment(ctx context.Context, id uint, scope domain.Scope) error
+
+    // Seed 역할 테이블이 비어 있으면 기본 매핑으로 채우고, 코드에 새로 생긴 권한은 항상 추가
+    // 이미 운영 중인 DB의 부여 관계는 건드리지 않는다 (회수한 기본 권한이 재시작으로 되살아나지 않도록).
+    Seed(ctx context.Context, defaults map[domain.Role][]domain.Permission) error
+}
+
+type roleRepository struct {
+    db *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) RoleRepository {
+    return &roleRepository{db: db}
+}
+
+func (r *roleRepository) ListRoles(ctx context.Context) ([]*domain.RoleDefinition, error) {
+    var roles []*domain.RoleDefinition
+    err := r.db.WithContext(ctx).Order("name ASC").Find(&roles).Error
+    return roles, err
+}
+
+func (r *roleRepository) FindRole(ctx context.Context, name domain.Role) (*domain.RoleDefinition, error) {
+    var role domain.RoleDefinition
+    err := r.db.WithContext(ctx).Where("name = ?", name).First(&role).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrRoleNotFound
+    }
+    return &role, err
+}
+
+func (r *roleRepository) CreateRole(ctx context.Context, role *domain.RoleDefinition) error {
+    result := r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(role)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrRoleExists
+    }
+    return nil
+}
+
+func (r *roleRepository) DeleteRole(ctx context.Context, name domain.Role) error {
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("role = ?", name).Delete(&domain.RolePermission{}).Error; err != nil {
+            return err
+        }
+        result := tx.Where("name = ?", name).Delete(&domain.RoleDefinition{})
+        if result.Error != nil {
+            return result.Error
+        }
+        if result.RowsAffected == 0 {
+            return ErrRoleNotFound
+        }
+        return nil
+    })
+}
+
+func (r *roleRepository) CountUsers(ctx context.Context, name domain.Role) (int64, error) {
+    var users, assignments int64
+    if err := r.db.WithContext(ctx).
+        Model(&domain.User{}).
+        Where("role = ?", name).
+        Count(&users).Error; err != nil {
+        return 0, err
+    }
+    err := r.db.WithContext(ctx).
+        Model(&domain.RoleAssignment{}).
+        Where("role = ?", name).
+        Count(&assignments).Error
+    return users + assignments, err
+}
+
+func (r *roleRepository) AssignUser(ctx context.Context, userID uint, name domain.Role) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.User{}).
+        Where("id = ?", userID).
+        Update("role", name)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrUserNotFound
+    }
+    return nil
+}
+
+func (r *roleRepository) ListPermissions(ctx context.Context) ([]*domain.PermissionDefinition, error) {
+    var permissions []*domain.PermissionDefinition
+    err := r.db.WithContext(ctx).Order("name ASC").Find(&permissions).Error
+    return permissions, err
+}
+
+func (r *roleRepository) FindPermission(ctx context.Context, name domain.Permission) (*domain.PermissionDefinition, error) {
+    var permission domain.PermissionDefinition
+    err := r.db.WithContext(ctx).Where("name = ?", name).First(&permission).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrPermissionNotFound
+    }
+    return &permission, err
+}
+
+func (r *roleRepository) CreatePermission(ctx context.Context, permission *domain.PermissionDefinition) error {
+    result := r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(permission)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrPermissionExists
+    }
+    return nil
+}
+
+func (r *roleRepository) DeletePermission(ctx context.Context, name domain.Permission) error {
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("permission = ?", name).Delete(&domain.RolePermission{}).Error; err != nil {
+            return err
+        }
+        result := tx.Where("name = ?", name).Delete(&domain.PermissionDefinition{})
+        if result.Error != nil {
+            return result.Error
+        }
+        if result.RowsAffected == 0 {
+            return ErrPermissionNotFound
+        }
+        return nil
+    })
+}
+
+func (r *roleRepository) ListGrants(ctx context.Context) ([]*domain.RolePermission, error) {
+    var grants []*domain.RolePermission
+    err := r.db.WithContext(ctx).Order("role ASC, permission ASC").Find(&grants).Error
+    return grants, err
+}
+
+func (r *roleRepository) Grant(ctx context.Context, role domain.Role, permission domain.Permission) error {
+    return r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(&domain.RolePermission{Role: role, Permission: permission}).Error
+}
+
+func (r *roleRepository) Revoke(ctx context.Context, role domain.Role, permission domain.Permission) error {
+    result := r.db.WithContext(ctx).
+        Where("role = ? AND permission = ?", role, permission).
+        Delete(&domain.RolePermission{})
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrPermissionNotFound
+    }
+    return nil
+}
+
+func (r *roleRepository) ListAssignments(ctx context.Context) ([]*domain.RoleAssignment, error) {
+    var assignments []*domain.RoleAssignment
+    err := r.db.WithContext(ctx).Order("id ASC").Find(&assignments).Error
+    return assignments, err
+}
+
+func (r *roleRepository) FindAssignmentsByScope(ctx context.Context, scope domain.Scope) ([]*domain.RoleAssignment, error) {
+    var assignments []*domain.RoleAssignment
+    err := r.db.WithContext(ctx).
+        Preload("User").
+        Where("scope_type = ? AND scope_id = ?", scope.Type, scope.ID).
+        Order("id ASC").
+        Find(&assignments).Error
+    return assignments, err
+}
+
+func (r *roleRepository) CreateAssignment(ctx context.Context, assignment *domain.RoleAssignment) error {
+    result := r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(assignment)
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrAssignmentExists
+    }
+    return nil
+}
+
+func (r *roleRepository) DeleteAssignment(ctx context.Context, id uint, scope domain.Scope) error {
+    result := r.db.WithContext(ctx).
+        Where("id = ? AND scope_type = ? AND scope_id = ?", id, scope.Type, scope.ID).
+        Delete(&domain.RoleAssignment{})
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrAssignmentNotFound
+    }
+    return nil
+}
+
+func (r *roleRepository) Seed(ctx context.Context, defaults map[domain.Role][]domain.Permission) error {
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var permissions []*domain.PermissionDefinition
+        seen := make(map[domain.Permission]bool)
+        for _, granted := range defaults {
+            for _, p := range granted {
+                if !seen[p] {
+                    seen[p] = true
+                    permissions = append(permissions, &domain.PermissionDefinition{Name: p})
+                }
+            }
+        }
+        if len(permissions) > 0 {
+            if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&permissions).Error; err != nil {
+                return err
+            }
+        }
+
+        var count int64
+        if err := tx.Model(&domain.RoleDefinition{}).Count(&count).Error; err != nil {
+            return err
+        }
+        if count > 0 {
+            return nil
+        }
+
+        for role, granted := range defaults {
+            if err := tx.Create(&domain.RoleDefinition{Name: role, System: true}).Error; err != nil {
+                return err
+            }
+            for _, p := range granted {
+                if err := tx.Create(&domain.RolePermission{Role: role, Permission: p}).Error; err != nil {
+                    return err
+                }
+            }
+        }
+        return nil
+    })
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var ErrSanctionNotFound = errors.New("sanction not found")
+
+type SanctionRepository interface {
+    Create(ctx context.Context, sanction *domain.Sanction) error
+    // ListCurrent 해제되지 않았고 아직 끝나지 않은 제재 (시작 전 포함, 제재 캐시용)
+    ListCurrent(ctx context.Context, now time.Time) ([]*domain.Sanction, error)
+    // FindByUser 사용자의 제재 이력 (최근 순, 해제/만료 포함)
+    FindByUser(ctx context.Context, userID uint) ([]*domain.Sanction, error)
+    // Revoke 해제되지 않았고 아직 끝나지 않은 제재를 해제 (아니면 ErrSanctionNotFound)
+    Revoke(ctx context.Context, id, revokedBy uint, reason string, now time.Time) (*domain.Sanction, error)
+    // FindUnapplied 시작되었지만 세션 정리를 하지 않은 제재
+    FindUnapplied(ctx context.Context, now time.Time, limit int) ([]*domain.Sanction, error)
+    MarkApplied(ctx context.Context, id uint, now time.Time) error
+}
+
+type sanctionRepository struct {
+    db *gorm.DB
+}
+
+func NewSanctionRepository(db *gorm.DB) SanctionRepository {
+    return &sanctionRepository{db: db}
+}
+
+// currentSanctions 해제되지 않았고 now에 끝나지 않은 제재
+func currentSanctions(db *gorm.DB, now time.Time) *gorm.DB {
+    return db.Where("revoked_at IS NULL AND (ends_at IS NULL OR ends_at > ?)", now)
+}
+
+func (r *sanctionRepository) Create(ctx context.Context, sanction *domain.Sanction) error {
+    return r.db.WithContext(ctx).Create(sanction).Error
+}
+
+func (r *sanctionRepository) ListCurrent(ctx context.Context, now time.Time) ([]*domain.Sanction, error) {
+    var sanctions []*domain.Sanction
+    err := currentSanctions(r.db.WithContext(ctx), now).
+        Order("id ASC").
+        Find(&sanctions).Error
+    return sanctions, err
+}
+
+func (r *sanctionRepository) FindByUser(ctx context.Context, userID uint) ([]*domain.Sanction, error) {
+    var sanctions []*domain.Sanction
+    err := r.db.WithContext(ctx).
+        Where("user_id = ?", userID).
+        Order("starts_at DESC, id DESC").
+        Find(&sanctions).Error
+    return sanctions, err
+}
+
+func (r *sanctionRepository) Revoke(ctx context.Context, id, revokedBy uint, reason string, now time.Time) (*domain.Sanction, error) {
+    result := currentSanctions(r.db.WithContext(ctx).Model(&domain.Sanction{}), now).
+        Where("id = ?", id).
+        Updates(map[string]interface{}{
+            "revoked_at":    now,
+            "revoked_by":    revokedBy,
+            "revoke_reason": reason,
+        })
+    if result.Error != nil {
+        return nil, result.Error
+    }
+    if result.RowsAffected == 0 {
+        return nil, ErrSanctionNotFound
+    }
+
+    var sanction domain.Sanction
+    if err := r.db.WithContext(ctx).First(&sanction, id).Error; err != nil {
+        return nil, err
+    }
+    return &sanction, nil
+}
+
+func (r *sanctionRepository) FindUnapplied(ctx context.Context, now time.Time, limit int) ([]*domain.Sanction, error) {
+    var sanctions []*domain.Sanction
+    err := currentSanctions(r.db.WithContext(ctx), now).
+        Where("starts_at <= ? AND applied_at IS NULL", now).
+        Order("starts_at ASC").
+        Limit(limit).
+        Find(&sanctions).Error
+    return sanctions, err
+}
+
+func (r *sanctionRepository) MarkApplied(ctx context.Context, id uint, now time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.Sanction{}).
+        Where("id = ?", id).
+        Update("applied_at", now).Error
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var (
+    ErrSessionNotFound = errors.New("session not found")
+)
+
+type SessionRepository interface {
+    Create(ctx context.Context, session *domain.Session) error
+    FindByID(ctx context.Context, id uint) (*domain.Session, error)
+    FindActiveByUserID(ctx context.Context, userID uint, now time.Time) ([]*domain.Session, error)
+    // Touch 마지막 접속 시각 갱신 (since 이후에 이미 갱신됐다면 건너뜀)
+    Touch(ctx context.Context, id uint, ip string, seenAt, since time.Time) error
+    // Revoke 사용자의 세션 하나 폐기 (없거나 이미 폐기됐으면 ErrSessionNotFound)
+    Revoke(ctx context.Context, id, userID uint, revokedAt time.Time) error
+    // RevokeAllByUserID 사용자의 활성 세션 폐기 후 폐기된 세션 ID 반환 (exceptID는 유지)
+    RevokeAllByUserID(ctx context.Context, userID, exceptID uint, revokedAt time.Time) ([]uint, error)
+}
+
+type sessionRepository struct {
+    db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) SessionRepository {
+    return &sessionRepository{db: db}
+}
+
+func (r *sessionRepository) Create(ctx context.Context, session *domain.Session) error {
+    return r.db.WithContext(ctx).Create(session).Error
+}
+
+func (r *sessionRepository) FindByID(ctx context.Context, id uint) (*domain.Session, error) {
+    var session domain.Session
+    err := r.db.WithContext(ctx).First(&session, id).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrSessionNotFound
+    }
+    return &session, err
+}
+
+func (r *sessionRepository) FindActiveByUserID(ctx context.Context, userID uint, now time.Time) ([]*domain.Session, error) {
+    var sessions []*domain.Session
+    err := r.db.WithContext(ctx).
+        Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+        Order("last_seen_at DESC").
+        Find(&sessions).Error
+    return sessions, err
+}
+
+func (r *sessionRepository) Touch(ctx context.Context, id uint, ip string, seenAt, since time.Time) error {
+    updates := map[string]interface{}{"last_seen_at": seenAt}
+    if ip != "" {
+        updates["ip"] = ip
+    }
+
+    return r.db.WithContext(ctx).
+        Model(&domain.Session{}).
+        Where("id = ? AND last_seen_at < ?", id, since).
+        Updates(updates).Error
+}
+
+func (r *sessionRepository) Revoke(ctx context.Context, id, userID uint, revokedAt time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.Session{}).
+        Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+        Update("revoked_at", revokedAt)
+
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrSessionNotFound
+    }
+    return nil
+}
+
+func (r *sessionRepository) RevokeAllByUserID(ctx context.Context, userID, exceptID uint, revokedAt time.Time) ([]uint, error) {
+    var ids []uint
+    err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Model(&domain.Session{}).
+            Where("user_id = ? AND id <> ? AND revoked_at IS NULL", userID, exceptID).
+            Pluck("id", &ids).Error; err != nil {
+            return err
+        }
+        if len(ids) == 0 {
+            return nil
+        }
+
+        return tx.Model(&domain.Session{}).
+            Where("id IN ?", ids).
+            Update("revoked_at", revokedAt).Error
+    })
+    return ids, err
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+    "context"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+// SpamSample 모더레이터가 판정한 콘텐츠 (스팸 모델 학습용)
+type SpamSample struct {
+    Text string
+    Spam bool
+}
+
+type SpamRepository interface {
+    // FindTrainingSamples 스팸 여부로 판정이 끝난 처리 건의 본문 (최근 처리 순, 종류별 limit건)
+    // 스팸 보류 건과 스팸 사유로 신고된 건만 쓴다. 인정은 스팸, 기각은 정상으로 본다.
+    FindTrainingSamples(ctx context.Context, limit int) ([]SpamSample, error)
+    // CountRecentContent since 이후 작성한 게시글과 댓글 수
+    CountRecentContent(ctx context.Context, authorID uint, since time.Time) (int64, error)
+    // CountFingerprints since 이후 같은 지문으로 작성된 수
+    CountFingerprints(ctx context.Context, hash string, since time.Time) (int64, error)
+    AddFingerprint(ctx context.Context, fingerprint *domain.SpamFingerprint) error
+    DeleteFingerprintsBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type spamRepository struct {
+    db *gorm.DB
+}
+
+func NewSpamRepository(db *gorm.DB) SpamRepository {
+    return &spamRepository{db: db}
+}
+
+// spamTrainingTargets 처리 건 종류별 본문 컬럼
+var spamTrainingTargets = []struct {
+    targetType domain.ReportTargetType
+    table      string
+    text       string
+}{
+    {domain.ReportTargetPost, "posts", "posts.title || ' ' || posts.content"},
+    {domain.ReportTargetComment, "comments", "comments.content"},
+}
+
+func (r *spamRepository) FindTrainingSamples(ctx context.Context, limit int) ([]SpamSample, error) {
+    var samples []SpamSample
+    for _, target := range spamTrainingTargets {
+        var rows []SpamSample
+        err := r.db.WithContext(ctx).
+            Table("moderation_cases").
+            Select(target.text+" AS text, moderation_cases.status = ? AS spam", domain.ModerationResolved).
+            Joins("JOIN "+target.table+" ON "+target.table+".id = moderation_cases.target_id").
+            Where("moderation_cases.target_type = ? AND moderation_cases.status IN ?",
+                target.targetType, []domain.ModerationStatus{domain.ModerationResolved, domain.ModerationDismissed}).
+            Where(r.db.Where("moderation_cases.source = ?", domain.ModerationSourceSpamFilter).
+                Or("EXISTS (SELECT 1 FROM reports WHERE reports.case_id = moderation_cases.id AND reports.reason = ?)", domain.ReportReasonSpam)).
+            Order("moderation_cases.resolved_at DESC").
+            Limit(limit).
+            Scan(&rows).Error
+        if err != nil {
+            return nil, err
+        }
+        samples = append(samples, rows...)
+    }
+    return samples, nil
+}
+
+func (r *spamRepository) CountRecentContent(ctx context.Context, authorID uint, since time.Time) (int64, error) {
+    var posts, comments int64
+    if err := r.db.WithContext(ctx).
+        Model(&domain.Post{}).
+        Where("author_id = ? AND created_at >= ?", authorID, since).
+        Count(&posts).Error; err != nil {
+        return 0, err
+    }
+    if err := r.db.WithContext(ctx).
+        Model(&domain.Comment{}).
+        Where("author_id = ? AND created_at >= ?", authorID, since).
+        Count(&comments).Error; err != nil {
+        return 0, err
+    }
+    return posts + comments, nil
+}
+
+func (r *spamRepository) CountFingerprints(ctx context.Context, hash string, since time.Time) (int64, error) {
+    var count int64
+    err := r.db.WithContext(ctx).
+        Model(&domain.SpamFingerprint{}).
+        Where("hash = ? AND created_at >= ?", hash, since).
+        Count(&count).Error
+    return count, err
+}
+
+func (r *spamRepository) AddFingerprint(ctx context.Context, fingerprint *domain.SpamFingerprint) error {
+    return r.db.WithContext(ctx).Create(fingerprint).Error
+}
+
+func (r *spamRepository) DeleteFingerprintsBefore(ctx context.Context, before time.Time) (int64, error) {
+    result := r.db.WithContext(ctx).
+        Where("created_at < ?", before).
+        Delete(&domain.SpamFingerprint{})
+    return result.RowsAffected, result.Error
+}
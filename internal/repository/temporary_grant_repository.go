@@ -0,0 +1,101 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var ErrTemporaryGrantNotFound = errors.New("temporary grant not found")
+
+type TemporaryGrantRepository interface {
+    Create(ctx context.Context, grant *domain.TemporaryGrant) error
+    // ListCurrent 아직 끝나지 않은 부여 (시작 전 포함, 권한 캐시용)
+    ListCurrent(ctx context.Context, now time.Time) ([]*domain.TemporaryGrant, error)
+    // FindByUser 사용자의 부여 (최근 종료 순)
+    FindByUser(ctx context.Context, userID uint) ([]*domain.TemporaryGrant, error)
+    // End 진행 중이거나 시작 전인 부여를 now에 끝낸다 (이미 끝났으면 ErrTemporaryGrantNotFound)
+    End(ctx context.Context, id uint, now time.Time) (*domain.TemporaryGrant, error)
+    // FindExpiredUnnotified 끝났지만 만료 알림을 보내지 않은 부여
+    FindExpiredUnnotified(ctx context.Context, now time.Time, limit int) ([]*domain.TemporaryGrant, error)
+    MarkExpiryNotified(ctx context.Context, id uint, now time.Time) error
+    // DeleteEndedBefore 보관 기간이 지난 부여 삭제 (만료 알림을 보낸 것만)
+    DeleteEndedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type temporaryGrantRepository struct {
+    db *gorm.DB
+}
+
+func NewTemporaryGrantRepository(db *gorm.DB) TemporaryGrantRepository {
+    return &temporaryGrantRepository{db: db}
+}
+
+func (r *temporaryGrantRepository) Create(ctx context.Context, grant *domain.TemporaryGrant) error {
+    return r.db.WithContext(ctx).Create(grant).Error
+}
+
+func (r *temporaryGrantRepository) ListCurrent(ctx context.Context, now time.Time) ([]*domain.TemporaryGrant, error) {
+    var grants []*domain.TemporaryGrant
+    err := r.db.WithContext(ctx).
+        Where("ends_at > ?", now).
+        Order("id ASC").
+        Find(&grants).Error
+    return grants, err
+}
+
+func (r *temporaryGrantRepository) FindByUser(ctx context.Context, userID uint) ([]*domain.TemporaryGrant, error) {
+    var grants []*domain.TemporaryGrant
+    err := r.db.WithContext(ctx).
+        Where("user_id = ?", userID).
+        Order("ends_at DESC, id DESC").
+        Find(&grants).Error
+    return grants, err
+}
+
+func (r *temporaryGrantRepository) End(ctx context.Context, id uint, now time.Time) (*domain.TemporaryGrant, error) {
+    result := r.db.WithContext(ctx).
+        Model(&domain.TemporaryGrant{}).
+        Where("id = ? AND ends_at > ?", id, now).
+        Update("ends_at", now)
+    if result.Error != nil {
+        return nil, result.Error
+    }
+    if result.RowsAffected == 0 {
+        return nil, ErrTemporaryGrantNotFound
+    }
+
+    var grant domain.TemporaryGrant
+    if err := r.db.WithContext(ctx).First(&grant, id).Error; err != nil {
+        return nil, err
+    }
+    return &grant, nil
+}
+
+func (r *temporaryGrantRepository) FindExpiredUnnotified(ctx context.Context, now time.Time, limit int) ([]*domain.TemporaryGrant, error) {
+    var grants []*domain.TemporaryGrant
+    err := r.db.WithContext(ctx).
+        Where("ends_at <= ? AND expiry_notified_at IS NULL", now).
+        Order("ends_at ASC").
+        Limit(limit).
+        Find(&grants).Error
+    return grants, err
+}
+
+func (r *temporaryGrantRepository) MarkExpiryNotified(ctx context.Context, id uint, now time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.TemporaryGrant{}).
+        Where("id = ?", id).
+        Update("expiry_notified_at", now).Error
+}
+
+func (r *temporaryGrantRepository) DeleteEndedBefore(ctx context.Context, before time.Time) (int64, error) {
+    result := r.db.WithContext(ctx).
+        Where("ends_at < ? AND expiry_notified_at IS NOT NULL", before).
+        Delete(&domain.TemporaryGrant{})
+    return result.RowsAffected, result.Error
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+)
+
+var (
+    ErrTwoFactorNotFound    = errors.New("two factor not found")
+    ErrRecoveryCodeNotFound = errors.New("recovery code not found")
+    ErrTOTPStepReused       = errors.New("totp step already used")
+)
+
+type TwoFactorRepository interface {
+    FindByUserID(ctx context.Context, userID uint) (*domain.TwoFactor, error)
+    Save(ctx context.Context, twoFactor *domain.TwoFactor) error
+    Enable(ctx context.Context, userID uint, step int64, enabledAt time.Time) error
+    UpdateLastUsedStep(ctx context.Context, userID uint, step int64) error
+    DeleteByUserID(ctx context.Context, userID uint) error
+
+    ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error
+    UseRecoveryCode(ctx context.Context, userID uint, codeHash string, usedAt time.Time) error
+    CountUnusedRecoveryCodes(ctx context.Context, userID uint) (int64, error)
+}
+
+type twoFactorRepository struct {
+    db *gorm.DB
+}
+
+func NewTwoFactorRepository(db *gorm.DB) TwoFactorRepository {
+    return &twoFactorRepository{db: db}
+}
+
+func (r *twoFactorRepository) FindByUserID(ctx context.Context, userID uint) (*domain.TwoFactor, error) {
+    var twoFactor domain.TwoFactor
+    err := r.db.WithContext(ctx).
+        Where("user_id = ?", userID).
+        First(&twoFactor).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrTwoFactorNotFound
+    }
+    return &twoFactor, err
+}
+
+func (r *twoFactorRepository) Save(ctx context.Context, twoFactor *domain.TwoFactor) error {
+    return r.db.WithContext(ctx).Save(twoFactor).Error
+}
+
+func (r *twoFactorRepository) Enable(ctx context.Context, userID uint, step int64, enabledAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.TwoFactor{}).
+        Where("user_id = ?", userID).
+        Updates(map[string]interface{}{
+            "enabled_at":     enabledAt,
+            "last_used_step": step,
+        }).Error
+}
+
+// UpdateLastUsedStep 사용된 시간 단계 기록 (같거나 이전 단계면 ErrTOTPStepReused)
+func (r *twoFactorRepository) UpdateLastUsedStep(ctx context.Context, userID uint, step int64) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.TwoFactor{}).
+        Where("user_id = ? AND last_used_step < ?", userID, step).
+        Update("last_used_step", step)
+
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrTOTPStepReused
+    }
+    return nil
+}
+
+func (r *twoFactorRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("user_id = ?", userID).Delete(&domain.RecoveryCode{}).Error; err != nil {
+            return err
+        }
+        return tx.Where("user_id = ?", userID).Delete(&domain.TwoFactor{}).Error
+    })
+}
+
+// ReplaceRecoveryCodes 기존 복구 코드를 모두 지우고 새 코드로 교체
+func (r *twoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID uint, codeHashes []string) error {
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("user_id = ?", userID).Delete(&domain.RecoveryCode{}).Error; err != nil {
+            return err
+        }
+
+        codes := make([]domain.RecoveryCode, len(codeHashes))
+        for i, hash := range codeHashes {
+            codes[i] = domain.RecoveryCode{UserID: userID, CodeHash: hash}
+        }
+        return tx.Create(&codes).Error
+    })
+}
+
+// UseRecoveryCode 복구 코드 사용 처리 (없거나 이미 사용된 코드면 ErrRecoveryCodeNotFound)
+func (r *twoFactorRepository) UseRecoveryCode(ctx context.Context, userID uint, codeHash string, usedAt time.Time) error {
+    result := r.db.WithContext(ctx).
+        Model(&domain.RecoveryCode{}).
+        Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+        Update("used_at", usedAt)
+
+    if result.Error != nil {
+        return result.Error
+    }
+    if result.RowsAffected == 0 {
+        return ErrRecoveryCodeNotFound
+    }
+    return nil
+}
+
+func (r *twoFactorRepository) CountUnusedRecoveryCodes(ctx context.Context, userID uint) (int64, error) {
+    var count int64
+    err := r.db.WithContext(ctx).
+        Model(&domain.RecoveryCode{}).
+        Where("user_id = ? AND used_at IS NULL", userID).
+        Count(&count).Error
+    return count, err
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+    "context"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type UserBlockRepository interface {
+    // Block 차단 (뮤트 중이었으면 차단으로 바꾼다)
+    Block(ctx context.Context, blockerID, blockedID uint) error
+    // Mute 뮤트 (이미 차단 중이면 그대로 둔다)
+    Mute(ctx context.Context, blockerID, blockedID uint) error
+    // Delete kind가 일치하는 관계만 해제 (없었으면 false)
+    Delete(ctx context.Context, blockerID, blockedID uint, kind domain.BlockKind) (bool, error)
+    // IsBlockedEither 둘 중 한쪽이라도 상대를 차단했는지
+    IsBlockedEither(ctx context.Context, userA, userB uint) (bool, error)
+    // IsBlockedAny userID와 others 중 누구라도 한쪽이 상대를 차단했는지 (그룹 대화방 초대용)
+    IsBlockedAny(ctx context.Context, userID uint, others []uint) (bool, error)
+    // Hides viewer가 actor의 콘텐츠를 보지 않아야 하는지 (viewer의 차단/뮤트 또는 actor의 차단)
+    Hides(ctx context.Context, viewerID, actorID uint) (bool, error)
+    // FindByBlocker 내가 차단/뮤트한 사용자 목록
+    FindByBlocker(ctx context.Context, blockerID uint, kind domain.BlockKind, offset, limit int) ([]*domain.User, int64, error)
+}
+
+type userBlockRepository struct {
+    db *gorm.DB
+}
+
+func NewUserBlockRepository(db *gorm.DB) UserBlockRepository {
+    return &userBlockRepository{db: db}
+}
+
+func (r *userBlockRepository) Block(ctx context.Context, blockerID, blockedID uint) error {
+    return r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{
+            Columns:   []clause.Column{{Name: "blocker_id"}, {Name: "blocked_id"}},
+            DoUpdates: clause.Assignments(map[string]interface{}{"kind": domain.BlockKindBlock}),
+        }).
+        Create(&domain.UserBlock{BlockerID: blockerID, BlockedID: blockedID, Kind: domain.BlockKindBlock}).Error
+}
+
+func (r *userBlockRepository) Mute(ctx context.Context, blockerID, blockedID uint) error {
+    return r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(&domain.UserBlock{BlockerID: blockerID, BlockedID: blockedID, Kind: domain.BlockKindMute}).Error
+}
+
+func (r *userBlockRepository) Delete(ctx context.Context, blockerID, blockedID uint, kind domain.BlockKind) (bool, error) {
+    result := r.db.WithContext(ctx).
+        Where("blocker_id = ? AND blocked_id = ? AND kind = ?", blockerID, blockedID, kind).
+        Delete(&domain.UserBlock{})
+    if result.Error != nil {
+        return false, result.Error
+    }
+    return result.RowsAffected > 0, nil
+}
+
+func (r *userBlockRepository) IsBlockedEither(ctx context.Context, userA, userB uint) (bool, error) {
+    var count int64
+    err := r.db.WithContext(ctx).
+        Model(&domain.UserBlock{}).
+        Where("kind = ?", domain.BlockKindBlock).
+        Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userA, userB, userB, userA).
+        Count(&count).Error
+    return count > 0, err
+}
+
+func (r *userBlockRepository) IsBlockedAny(ctx context.Context, userID uint, others []uint) (bool, error) {
+    if len(others) == 0 {
+        return false, nil
+    }
+
+    var count int64
+    err := r.db.WithContext(ctx).
+        Model(&domain.UserBlock{}).
+        Where("kind = ?", domain.BlockKindBlock).
+        Where("(blocker_id = ? AND blocked_id IN ?) OR (blocker_id IN ? AND blocked_id = ?)", userID, others, others, userID).
+        Count(&count).Error
+    return count > 0, err
+}
+
+func (r *userBlockRepository) Hides(ctx context.Context, viewerID, actorID uint) (bool, error) {
+    var count int64
+    err := r.db.WithContext(ctx).
+        Model(&domain.UserBlock{}).
+        Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ? AND kind = ?)",
+            viewerID, actorID, actorID, viewerID, domain.BlockKindBlock).
+        Count(&count).Error
+    return count > 0, err
+}
+
+func (r *userBlockRepository) FindByBlocker(ctx context.Context, blockerID uint, kind domain.BlockKind, offset, limit int) ([]*domain.User, int64, error) {
+    var users []*domain.User
+    var total int64
+
+    query := func() *gorm.DB {
+        return r.db.WithContext(ctx).
+            Model(&domain.User{}).
+            Joins("JOIN user_blocks ON user_blocks.blocked_id = users.id").
+            Where("user_blocks.blocker_id = ? AND user_blocks.kind = ?", blockerID, kind)
+    }
+
+    if err := query().Count(&total).Error; err != nil {
+        return nil, 0, err
+    }
+
+    err := query().
+        Order("user_blocks.created_at DESC").
+        Offset(offset).
+        Limit(limit).
+        Find(&users).Error
+
+    return users, total, err
+}
+
+// HideBlockedAuthors 목록 조회에서 viewer가 차단/뮤트한 작성자와 viewer를 차단한 작성자의 글을 제외하는 scope
+// column은 작성자 ID 컬럼 (예: "posts.author_id"). 비로그인(viewerID == 0)이면 아무것도 하지 않는다.
+func HideBlockedAuthors(viewerID uint, column string) func(*gorm.DB) *gorm.DB {
+    return func(db *gorm.DB) *gorm.DB {
+        if viewerID == 0 {
+            return db
+        }
+        return db.
+            Where(column+" NOT IN (SELECT blocked_id FROM user_blocks WHERE blocker_id = ?)", viewerID).
+            Where(column+" NOT IN (SELECT blocker_id FROM user_blocks WHERE blocked_id = ? AND kind = ?)", viewerID, domain.BlockKindBlock)
+    }
+}
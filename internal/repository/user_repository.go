@@ -1,15 +1,95 @@
-
-func (r *userRepository) FindInactiveBatch(ctx context.Context, afterID uint, limit int) ([]*domain.User, error) {
+// FindInactiveBatch inactiveBefore 이전부터 활동이 없는 (휴면이 아닌) 사용자를 afterID 이후 ID 순으로 조회
+// 로그인한 적 없는 계정은 가입 시각을 기준으로 한다.
+func (r *userRepository) FindInactiveBatch(ctx context.Context, afterID uint, inactiveBefore time.Time, limit int) ([]*domain.User, error) {
     var users []*domain.User
 
-    thirtyDaysAgo := time.Now().AddDate(0, 0, -30)
-
     err := r.db.WithContext(ctx).
         Where("id > ?", afterID).
-        Where("last_login_at < ?", thirtyDaysAgo).
+        Where("dormant_at IS NULL").
+        Where("COALESCE(last_login_at, created_at) < ?", inactiveBefore).
         Order("id ASC").
         Limit(limit).
         Find(&users).Error
 
     return users, err
 }
+
+var (
+    ErrUserNotFound = errors.New("user not found")
+)
+
+func (r *userRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+    var user domain.User
+    err := r.db.WithContext(ctx).
+        Where("email = ?", email).
+        First(&user).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrUserNotFound
+    }
+    return &user, err
+}
+
+// MarkEmailVerified 이메일 인증 처리 (이미 인증된 경우 기존 시각 유지)
+func (r *userRepository) MarkEmailVerified(ctx context.Context, userID uint, verifiedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.User{}).
+        Where("id = ? AND email_verified_at IS NULL", userID).
+        Update("email_verified_at", verifiedAt).Error
+}
+
+// UpdatePassword 비밀번호 변경
+func (r *userRepository) UpdatePassword(ctx context.Context, userID uint, hashedPassword string, changedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.User{}).
+        Where("id = ?", userID).
+        Updates(map[string]interface{}{
+            "password":            hashedPassword,
+            "password_changed_at": changedAt,
+        }).Error
+}
+
+func (r *userRepository) UpdateLastLogin(ctx context.Context, userID uint, loginAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.User{}).
+        Where("id = ?", userID).
+        Update("last_login_at", loginAt).Error
+}
+
+func (r *userRepository) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
+    var user domain.User
+    err := r.db.WithContext(ctx).
+        Where("username = ?", username).
+        First(&user).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrUserNotFound
+    }
+    return &user, err
+}
+
+// UpdatePasswordHash 같은 비밀번호를 새 알고리즘으로 다시 해싱한 값으로 교체
+// 그 사이 비밀번호가 바뀌었으면 덮어쓰지 않는다 (비밀번호 변경 시각도 유지).
+func (r *userRepository) UpdatePasswordHash(ctx context.Context, userID uint, oldHash, newHash string) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.User{}).
+        Where("id = ? AND password = ?", userID, oldHash).
+        Update("password", newHash).Error
+}
+
+// UpdateProfile 프로필 항목(표시 이름, 소개, 웹사이트) 저장
+func (r *userRepository) UpdateProfile(ctx context.Context, user *domain.User) error {
+    return r.db.WithContext(ctx).
+        Model(user).
+        Select("display_name", "bio", "website").
+        Updates(user).Error
+}
+
+// UpdateAvatar 프로필 이미지 교체 (key가 빈 값이면 삭제)
+func (r *userRepository) UpdateAvatar(ctx context.Context, userID uint, key, url string) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.User{}).
+        Where("id = ?", userID).
+        Updates(map[string]interface{}{
+            "avatar_key": key,
+            "avatar_url": url,
+        }).Error
+}
@@ -0,0 +1,249 @@
+package repository
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "time"
+
+    "goboardapi/internal/domain"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+var (
+    ErrUnknownContentPolicy = errors.New("unknown content policy")
+    ErrSystemUserConflict   = errors.New("system username is taken by a regular account")
+)
+
+// userOwnedTables 영구 삭제 시 행을 지우는 사용자 소유 테이블과 컬럼
+var userOwnedTables = []struct {
+    table  string
+    column string
+}{
+    {"notifications", "user_id"},
+    {"notifications", "actor_id"},
+    {"feed_items", "user_id"},
+    {"user_blocks", "blocker_id"},
+    {"user_blocks", "blocked_id"},
+    {"role_assignments", "user_id"},
+    {"temporary_grants", "user_id"},
+    {"reports", "reporter_id"},
+    {"spam_fingerprints", "author_id"},
+    {"conversation_members", "user_id"},
+    {"sessions", "user_id"},
+    {"refresh_tokens", "user_id"},
+    {"personal_access_tokens", "user_id"},
+    {"two_factors", "user_id"},
+    {"recovery_codes", "user_id"},
+    {"external_identities", "user_id"},
+    {"email_verifications", "user_id"},
+    {"password_resets", "user_id"},
+}
+
+type WithdrawalRepository interface {
+    CreateLog(ctx context.Context, log *domain.WithdrawLog) error
+    // FindDue 복구 기간이 지나 영구 삭제할 탈퇴 기록 (실패 횟수가 적은 순)
+    FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.WithdrawLog, error)
+    // MarkRestored 계정 복구 시 영구 삭제 대상에서 제외
+    MarkRestored(ctx context.Context, userID uint, restoredAt time.Time) error
+    // RecordPurgeFailure 영구 삭제 실패 기록 (FindDue에서 뒤로 밀린다)
+    RecordPurgeFailure(ctx context.Context, id uint, attemptedAt time.Time) error
+    // MarkPurged 영구 삭제 완료 처리 (탈퇴 기록의 이메일도 지운다)
+    MarkPurged(ctx context.Context, id uint, purgedAt time.Time) error
+
+    // FindWithdrawnUser 탈퇴(소프트 삭제) 상태인 사용자 조회 (복구된 계정이면 ErrUserNotFound)
+    FindWithdrawnUser(ctx context.Context, userID uint) (*domain.User, error)
+    // EnsureSystemUser 콘텐츠를 넘겨받을 시스템 계정 조회 (없으면 생성)
+    EnsureSystemUser(ctx context.Context, username string) (*domain.User, error)
+    // ApplyContentPolicy 게시글/댓글/좋아요/메시지에 처리 방식 적용
+    ApplyContentPolicy(ctx context.Context, userID uint, policy domain.ContentPolicy, systemUserID uint) error
+    // DeleteUserData 알림, 팔로우, 차단, 세션 등 사용자에게 딸린 데이터 삭제
+    DeleteUserData(ctx context.Context, userID uint) error
+    // ScrubUser 사용자 행의 개인 정보 제거 (외래 키 유지를 위해 행은 소프트 삭제 상태로 남긴다)
+    ScrubUser(ctx context.Context, userID uint) error
+}
+
+type withdrawalRepository struct {
+    db *gorm.DB
+}
+
+func NewWithdrawalRepository(db *gorm.DB) WithdrawalRepository {
+    return &withdrawalRepository{db: db}
+}
+
+func (r *withdrawalRepository) CreateLog(ctx context.Context, log *domain.WithdrawLog) error {
+    return r.db.WithContext(ctx).Create(log).Error
+}
+
+func (r *withdrawalRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.WithdrawLog, error) {
+    var logs []*domain.WithdrawLog
+    err := r.db.WithContext(ctx).
+        Where("purged_at IS NULL AND restored_at IS NULL AND purge_after <= ?", now).
+        Order("purge_attempts ASC, purge_after ASC, id ASC").
+        Limit(limit).
+        Find(&logs).Error
+    return logs, err
+}
+
+func (r *withdrawalRepository) MarkRestored(ctx context.Context, userID uint, restoredAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.WithdrawLog{}).
+        Where("user_id = ? AND purged_at IS NULL AND restored_at IS NULL", userID).
+        Update("restored_at", restoredAt).Error
+}
+
+func (r *withdrawalRepository) RecordPurgeFailure(ctx context.Context, id uint, attemptedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.WithdrawLog{}).
+        Where("id = ?", id).
+        Updates(map[string]interface{}{
+            "purge_attempts":        gorm.Expr("purge_attempts + 1"),
+            "last_purge_attempt_at": attemptedAt,
+        }).Error
+}
+
+func (r *withdrawalRepository) MarkPurged(ctx context.Context, id uint, purgedAt time.Time) error {
+    return r.db.WithContext(ctx).
+        Model(&domain.WithdrawLog{}).
+        Where("id = ?", id).
+        Updates(map[string]interface{}{
+            "purged_at": purgedAt,
+            "email":     "",
+        }).Error
+}
+
+func (r *withdrawalRepository) FindWithdrawnUser(ctx context.Context, userID uint) (*domain.User, error) {
+    var user domain.User
+    err := r.db.WithContext(ctx).
+        Unscoped().
+        Where("id = ? AND deleted_at IS NOT NULL", userID).
+        First(&user).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrUserNotFound
+    }
+    return &user, err
+}
+
+func (r *withdrawalRepository) EnsureSystemUser(ctx context.Context, username string) (*domain.User, error) {
+    email := username + "@system.invalid"
+    system := &domain.User{
+        Email:    email,
+        Username: username,
+        Role:     domain.RoleUser,
+    }
+    if err := r.db.WithContext(ctx).
+        Clauses(clause.OnConflict{DoNothing: true}).
+        Create(system).Error; err != nil {
+        return nil, err
+    }
+
+    var user domain.User
+    err := r.db.WithContext(ctx).
+        Where("username = ?", username).
+        First(&user).Error
+    if errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrUserNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+    // 같은 이름으로 가입한 일반 계정에 콘텐츠가 넘어가지 않도록 확인
+    if user.Email != email {
+        return nil, ErrSystemUserConflict
+    }
+    return &user, nil
+}
+
+func (r *withdrawalRepository) ApplyContentPolicy(ctx context.Context, userID uint, policy domain.ContentPolicy, systemUserID uint) error {
+    db := r.db.WithContext(ctx)
+
+    switch policy {
+    case domain.ContentAnonymize:
+        // 작성자 행이 소프트 삭제 상태로 남으므로 조회 시 "탈퇴한 사용자"로 표시된다
+        return nil
+
+    case domain.ContentTransfer:
+        if err := db.Model(&domain.Post{}).
+            Where("author_id = ?", userID).
+            Update("author_id", systemUserID).Error; err != nil {
+            return err
+        }
+        return db.Model(&domain.Comment{}).
+            Where("author_id = ?", userID).
+            Update("author_id", systemUserID).Error
+
+    case domain.ContentDelete:
+        if err := db.Where("author_id = ?", userID).Delete(&domain.Post{}).Error; err != nil {
+            return err
+        }
+        // 답글 흐름이 끊기지 않도록 댓글은 "삭제된 댓글"로 남긴다
+        if err := db.Model(&domain.Comment{}).
+            Where("author_id = ?", userID).
+            Updates(map[string]interface{}{"is_deleted": true, "content": ""}).Error; err != nil {
+            return err
+        }
+        if err := db.Exec(`UPDATE posts SET like_count = like_count - 1
+            WHERE id IN (SELECT post_id FROM likes WHERE user_id = ?) AND like_count > 0`, userID).Error; err != nil {
+            return err
+        }
+        if err := db.Where("user_id = ?", userID).Delete(&domain.Like{}).Error; err != nil {
+            return err
+        }
+        return db.Where("sender_id = ?", userID).Delete(&domain.Message{}).Error
+
+    default:
+        return ErrUnknownContentPolicy
+    }
+}
+
+func (r *withdrawalRepository) DeleteUserData(ctx context.Context, userID uint) error {
+    db := r.db.WithContext(ctx)
+
+    // 팔로우 수를 맞춘 뒤 팔로우 관계 삭제
+    if err := db.Exec(`UPDATE users SET follower_count = follower_count - 1
+        WHERE id IN (SELECT target_id FROM follows WHERE follower_id = ? AND target_type = ?) AND follower_count > 0`,
+        userID, domain.FollowTargetUser).Error; err != nil {
+        return err
+    }
+    if err := db.Exec(`UPDATE users SET following_count = following_count - 1
+        WHERE id IN (SELECT follower_id FROM follows WHERE target_id = ? AND target_type = ?) AND following_count > 0`,
+        userID, domain.FollowTargetUser).Error; err != nil {
+        return err
+    }
+    if err := db.Where("follower_id = ? OR (target_id = ? AND target_type = ?)", userID, userID, domain.FollowTargetUser).
+        Delete(&domain.Follow{}).Error; err != nil {
+        return err
+    }
+
+    for _, owned := range userOwnedTables {
+        if err := db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", owned.table, owned.column), userID).Error; err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func (r *withdrawalRepository) ScrubUser(ctx context.Context, userID uint) error {
+    // 유니크 컬럼은 ID 기반 자리표시 값으로 바꿔 재가입을 막지 않는다
+    return r.db.WithContext(ctx).
+        Unscoped().
+        Model(&domain.User{}).
+        Where("id = ?", userID).
+        Updates(map[string]interface{}{
+            "email":               fmt.Sprintf("withdrawn-%d@deleted.invalid", userID),
+            "username":            fmt.Sprintf("withdrawn-%d", userID),
+            "password":            "",
+            "display_name":        "",
+            "bio":                 "",
+            "website":             "",
+            "avatar_url":          "",
+            "avatar_key":          "",
+            "follower_count":      0,
+            "following_count":     0,
+            "email_verified_at":   nil,
+            "password_changed_at": nil,
+            "last_login_at":       nil,
+        }).Error
+}
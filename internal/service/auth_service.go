@@ -0,0 +1,486 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "log"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/validator"
+)
+
+// AuthConfig 로그인 토큰 정책
+type AuthConfig struct {
+    RefreshTokenTTL time.Duration
+    SessionTTL      time.Duration // 토큰을 갱신해도 세션은 이 기간이 지나면 다시 로그인해야 한다
+}
+
+// DefaultAuthConfig 기본 정책
+func DefaultAuthConfig() AuthConfig {
+    return AuthConfig{
+        RefreshTokenTTL: 14 * 24 * time.Hour,
+        SessionTTL:      90 * 24 * time.Hour,
+    }
+}
+
+type AuthService interface {
+    Signup(ctx context.Context, req *dto.SignupRequest) (*dto.SignupResponse, error)
+    // Login 비밀번호 확인 (2단계 인증 사용자는 challenge_token만 반환)
+    Login(ctx context.Context, req *dto.LoginRequest) (*dto.TokenResponse, error)
+    // LoginTwoFactor challenge_token과 TOTP/복구 코드로 로그인 완료
+    LoginTwoFactor(ctx context.Context, req *dto.LoginTwoFactorRequest) (*dto.TokenResponse, error)
+    // CompleteLogin 외부 인증 등으로 본인 확인이 끝난 사용자 로그인 (2단계 인증은 동일하게 적용)
+    CompleteLogin(ctx context.Context, user *domain.User) (*dto.TokenResponse, error)
+    // Refresh 리프레시 토큰 교체 (이미 교체된 토큰이 다시 쓰이면 세션 전체 폐기)
+    Refresh(ctx context.Context, refreshToken string) (*dto.TokenResponse, error)
+    // ChangePassword 비밀번호 변경 후 현재 세션을 제외한 모든 세션 로그아웃
+    ChangePassword(ctx context.Context, req *dto.ChangePasswordRequest) ([]string, error)
+}
+
+type authService struct {
+    userRepo         repository.UserRepository
+    dormantRepo      repository.DormantAccountRepository
+    refreshTokenRepo repository.RefreshTokenRepository
+    sessionRepo      repository.SessionRepository
+    passwordHasher   auth.PasswordHasher
+    passwordPolicy   *PasswordPolicy
+    tokenProvider    *auth.TokenProvider
+    verificationSvc  EmailVerificationService
+    twoFactorSvc     TwoFactorService
+    attemptSvc       LoginAttemptService
+    terminator       SessionTerminator
+    wordFilter       *WordFilterService
+    sanctions        *SanctionStore
+    config           AuthConfig
+    now              func() time.Time
+}
+
+func NewAuthService(
+    userRepo repository.UserRepository,
+    dormantRepo repository.DormantAccountRepository,
+    refreshTokenRepo repository.RefreshTokenRepository,
+    sessionRepo repository.SessionRepository,
+    passwordHasher auth.PasswordHasher,
+    passwordPolicy *PasswordPolicy,
+    tokenProvider *auth.TokenProvider,
+    verificationSvc EmailVerificationService,
+    twoFactorSvc TwoFactorService,
+    attemptSvc LoginAttemptService,
+    terminator SessionTerminator,
+    wordFilter *WordFilterService,
+    sanctions *SanctionStore,
+    config AuthConfig,
+) AuthService {
+    return &authService{
+        userRepo:         userRepo,
+        dormantRepo:      dormantRepo,
+        refreshTokenRepo: refreshTokenRepo,
+        sessionRepo:      sessionRepo,
+        passwordHasher:   passwordHasher,
+        passwordPolicy:   passwordPolicy,
+        tokenProvider:    tokenProvider,
+        verificationSvc:  verificationSvc,
+        twoFactorSvc:     twoFactorSvc,
+        attemptSvc:       attemptSvc,
+        terminator:       terminator,
+        wordFilter:       wordFilter,
+        sanctions:        sanctions,
+        config:           config,
+        now:              time.Now,
+    }
+}
+
+// Signup 회원가입
+// 가입 직후에는 이메일 미인증 상태로, 인증 전까지 읽기 전용으로 제한된다.
+func (s *authService) Signup(ctx context.Context, req *dto.SignupRequest) (*dto.SignupResponse, error) {
+    if err := validator.ValidateEmail(req.Email); err != nil {
+        return nil, err
+    }
+
+    if err := s.wordFilter.CheckName(ctx, FilterFieldUsername, req.Username); err != nil {
+        return nil, err
+    }
+
+    warnings, err := s.passwordPolicy.Validate(ctx, req.Password)
+    if err != nil {
+        return nil, err
+    }
+
+    _, err = s.userRepo.FindByEmail(ctx, req.Email)
+    if err == nil {
+        return nil, ErrEmailAlreadyExists
+    }
+    if !errors.Is(err, repository.ErrUserNotFound) {
+        return nil, err
+    }
+    // 휴면 계정의 이메일은 분리 보관 테이블에만 있다
+    _, err = s.dormantRepo.FindByEmail(ctx, req.Email)
+    if err == nil {
+        return nil, ErrEmailAlreadyExists
+    }
+    if !errors.Is(err, repository.ErrDormantAccountNotFound) {
+        return nil, err
+    }
+
+    hashed, err := s.passwordHasher.Hash(req.Password)
+    if err != nil {
+        return nil, err
+    }
+
+    user := &domain.User{
+        Email:    req.Email,
+        Username: req.Username,
+        Password: hashed,
+        Role:     domain.RoleUser,
+    }
+    if err := s.userRepo.Create(ctx, user); err != nil {
+        return nil, err
+    }
+
+    // 메일 발송 실패는 가입 자체를 막지 않는다 (재발송으로 복구 가능)
+    if err := s.verificationSvc.Issue(ctx, user); err != nil {
+        log.Printf("인증 메일 발송 실패: user=%d - %v", user.ID, err)
+    }
+
+    return &dto.SignupResponse{
+        UserResponse: dto.ToUserResponse(user),
+        Warnings:     warnings,
+    }, nil
+}
+
+func (s *authService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.TokenResponse, error) {
+    ip := middleware.ClientInfoFromContext(ctx).IP
+    if err := s.attemptSvc.Check(ctx, req.Email, ip); err != nil {
+        return nil, err
+    }
+
+    user, err := s.userRepo.FindByEmail(ctx, req.Email)
+    if err != nil {
+        if errors.Is(err, repository.ErrUserNotFound) {
+            return nil, s.checkDormantLogin(ctx, req, ip)
+        }
+        return nil, err
+    }
+
+    // 외부 로그인으로만 가입한 계정은 비밀번호 로그인 불가
+    if !user.HasPassword() || !s.passwordHasher.Compare(user.Password, req.Password) {
+        s.attemptSvc.RecordFailure(ctx, req.Email, ip, user)
+        return nil, ErrInvalidCredentials
+    }
+
+    s.rehashIfNeeded(ctx, user, req.Password)
+
+    resp, err := s.CompleteLogin(ctx, user)
+    if err != nil {
+        return nil, err
+    }
+
+    // 2단계 인증이 남아 있으면 실패 기록을 유지 (비밀번호만 알아도 코드 추측 횟수가 초기화되지 않도록)
+    if !resp.TwoFactorRequired {
+        s.attemptSvc.RecordSuccess(ctx, req.Email)
+    }
+    return resp, nil
+}
+
+// checkDormantLogin users에 없는 이메일이 휴면 계정이면 비밀번호가 맞을 때만 ErrAccountDormant
+// 비밀번호를 모르는 사람에게는 휴면 여부를 드러내지 않는다.
+func (s *authService) checkDormantLogin(ctx context.Context, req *dto.LoginRequest, ip string) error {
+    account, err := s.dormantRepo.FindByEmail(ctx, req.Email)
+    if err != nil {
+        if errors.Is(err, repository.ErrDormantAccountNotFound) {
+            s.attemptSvc.RecordFailure(ctx, req.Email, ip, nil)
+            return ErrInvalidCredentials
+        }
+        return err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, account.UserID)
+    if err != nil {
+        if errors.Is(err, repository.ErrUserNotFound) {
+            s.attemptSvc.RecordFailure(ctx, req.Email, ip, nil)
+            return ErrInvalidCredentials
+        }
+        return err
+    }
+    if !user.HasPassword() || !s.passwordHasher.Compare(user.Password, req.Password) {
+        s.attemptSvc.RecordFailure(ctx, req.Email, ip, user)
+        return ErrInvalidCredentials
+    }
+
+    s.attemptSvc.RecordSuccess(ctx, req.Email)
+    return ErrAccountDormant
+}
+
+// rehashIfNeeded 오래된 알고리즘/비용의 해시를 로그인 시점에 현재 설정으로 교체
+// 실패해도 로그인은 계속 진행한다 (다음 로그인 때 다시 시도).
+func (s *authService) rehashIfNeeded(ctx context.Context, user *domain.User, password string) {
+    if !s.passwordHasher.NeedsRehash(user.Password) {
+        return
+    }
+
+    hashed, err := s.passwordHasher.Hash(password)
+    if err != nil {
+        log.Printf("비밀번호 재해싱 실패: user=%d - %v", user.ID, err)
+        return
+    }
+    if err := s.userRepo.UpdatePasswordHash(ctx, user.ID, user.Password, hashed); err != nil {
+        log.Printf("비밀번호 재해싱 저장 실패: user=%d - %v", user.ID, err)
+        return
+    }
+    user.Password = hashed
+}
+
+func (s *authService) CompleteLogin(ctx context.Context, user *domain.User) (*dto.TokenResponse, error) {
+    // 외부 로그인도 휴면 계정은 이메일 인증으로 재활성화한 뒤에만 가능
+    if user.IsDormant() {
+        return nil, ErrAccountDormant
+    }
+    // 이용 정지 중이면 종료 시각과 함께 거부 (2단계 인증 전에 알린다)
+    if err := s.sanctions.CheckAccess(ctx, user.ID); err != nil {
+        return nil, err
+    }
+
+    enabled, err := s.twoFactorSvc.IsEnabled(ctx, user.ID)
+    if err != nil {
+        return nil, err
+    }
+
+    if enabled {
+        challenge, err := s.tokenProvider.GenerateChallengeToken(user.ID)
+        if err != nil {
+            return nil, err
+        }
+        return &dto.TokenResponse{
+            TwoFactorRequired: true,
+            ChallengeToken:    challenge,
+        }, nil
+    }
+
+    resp, err := s.issueTokens(ctx, user, false)
+    if err != nil {
+        return nil, err
+    }
+
+    // 의무 대상인데 아직 등록하지 않았으면 등록을 안내 (관리 기능은 미들웨어에서 차단)
+    resp.TwoFactorSetupRequired = s.twoFactorSvc.IsRequired(user.Role)
+    return resp, nil
+}
+
+func (s *authService) LoginTwoFactor(ctx context.Context, req *dto.LoginTwoFactorRequest) (*dto.TokenResponse, error) {
+    userID, err := s.tokenProvider.ParseChallengeToken(req.ChallengeToken)
+    if err != nil {
+        return nil, ErrInvalidChallenge
+    }
+
+    user, err := s.userRepo.FindByID(ctx, userID)
+    if err != nil {
+        return nil, ErrInvalidChallenge
+    }
+
+    // 2단계 코드 추측도 같은 계정 실패로 센다
+    ip := middleware.ClientInfoFromContext(ctx).IP
+    if err := s.attemptSvc.Check(ctx, user.Email, ip); err != nil {
+        return nil, err
+    }
+
+    if err := s.twoFactorSvc.Verify(ctx, user.ID, req.Code); err != nil {
+        if errors.Is(err, ErrInvalidTwoFactorCode) {
+            s.attemptSvc.RecordFailure(ctx, user.Email, ip, user)
+        }
+        return nil, err
+    }
+    s.attemptSvc.RecordSuccess(ctx, user.Email)
+
+    return s.issueTokens(ctx, user, true)
+}
+
+func (s *authService) Refresh(ctx context.Context, refreshToken string) (*dto.TokenResponse, error) {
+    token, err := s.refreshTokenRepo.FindByTokenHash(ctx, auth.HashToken(refreshToken))
+    if err != nil {
+        if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+            return nil, ErrInvalidRefreshToken
+        }
+        return nil, err
+    }
+
+    now := s.now()
+    if token.RevokedAt != nil {
+        // 교체된 토큰의 재사용은 탈취로 간주
+        s.revokeSession(ctx, token.UserID, token.SessionID, now)
+        return nil, ErrInvalidRefreshToken
+    }
+    if !token.IsActive(now) {
+        return nil, ErrInvalidRefreshToken
+    }
+
+    session, err := s.sessionRepo.FindByID(ctx, token.SessionID)
+    if err != nil {
+        if errors.Is(err, repository.ErrSessionNotFound) {
+            return nil, ErrInvalidRefreshToken
+        }
+        return nil, err
+    }
+    if !session.IsActive(now) {
+        return nil, ErrInvalidRefreshToken
+    }
+
+    user, err := s.userRepo.FindByID(ctx, token.UserID)
+    if err != nil || user.IsDormant() {
+        return nil, ErrInvalidRefreshToken
+    }
+    // 제재 시 세션은 폐기되지만, 캐시 반영 전 갱신이나 정리 실패에 대비해 다시 확인
+    if err := s.sanctions.CheckAccess(ctx, user.ID); err != nil {
+        return nil, err
+    }
+
+    // 동시에 같은 토큰으로 갱신하면 하나만 성공
+    if err := s.refreshTokenRepo.Revoke(ctx, token.ID, now); err != nil {
+        if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+            return nil, ErrInvalidRefreshToken
+        }
+        return nil, err
+    }
+
+    client := middleware.ClientInfoFromContext(ctx)
+    if err := s.sessionRepo.Touch(ctx, session.ID, client.IP, now, now); err != nil {
+        log.Printf("세션 접속 시각 갱신 실패: session=%d - %v", session.ID, err)
+    }
+
+    return s.issueSessionTokens(ctx, user, session, now)
+}
+
+func (s *authService) ChangePassword(ctx context.Context, req *dto.ChangePasswordRequest) ([]string, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, claims.UserID)
+    if err != nil {
+        return nil, err
+    }
+
+    // 비밀번호가 없는 계정(외부 로그인 가입)은 처음 설정할 때 현재 비밀번호를 묻지 않는다
+    if user.HasPassword() && !s.passwordHasher.Compare(user.Password, req.CurrentPassword) {
+        return nil, ErrWrongPassword
+    }
+
+    warnings, err := s.passwordPolicy.Validate(ctx, req.NewPassword)
+    if err != nil {
+        return nil, err
+    }
+
+    hashed, err := s.passwordHasher.Hash(req.NewPassword)
+    if err != nil {
+        return nil, err
+    }
+
+    now := s.now()
+    if err := s.userRepo.UpdatePassword(ctx, user.ID, hashed, now); err != nil {
+        return nil, err
+    }
+
+    revoked, err := s.sessionRepo.RevokeAllByUserID(ctx, user.ID, claims.SessionID, now)
+    if err != nil {
+        return nil, err
+    }
+    if err := s.refreshTokenRepo.RevokeBySessionIDs(ctx, revoked, now); err != nil {
+        return nil, err
+    }
+    s.terminator.CloseSessions(revoked...)
+
+    return warnings, nil
+}
+
+// revokeSession 세션과 그 리프레시 토큰을 폐기하고 실시간 연결 종료
+func (s *authService) revokeSession(ctx context.Context, userID, sessionID uint, now time.Time) {
+    if err := s.sessionRepo.Revoke(ctx, sessionID, userID, now); err != nil && !errors.Is(err, repository.ErrSessionNotFound) {
+        log.Printf("세션 폐기 실패: session=%d - %v", sessionID, err)
+        return
+    }
+    if err := s.refreshTokenRepo.RevokeBySessionIDs(ctx, []uint{sessionID}, now); err != nil {
+        log.Printf("세션 토큰 폐기 실패: session=%d - %v", sessionID, err)
+    }
+    s.terminator.CloseSessions(sessionID)
+}
+
+// issueTokens 새 로그인 세션을 만들고 토큰 발급
+func (s *authService) issueTokens(ctx context.Context, user *domain.User, mfa bool) (*dto.TokenResponse, error) {
+    // 2단계 인증 도중 휴면으로 전환되거나 이용 정지된 경우
+    if user.IsDormant() {
+        return nil, ErrAccountDormant
+    }
+    if err := s.sanctions.CheckAccess(ctx, user.ID); err != nil {
+        return nil, err
+    }
+
+    now := s.now()
+    client := middleware.ClientInfoFromContext(ctx)
+
+    label := client.DeviceName
+    if label == "" {
+        label = deviceLabel(client.UserAgent)
+    }
+    label = truncateRunes(label, 100)
+    userAgent := truncateRunes(client.UserAgent, 512)
+
+    session := &domain.Session{
+        UserID:      user.ID,
+        DeviceLabel: label,
+        UserAgent:   userAgent,
+        IP:          client.IP,
+        MFA:         mfa,
+        LastSeenAt:  now,
+        ExpiresAt:   now.Add(s.config.SessionTTL),
+    }
+    if err := s.sessionRepo.Create(ctx, session); err != nil {
+        return nil, err
+    }
+
+    if err := s.userRepo.UpdateLastLogin(ctx, user.ID, now); err != nil {
+        log.Printf("마지막 로그인 시각 갱신 실패: user=%d - %v", user.ID, err)
+    }
+
+    return s.issueSessionTokens(ctx, user, session, now)
+}
+
+// issueSessionTokens 세션에 속한 액세스 토큰과 리프레시 토큰 발급
+func (s *authService) issueSessionTokens(ctx context.Context, user *domain.User, session *domain.Session, now time.Time) (*dto.TokenResponse, error) {
+    accessToken, err := s.tokenProvider.GenerateAccessToken(user, session.ID, session.MFA)
+    if err != nil {
+        return nil, err
+    }
+
+    raw, hash, err := auth.GenerateToken()
+    if err != nil {
+        return nil, err
+    }
+
+    // 리프레시 토큰은 세션 만료를 넘겨 쓸 수 없다
+    expiresAt := now.Add(s.config.RefreshTokenTTL)
+    if expiresAt.After(session.ExpiresAt) {
+        expiresAt = session.ExpiresAt
+    }
+
+    refreshToken := &domain.RefreshToken{
+        UserID:    user.ID,
+        SessionID: session.ID,
+        TokenHash: hash,
+        ExpiresAt: expiresAt,
+    }
+    if err := s.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
+        return nil, err
+    }
+
+    return &dto.TokenResponse{
+        AccessToken:  accessToken,
+        RefreshToken: raw,
+        TokenType:    "Bearer",
+        ExpiresIn:    int(s.tokenProvider.AccessTTL().Seconds()),
+    }, nil
+}
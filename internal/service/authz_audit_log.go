@@ -0,0 +1,108 @@
+package service
+
+import (
+    "context"
+    "log"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+)
+
+// AuthzAuditConfig 권한 거부 기록 정책
+type AuthzAuditConfig struct {
+    QueueSize     int           // 저장을 기다리는 기록 수 (가득 차면 새 기록은 버림)
+    BatchSize     int           // 한 번에 저장하는 기록 수
+    FlushInterval time.Duration // BatchSize를 채우지 못해도 이 간격마다 저장
+    Retention     time.Duration // 이보다 오래된 기록은 정리
+}
+
+// DefaultAuthzAuditConfig 기본 정책
+func DefaultAuthzAuditConfig() AuthzAuditConfig {
+    return AuthzAuditConfig{
+        QueueSize:     10000,
+        BatchSize:     100,
+        FlushInterval: 2 * time.Second,
+        Retention:     90 * 24 * time.Hour,
+    }
+}
+
+// authzDenialReasonMax authz_denials.reason 컬럼 길이
+const authzDenialReasonMax = 255
+
+// AuthzAuditLog 권한 거부 기록 (middleware.DenialRecorder)
+//
+// 거부가 몰려도 요청이 DB 쓰기를 기다리지 않도록 큐에 넣고 Run에서 모아 저장한다.
+type AuthzAuditLog struct {
+    denialRepo repository.AuthzDenialRepository
+    config     AuthzAuditConfig
+    queue      chan *domain.AuthzDenial
+    now        func() time.Time
+}
+
+func NewAuthzAuditLog(denialRepo repository.AuthzDenialRepository, config AuthzAuditConfig) *AuthzAuditLog {
+    return &AuthzAuditLog{
+        denialRepo: denialRepo,
+        config:     config,
+        queue:      make(chan *domain.AuthzDenial, config.QueueSize),
+        now:        time.Now,
+    }
+}
+
+// RecordDenial 큐가 가득 차면 기록을 버린다 (요청 처리를 막지 않음)
+func (l *AuthzAuditLog) RecordDenial(denial *domain.AuthzDenial) {
+    denial.CreatedAt = l.now()
+    if r := []rune(denial.Reason); len(r) > authzDenialReasonMax {
+        denial.Reason = string(r[:authzDenialReasonMax])
+    }
+
+    select {
+    case l.queue <- denial:
+    default:
+        log.Printf("권한 거부 기록 큐가 가득 참: request_id=%s user=%d", denial.RequestID, denial.UserID)
+    }
+}
+
+// Run ctx가 끝날 때까지 큐의 기록을 모아 저장 (끝나면 남은 기록까지 저장)
+func (l *AuthzAuditLog) Run(ctx context.Context) {
+    ticker := time.NewTicker(l.config.FlushInterval)
+    defer ticker.Stop()
+
+    batch := make([]*domain.AuthzDenial, 0, l.config.BatchSize)
+    flush := func(ctx context.Context) {
+        if len(batch) == 0 {
+            return
+        }
+        if err := l.denialRepo.CreateBatch(ctx, batch); err != nil {
+            log.Printf("권한 거부 기록 저장 실패 (%d건): %v", len(batch), err)
+        }
+        batch = make([]*domain.AuthzDenial, 0, l.config.BatchSize)
+    }
+
+    for {
+        select {
+        case <-ctx.Done():
+            for {
+                select {
+                case denial := <-l.queue:
+                    batch = append(batch, denial)
+                default:
+                    flush(context.Background())
+                    return
+                }
+            }
+        case denial := <-l.queue:
+            batch = append(batch, denial)
+            if len(batch) >= l.config.BatchSize {
+                flush(ctx)
+            }
+        case <-ticker.C:
+            flush(ctx)
+        }
+    }
+}
+
+// Cleanup 보관 기간이 지난 기록 정리 (스케줄러에서 호출)
+func (l *AuthzAuditLog) Cleanup(ctx context.Context) (int64, error) {
+    return l.denialRepo.DeleteOlderThan(ctx, l.now().Add(-l.config.Retention))
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+    "context"
+    "strings"
+    "sync"
+    "testing"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+)
+
+type fakeAuthzDenialRepository struct {
+    repository.AuthzDenialRepository
+    mu      sync.Mutex
+    saved   []*domain.AuthzDenial
+    batches int
+}
+
+func (r *fakeAuthzDenialRepository) CreateBatch(ctx context.Context, denials []*domain.AuthzDenial) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    r.saved = append(r.saved, denials...)
+    r.batches++
+    return nil
+}
+
+func TestAuthzAuditLogFlushesOnShutdown(t *testing.T) {
+    repo := &fakeAuthzDenialRepository{}
+    config := AuthzAuditConfig{QueueSize: 10, BatchSize: 3, FlushInterval: time.Hour}
+    l := NewAuthzAuditLog(repo, config)
+
+    for i := 0; i < 7; i++ {
+        l.RecordDenial(&domain.AuthzDenial{RequestID: "req", UserID: uint(i), Check: "test"})
+    }
+
+    ctx, cancel := context.WithCancel(context.Background())
+    done := make(chan struct{})
+    go func() {
+        l.Run(ctx)
+        close(done)
+    }()
+
+    // 배치 두 개(3건씩)가 저장될 때까지 기다린 뒤 종료하면 남은 1건도 저장되어야 한다
+    deadline := time.Now().Add(time.Second)
+    for {
+        repo.mu.Lock()
+        batches := repo.batches
+        repo.mu.Unlock()
+        if batches >= 2 || time.Now().After(deadline) {
+            break
+        }
+        time.Sleep(time.Millisecond)
+    }
+    cancel()
+    <-done
+
+    if len(repo.saved) != 7 {
+        t.Fatalf("saved %d denials, want 7", len(repo.saved))
+    }
+    if repo.saved[0].CreatedAt.IsZero() {
+        t.Error("CreatedAt should be set when recorded")
+    }
+}
+
+func TestAuthzAuditLogDropsWhenFull(t *testing.T) {
+    l := NewAuthzAuditLog(&fakeAuthzDenialRepository{}, AuthzAuditConfig{QueueSize: 2, BatchSize: 10, FlushInterval: time.Hour})
+
+    // Run 없이도 요청 경로가 막히지 않아야 한다
+    for i := 0; i < 5; i++ {
+        l.RecordDenial(&domain.AuthzDenial{Reason: strings.Repeat("가", 300)})
+    }
+    if got := len(l.queue); got != 2 {
+        t.Fatalf("queue length = %d, want 2", got)
+    }
+    if got := len([]rune((<-l.queue).Reason)); got != authzDenialReasonMax {
+        t.Errorf("reason length = %d, want %d", got, authzDenialReasonMax)
+    }
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/policy"
+    "goboardapi/internal/repository"
+)
+
+const (
+    authzDenialDefaultSize = 50
+    authzDenialMaxSize     = 100
+)
+
+// AuthzService 권한 판단 설명과 거부 기록 조회 (role:manage 권한 필요)
+type AuthzService interface {
+    // Explain 사용자가 action을 할 수 있는지 역할 권한과 정책을 모두 평가해 규칙별 결과 반환
+    Explain(ctx context.Context, req *dto.AuthzExplainRequest) (*dto.AuthzExplainResponse, error)
+    // ListDenials 권한 거부 기록 (최신순, 요청 ID나 사용자로 필터)
+    ListDenials(ctx context.Context, query *dto.AuthzDenialQuery) ([]*dto.AuthzDenialResponse, error)
+}
+
+type authzService struct {
+    userRepo   repository.UserRepository
+    postRepo   repository.PostRepository
+    boardRepo  repository.BoardRepository
+    roleRepo   repository.RoleRepository
+    grantRepo  repository.TemporaryGrantRepository
+    denialRepo repository.AuthzDenialRepository
+    now        func() time.Time
+}
+
+func NewAuthzService(
+    userRepo repository.UserRepository,
+    postRepo repository.PostRepository,
+    boardRepo repository.BoardRepository,
+    roleRepo repository.RoleRepository,
+    grantRepo repository.TemporaryGrantRepository,
+    denialRepo repository.AuthzDenialRepository,
+) AuthzService {
+    return &authzService{
+        userRepo:   userRepo,
+        postRepo:   postRepo,
+        boardRepo:  boardRepo,
+        roleRepo:   roleRepo,
+        grantRepo:  grantRepo,
+        denialRepo: denialRepo,
+        now:        time.Now,
+    }
+}
+
+func (s *authzService) Explain(ctx context.Context, req *dto.AuthzExplainRequest) (*dto.AuthzExplainResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, req.UserID)
+    if err != nil {
+        return nil, err
+    }
+    resource, scope, err := s.loadResource(ctx, req.ResourceType, req.ResourceID)
+    if err != nil {
+        return nil, err
+    }
+
+    resp := &dto.AuthzExplainResponse{
+        UserID: user.ID,
+        Role:   string(user.Role),
+        Action: req.Action,
+        Rules:  []dto.AuthzRuleResult{},
+    }
+    if resource != nil {
+        resp.Resource = fmt.Sprintf("%s:%d", req.ResourceType, req.ResourceID)
+    }
+
+    // 역할 권한: 결과는 RequirePermission과 같은 경로로 판단하고, 규칙은 전역 역할, 대상 게시판에 부여된 역할 순으로 보여준다
+    permission := domain.Permission(req.Action)
+    resp.PermissionAllowed = domain.HasPermissionIn(ctx, user.ID, user.Role, permission, scope)
+    resp.Rules = append(resp.Rules, roleRule("role", "role:"+string(user.Role), domain.HasPermission(user.Role, permission)))
+
+    if !scope.IsZero() {
+        assignments, err := s.roleRepo.FindAssignmentsByScope(ctx, scope)
+        if err != nil {
+            return nil, err
+        }
+        for _, assignment := range assignments {
+            if assignment.UserID != user.ID {
+                continue
+            }
+            resp.Rules = append(resp.Rules, roleRule("scoped_role", string(assignment.Role)+"@"+scope.String(), domain.HasPermission(assignment.Role, permission)))
+        }
+    }
+
+    // 임시 부여: 지금 적용 중이고 전역이거나 대상 범위에 부여된 것
+    grants, err := s.grantRepo.FindByUser(ctx, user.ID)
+    if err != nil {
+        return nil, err
+    }
+    now := s.now()
+    for _, grant := range grants {
+        if !grant.ActiveAt(now) {
+            continue
+        }
+        if grantScope := grant.Scope(); !grantScope.IsZero() && grantScope != scope {
+            continue
+        }
+        resp.Rules = append(resp.Rules, temporaryGrantRule(grant, permission))
+    }
+
+    // 정책: 대상 사용자로 인증된 것처럼 평가 (토큰 범위 제한은 없음)
+    subjectCtx := middleware.WithUser(ctx, &middleware.Claims{
+        UserID: user.ID,
+        Email:  user.Email,
+        Role:   string(user.Role),
+    })
+    decision, traces := auth.NewChecker().Explain(subjectCtx, req.Action, resource)
+    resp.PolicyAllowed = decision.Allowed
+    resp.PolicyDecision = decision.Reason
+    if decision.Policy != "" {
+        resp.PolicyDecision = decision.Policy + ": " + decision.Reason
+    }
+    for _, trace := range traces {
+        resp.Rules = append(resp.Rules, dto.AuthzRuleResult{
+            Source: "policy",
+            Rule:   trace.Policy,
+            Effect: string(trace.Effect),
+            Result: string(trace.Result),
+            Detail: trace.Error,
+        })
+    }
+
+    return resp, nil
+}
+
+// loadResource 정책 평가 대상과 그 범위 (resourceType이 비어 있으면 대상 없음)
+func (s *authzService) loadResource(ctx context.Context, resourceType string, id uint) (policy.Resource, domain.Scope, error) {
+    switch resourceType {
+    case "":
+        return nil, domain.Scope{}, nil
+    case "post":
+        post, err := s.postRepo.FindByID(ctx, id)
+        if err != nil {
+            return nil, domain.Scope{}, err
+        }
+        return post, post.PolicyScope(), nil
+    case "board":
+        board, err := s.boardRepo.FindByID(ctx, id)
+        if err != nil {
+            return nil, domain.Scope{}, err
+        }
+        return board, board.PolicyScope(), nil
+    }
+    return nil, domain.Scope{}, ErrUnsupportedResource
+}
+
+func roleRule(source, rule string, granted bool) dto.AuthzRuleResult {
+    result := "not_granted"
+    if granted {
+        result = "granted"
+    }
+    return dto.AuthzRuleResult{Source: source, Rule: rule, Result: result}
+}
+
+// temporaryGrantRule 임시 부여 규칙 (detail에 부여 ID와 종료 시각)
+func temporaryGrantRule(grant *domain.TemporaryGrant, permission domain.Permission) dto.AuthzRuleResult {
+    rule := string(grant.Permission)
+    if grant.Role != "" {
+        rule = "role:" + string(grant.Role)
+    }
+    if scope := grant.Scope(); !scope.IsZero() {
+        rule += "@" + scope.String()
+    }
+
+    result := roleRule("temporary_grant", rule, grant.Grants(permission, domain.HasPermission))
+    result.Detail = fmt.Sprintf("grant %d until %s", grant.ID, grant.EndsAt.Format(time.RFC3339))
+    return result
+}
+
+func (s *authzService) ListDenials(ctx context.Context, query *dto.AuthzDenialQuery) ([]*dto.AuthzDenialResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+
+    size := query.Size
+    if size <= 0 {
+        size = authzDenialDefaultSize
+    }
+    if size > authzDenialMaxSize {
+        size = authzDenialMaxSize
+    }
+
+    denials, err := s.denialRepo.Find(ctx, repository.AuthzDenialQuery{
+        UserID:    query.UserID,
+        RequestID: query.RequestID,
+        Since:     query.Since,
+        BeforeID:  query.Before,
+        Limit:     size,
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    resp := make([]*dto.AuthzDenialResponse, 0, len(denials))
+    for _, denial := range denials {
+        resp = append(resp, dto.ToAuthzDenialResponse(denial))
+    }
+    return resp, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+)
+
+func (r *fakeTemporaryGrantRepository) FindByUser(ctx context.Context, userID uint) ([]*domain.TemporaryGrant, error) {
+    var grants []*domain.TemporaryGrant
+    for _, grant := range r.grants {
+        if grant.UserID == userID {
+            grants = append(grants, grant)
+        }
+    }
+    return grants, nil
+}
+
+func TestAuthzExplainTemporaryGrant(t *testing.T) {
+    now := time.Now()
+    roles := &fakeRoleRepository{grants: []*domain.RolePermission{
+        {Role: domain.RoleAdmin, Permission: domain.PermissionRoleManage},
+    }}
+    grants := &fakeTemporaryGrantRepository{grants: []*domain.TemporaryGrant{
+        {ID: 5, UserID: 7, Permission: domain.PermissionPostManage, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+        // 끝난 부여는 규칙에 나오지 않는다
+        {ID: 4, UserID: 7, Permission: domain.PermissionPostManage, StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)},
+    }}
+    domain.SetPermissionSource(NewPermissionStore(roles, grants, cache.NewLocalInvalidator(), time.Hour))
+    defer domain.SetPermissionSource(nil)
+
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        7: {ID: 7, Role: domain.RoleUser},
+    }}
+    svc := NewAuthzService(users, nil, nil, roles, grants, nil)
+    ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 1, Role: string(domain.RoleAdmin)})
+
+    resp, err := svc.Explain(ctx, &dto.AuthzExplainRequest{UserID: 7, Action: string(domain.PermissionPostManage)})
+    if err != nil {
+        t.Fatalf("Explain() error = %v", err)
+    }
+    if !resp.PermissionAllowed {
+        t.Error("PermissionAllowed = false, want true through the active grant")
+    }
+
+    var grantRules []dto.AuthzRuleResult
+    for _, rule := range resp.Rules {
+        if rule.Source == "temporary_grant" {
+            grantRules = append(grantRules, rule)
+        }
+    }
+    if len(grantRules) != 1 || grantRules[0].Rule != string(domain.PermissionPostManage) || grantRules[0].Result != "granted" {
+        t.Errorf("temporary_grant rules = %+v, want one granted post:manage rule", grantRules)
+    }
+}
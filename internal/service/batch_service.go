@@ -3,22 +3,61 @@ package service
 import (
     "context"
     "log"
+    "time"
 
     "yourproject/internal/domain"
     "yourproject/internal/repository"
 )
 
+// inactiveUsersCheckpoint 휴면 처리 배치의 진행 위치 이름
+const inactiveUsersCheckpoint = "inactive_users"
+
 type BatchService struct {
-    userRepo repository.UserRepository
+    userRepo       repository.UserRepository
+    checkpointRepo repository.BatchCheckpointRepository
+    dormancySvc    DormancyService
+    config         DormancyConfig
+    now            func() time.Time
+}
+
+func NewBatchService(
+    userRepo repository.UserRepository,
+    checkpointRepo repository.BatchCheckpointRepository,
+    dormancySvc DormancyService,
+    config DormancyConfig,
+) *BatchService {
+    return &BatchService{
+        userRepo:       userRepo,
+        checkpointRepo: checkpointRepo,
+        dormancySvc:    dormancySvc,
+        config:         config,
+        now:            time.Now,
+    }
 }
 
+// ProcessInactiveUsers 비활성 사용자에게 휴면 안내 또는 휴면 전환 적용
+// 배치마다 lastID를 저장하므로 중단되면 다음 실행에서 이어서 처리하고, 끝까지 돌면 처음으로 되돌린다.
 func (s *BatchService) ProcessInactiveUsers(ctx context.Context) error {
     const batchSize = 100
-    var lastID uint = 0
+
+    lastID, err := s.checkpointRepo.Get(ctx, inactiveUsersCheckpoint)
+    if err != nil {
+        return err
+    }
+    if lastID > 0 {
+        log.Printf("이전 배치 이어서 처리: lastID=%d", lastID)
+    }
+
+    now := s.now()
+    inactiveBefore := s.config.InactiveBefore(now)
 
     for {
+        if err := ctx.Err(); err != nil {
+            return err
+        }
+
         // 배치 조회
-        users, err := s.userRepo.FindInactiveBatch(ctx, lastID, batchSize)
+        users, err := s.userRepo.FindInactiveBatch(ctx, lastID, inactiveBefore, batchSize)
         if err != nil {
             return err
         }
@@ -29,21 +68,24 @@ func (s *BatchService) ProcessInactiveUsers(ctx context.Context) error {
 
         // 배치 처리
         for _, user := range users {
-            if err := s.processInactiveUser(ctx, user); err != nil {
+            if err := s.processInactiveUser(ctx, user, now); err != nil {
+                // 실패한 사용자는 다음 전체 실행에서 다시 대상이 된다
                 log.Printf("처리 실패: %d - %v", user.ID, err)
             }
         }
 
         // 다음 배치를 위한 마지막 ID 업데이트
         lastID = users[len(users)-1].ID
+        if err := s.checkpointRepo.Save(ctx, inactiveUsersCheckpoint, lastID, s.now()); err != nil {
+            return err
+        }
 
         log.Printf("배치 처리 완료: lastID=%d", lastID)
     }
 
-    return nil
+    return s.checkpointRepo.Save(ctx, inactiveUsersCheckpoint, 0, s.now())
 }
 
-func (s *BatchService) processInactiveUser(ctx context.Context, user *domain.User) error {
-    // 비활성 사용자 처리 로직
-    return nil
+func (s *BatchService) processInactiveUser(ctx context.Context, user *domain.User, now time.Time) error {
+    return s.dormancySvc.Process(ctx, user, now)
 }
@@ -0,0 +1,180 @@
+package service
+
+import (
+    "context"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+
+    "gorm.io/gorm"
+)
+
+// 실시간 전송 시 차단 여부 확인 제한 시간
+const deliveryCheckTimeout = 2 * time.Second
+
+type BlockService interface {
+    // Block 사용자 차단 (서로의 팔로우도 해제된다)
+    Block(ctx context.Context, username string) error
+    Unblock(ctx context.Context, username string) error
+    // Mute 사용자 뮤트 (내 화면에서만 숨김)
+    Mute(ctx context.Context, username string) error
+    Unmute(ctx context.Context, username string) error
+    // List 내가 차단/뮤트한 사용자 목록
+    List(ctx context.Context, kind domain.BlockKind, p *dto.Pagination) ([]*domain.User, int64, error)
+    // CheckInteraction actor가 owner에게 댓글/답글/멘션할 수 있는지 (차단 관계면 ErrBlocked)
+    CheckInteraction(ctx context.Context, actorID, ownerID uint) error
+    // CanDeliver actor가 일으킨 실시간 메시지를 recipient에게 보내도 되는지 (ws.DeliveryFilter)
+    CanDeliver(recipientID, actorID uint) bool
+}
+
+type blockService struct {
+    db        *gorm.DB
+    userRepo  repository.UserRepository
+    blockRepo repository.UserBlockRepository
+    feedRepo  repository.FeedRepository
+}
+
+func NewBlockService(
+    db *gorm.DB,
+    userRepo repository.UserRepository,
+    blockRepo repository.UserBlockRepository,
+    feedRepo repository.FeedRepository,
+) BlockService {
+    return &blockService{
+        db:        db,
+        userRepo:  userRepo,
+        blockRepo: blockRepo,
+        feedRepo:  feedRepo,
+    }
+}
+
+func (s *blockService) Block(ctx context.Context, username string) error {
+    claims, target, err := s.resolveTarget(ctx, username)
+    if err != nil {
+        return err
+    }
+
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := repository.NewUserBlockRepository(tx).Block(ctx, claims.UserID, target.ID); err != nil {
+            return err
+        }
+
+        // 차단하면 양방향 팔로우를 모두 끊는다
+        followRepo := repository.NewFollowRepository(tx)
+        for _, pair := range [][2]uint{{claims.UserID, target.ID}, {target.ID, claims.UserID}} {
+            deleted, err := followRepo.Delete(ctx, pair[0], domain.FollowTargetUser, pair[1])
+            if err != nil {
+                return err
+            }
+            if deleted {
+                if err := followRepo.AdjustUserCounts(ctx, pair[0], pair[1], -1); err != nil {
+                    return err
+                }
+            }
+        }
+        return nil
+    })
+    if err != nil {
+        return err
+    }
+
+    log := middleware.LoggerFromRequestContext(ctx)
+    if err := s.feedRepo.RemoveAuthor(ctx, claims.UserID, target.ID); err != nil {
+        log.Warn("차단 피드 정리 실패", "user_id", claims.UserID, "error", err)
+    }
+    if err := s.feedRepo.RemoveAuthor(ctx, target.ID, claims.UserID); err != nil {
+        log.Warn("차단 피드 정리 실패", "user_id", target.ID, "error", err)
+    }
+
+    return nil
+}
+
+func (s *blockService) Unblock(ctx context.Context, username string) error {
+    claims, target, err := s.resolveTarget(ctx, username)
+    if err != nil {
+        return err
+    }
+
+    _, err = s.blockRepo.Delete(ctx, claims.UserID, target.ID, domain.BlockKindBlock)
+    return err
+}
+
+func (s *blockService) Mute(ctx context.Context, username string) error {
+    claims, target, err := s.resolveTarget(ctx, username)
+    if err != nil {
+        return err
+    }
+
+    return s.blockRepo.Mute(ctx, claims.UserID, target.ID)
+}
+
+func (s *blockService) Unmute(ctx context.Context, username string) error {
+    claims, target, err := s.resolveTarget(ctx, username)
+    if err != nil {
+        return err
+    }
+
+    _, err = s.blockRepo.Delete(ctx, claims.UserID, target.ID, domain.BlockKindMute)
+    return err
+}
+
+func (s *blockService) List(ctx context.Context, kind domain.BlockKind, p *dto.Pagination) ([]*domain.User, int64, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, 0, ErrUnauthorized
+    }
+
+    return s.blockRepo.FindByBlocker(ctx, claims.UserID, kind, p.Offset(), p.Size)
+}
+
+func (s *blockService) CheckInteraction(ctx context.Context, actorID, ownerID uint) error {
+    if actorID == ownerID {
+        return nil
+    }
+
+    blocked, err := s.blockRepo.IsBlockedEither(ctx, actorID, ownerID)
+    if err != nil {
+        return err
+    }
+    if blocked {
+        return ErrBlocked
+    }
+    return nil
+}
+
+func (s *blockService) CanDeliver(recipientID, actorID uint) bool {
+    if actorID == 0 || recipientID == actorID {
+        return true
+    }
+
+    ctx, cancel := context.WithTimeout(context.Background(), deliveryCheckTimeout)
+    defer cancel()
+
+    hidden, err := s.blockRepo.Hides(ctx, recipientID, actorID)
+    if err != nil {
+        // 확인할 수 없으면 보내지 않는다 (알림은 DB에 남아 있으므로 목록에서 다시 볼 수 있다)
+        middleware.LoggerFromRequestContext(ctx).Warn("실시간 전송 차단 확인 실패", "recipient_id", recipientID, "error", err)
+        return false
+    }
+    return !hidden
+}
+
+func (s *blockService) resolveTarget(ctx context.Context, username string) (*middleware.Claims, *domain.User, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, nil, ErrUnauthorized
+    }
+
+    target, err := s.userRepo.FindByUsername(ctx, username)
+    if err != nil {
+        return nil, nil, err
+    }
+    if target.ID == claims.UserID {
+        return nil, nil, ErrCannotBlockSelf
+    }
+
+    return claims, target, nil
+}
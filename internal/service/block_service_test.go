@@ -0,0 +1,147 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/notification"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/ws"
+)
+
+// fakeUserBlockRepository blocks[viewer][actor]: viewer가 actor를 차단(true)/뮤트(false)
+type fakeUserBlockRepository struct {
+    repository.UserBlockRepository
+    blocks map[uint]map[uint]bool
+    err    error
+}
+
+func (r *fakeUserBlockRepository) IsBlockedEither(ctx context.Context, userA, userB uint) (bool, error) {
+    return r.blocks[userA][userB] || r.blocks[userB][userA], r.err
+}
+
+func (r *fakeUserBlockRepository) Hides(ctx context.Context, viewerID, actorID uint) (bool, error) {
+    _, viewerHides := r.blocks[viewerID][actorID]
+    return viewerHides || r.blocks[actorID][viewerID], r.err
+}
+
+type fakeCommentRepository struct {
+    repository.CommentRepository
+    viewerID uint
+}
+
+func (r *fakeCommentRepository) FindByPostID(ctx context.Context, postID, viewerID uint) ([]*domain.Comment, error) {
+    r.viewerID = viewerID
+    return nil, nil
+}
+
+type fakeNotificationRepository struct {
+    repository.NotificationRepository
+    recipients []uint
+    types      []notification.NotificationType
+}
+
+func (r *fakeNotificationRepository) Create(ctx context.Context, userID uint, notif *notification.Notification) error {
+    r.recipients = append(r.recipients, userID)
+    r.types = append(r.types, notif.Type)
+    return nil
+}
+
+func TestBlockedAuthorComments(t *testing.T) {
+    verifiedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        2: {ID: 2, EmailVerifiedAt: &verifiedAt},
+    }}
+    // 1이 2를 차단
+    blocks := &fakeUserBlockRepository{blocks: map[uint]map[uint]bool{1: {2: true}}}
+    comments := &fakeCommentRepository{}
+    svc := NewCommentService(
+        comments,
+        &fakePostRepository{post: &domain.Post{ID: 10, AuthorID: 1}},
+        users, nil,
+        NewBlockService(nil, users, blocks, nil),
+        nil, nil, nil,
+    )
+
+    // 차단한 사용자의 글에는 댓글을 달 수 없다
+    ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 2})
+    if _, err := svc.Create(ctx, 10, &dto.CreateCommentRequest{Content: "hello"}); !errors.Is(err, ErrBlocked) {
+        t.Errorf("Create() error = %v, want ErrBlocked", err)
+    }
+
+    // 목록은 보는 사람 기준으로 차단/뮤트한 작성자의 댓글을 뺀다
+    ctx = middleware.WithUser(context.Background(), &middleware.Claims{UserID: 1})
+    if _, err := svc.ListByPost(ctx, 10); err != nil || comments.viewerID != 1 {
+        t.Errorf("ListByPost() viewer = %d, %v, want 1", comments.viewerID, err)
+    }
+    if _, err := svc.ListByPost(context.Background(), 10); err != nil || comments.viewerID != 0 {
+        t.Errorf("ListByPost(anonymous) viewer = %d, %v, want 0", comments.viewerID, err)
+    }
+}
+
+func TestBlockedAuthorMentions(t *testing.T) {
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        1: {ID: 1, Username: "author"},
+        2: {ID: 2, Username: "blocker"},
+        3: {ID: 3, Username: "muter"},
+        4: {ID: 4, Username: "friend"},
+        5: {ID: 5, Username: "blocked"},
+    }}
+    // 2는 작성자를 차단, 3은 뮤트, 작성자는 5를 차단
+    blocks := &fakeUserBlockRepository{blocks: map[uint]map[uint]bool{
+        2: {1: true},
+        3: {1: false},
+        1: {5: true},
+    }}
+    notifs := &fakeNotificationRepository{}
+    svc := NewNotificationService(ws.NewHub(), notifs, users, blocks)
+
+    err := svc.CreateMentionNotifications(context.Background(), "@blocker @muter @friend @blocked @author", 10, 20, 1)
+    if err != nil {
+        t.Fatalf("CreateMentionNotifications() error = %v", err)
+    }
+    if len(notifs.recipients) != 1 || notifs.recipients[0] != 4 {
+        t.Errorf("mention recipients = %v, want only [4]", notifs.recipients)
+    }
+}
+
+func TestBlockServiceCanDeliver(t *testing.T) {
+    blocks := &fakeUserBlockRepository{blocks: map[uint]map[uint]bool{
+        2: {1: true},
+        3: {1: false},
+    }}
+    svc := NewBlockService(nil, nil, blocks, nil)
+
+    // ws.Hub.SetDeliveryFilter에 넘기는 필터
+    var filter ws.DeliveryFilter = svc
+    tests := []struct {
+        name        string
+        recipientID uint
+        actorID     uint
+        want        bool
+    }{
+        {"차단한 사용자", 2, 1, false},
+        {"뮤트한 사용자", 3, 1, false},
+        {"나를 차단한 사용자에게", 1, 2, false},
+        {"관계 없음", 4, 1, true},
+        {"시스템 메시지", 2, 0, true},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := filter.CanDeliver(tt.recipientID, tt.actorID); got != tt.want {
+                t.Errorf("CanDeliver(%d, %d) = %v, want %v", tt.recipientID, tt.actorID, got, tt.want)
+            }
+        })
+    }
+
+    // 확인할 수 없으면 보내지 않는다
+    blocks.err = errors.New("db down")
+    if filter.CanDeliver(4, 1) {
+        t.Error("CanDeliver() on repository error = true, want false")
+    }
+}
@@ -1,34 +1,119 @@
 
+type CommentService interface {
+    Create(ctx context.Context, postID uint, req *dto.CreateCommentRequest) (*domain.Comment, error)
+    Update(ctx context.Context, id uint, req *dto.UpdateCommentRequest) (*domain.Comment, error)
+    Delete(ctx context.Context, id uint) error
+    // ListByPost 게시글 댓글 목록 (로그인한 경우 차단/뮤트한 사용자의 댓글 제외)
+    ListByPost(ctx context.Context, postID uint) ([]*domain.Comment, error)
+}
+
+type commentService struct {
+    commentRepo     repository.CommentRepository
+    postRepo        repository.PostRepository
+    userRepo        repository.UserRepository
+    notificationSvc *NotificationService
+    blockSvc        BlockService
+    spamGate        *SpamGate
+    wordFilter      *WordFilterService
+    sanctions       *SanctionStore
+}
+
+func NewCommentService(
+    commentRepo repository.CommentRepository,
+    postRepo repository.PostRepository,
+    userRepo repository.UserRepository,
+    notificationSvc *NotificationService,
+    blockSvc BlockService,
+    spamGate *SpamGate,
+    wordFilter *WordFilterService,
+    sanctions *SanctionStore,
+) CommentService {
+    return &commentService{
+        commentRepo:     commentRepo,
+        postRepo:        postRepo,
+        userRepo:        userRepo,
+        notificationSvc: notificationSvc,
+        blockSvc:        blockSvc,
+        spamGate:        spamGate,
+        wordFilter:      wordFilter,
+        sanctions:       sanctions,
+    }
+}
+
 func (s *commentService) Create(ctx context.Context, postID uint, req *dto.CreateCommentRequest) (*domain.Comment, error) {
     claims, ok := middleware.GetUserFromContext(ctx)
     if !ok {
         return nil, ErrUnauthorized
     }
 
+    if err := s.sanctions.CheckWrite(ctx, claims.UserID); err != nil {
+        return nil, err
+    }
+    if err := requireVerifiedEmail(ctx, s.userRepo, claims.UserID); err != nil {
+        return nil, err
+    }
+
     post, err := s.postRepo.FindByID(ctx, postID)
     if err != nil {
         return nil, err
     }
 
+    // 차단 관계면 상대 글에 댓글을 달 수 없다
+    if err := s.blockSvc.CheckInteraction(ctx, claims.UserID, post.AuthorID); err != nil {
+        return nil, err
+    }
+
     var parentComment *domain.Comment
     if req.ParentID != nil {
         parentComment, err = s.commentRepo.FindByID(ctx, *req.ParentID)
         if err != nil {
             return nil, err
         }
+
+        // 차단 관계면 상대 댓글에 답글을 달 수 없다
+        if err := s.blockSvc.CheckInteraction(ctx, claims.UserID, parentComment.AuthorID); err != nil {
+            return nil, err
+        }
+    }
+
+    // 금칙어: reject면 거부, mask는 가린 채 저장, flag는 저장 후 검토 큐에 올린다
+    filtered, err := s.wordFilter.Screen(ctx, FilterInput{Field: FilterFieldContent, Text: req.Content})
+    if err != nil {
+        return nil, err
     }
 
     comment := &domain.Comment{
         PostID:   postID,
         ParentID: req.ParentID,
         AuthorID: claims.UserID,
-        Content:  req.Content,
+        Content:  filtered.Texts[0],
+    }
+
+    // 스팸 의심이면 숨긴 채 저장하고 보류 큐로 보낸다 (승인 전까지 알림 없음)
+    hold := s.spamGate.Screen(ctx, &SpamCandidate{
+        AuthorID:   claims.UserID,
+        TargetType: domain.ReportTargetComment,
+        Content:    comment.Content,
+    })
+    if hold != nil {
+        now := time.Now()
+        comment.HiddenAt = &now
     }
 
     if err := s.commentRepo.Create(ctx, comment); err != nil {
         return nil, err
     }
 
+    if hold != nil {
+        if err := s.spamGate.Hold(ctx, domain.ReportTargetComment, comment.ID, claims.UserID, post.BoardID, hold); err != nil {
+            log.Printf("댓글 보류 실패 (공개 처리): comment=%d - %v", comment.ID, err)
+        } else {
+            return s.commentRepo.FindByID(ctx, comment.ID)
+        }
+    }
+
+    s.wordFilter.Flag(ctx, domain.ReportTargetComment, comment.ID, claims.UserID, post.BoardID, filtered.Flagged)
+
     // 댓글/대댓글 알림
     if req.ParentID != nil {
         _ = s.notificationSvc.CreateReplyNotification(ctx, parentComment, comment, claims.UserID)
@@ -36,8 +121,81 @@ func (s *commentService) Create(ctx context.Context, postID uint, req *dto.Creat
         _ = s.notificationSvc.CreateCommentNotification(ctx, post, comment, claims.UserID)
     }
 
-    // 멘션 알림
-    _ = s.notificationSvc.CreateMentionNotifications(ctx, req.Content, postID, comment.ID, claims.UserID)
+    // 멘션 알림 (차단 관계인 사용자는 CreateMentionNotifications에서 제외)
+    _ = s.notificationSvc.CreateMentionNotifications(ctx, comment.Content, postID, comment.ID, claims.UserID)
 
     return s.commentRepo.FindByID(ctx, comment.ID)
 }
+
+// loadForPolicy 정책 확인용으로 댓글과 게시글을 함께 불러온다 (게시판 모더레이터 범위가 게시글의 게시판이므로)
+func (s *commentService) loadForPolicy(ctx context.Context, id uint) (*domain.Comment, error) {
+    comment, err := s.commentRepo.FindByID(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+
+    post, err := s.postRepo.FindByID(ctx, comment.PostID)
+    if err != nil {
+        return nil, err
+    }
+    comment.Post = *post
+    return comment, nil
+}
+
+// 수정/삭제 가능 여부는 config/policies/content.yaml의 comment:update, comment:delete 정책으로 판단한다
+func (s *commentService) Update(ctx context.Context, id uint, req *dto.UpdateCommentRequest) (*domain.Comment, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    if err := s.sanctions.CheckWrite(ctx, claims.UserID); err != nil {
+        return nil, err
+    }
+
+    comment, err := s.loadForPolicy(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+    if err := auth.RequireCan(ctx, "comment:update", comment); err != nil {
+        return nil, err
+    }
+
+    filtered, err := s.wordFilter.Screen(ctx, FilterInput{Field: FilterFieldContent, Text: req.Content})
+    if err != nil {
+        return nil, err
+    }
+
+    comment.Content = filtered.Texts[0]
+    if err := s.commentRepo.Update(ctx, comment); err != nil {
+        return nil, err
+    }
+
+    s.wordFilter.Flag(ctx, domain.ReportTargetComment, comment.ID, comment.AuthorID, comment.Post.BoardID, filtered.Flagged)
+    return s.commentRepo.FindByID(ctx, comment.ID)
+}
+
+func (s *commentService) Delete(ctx context.Context, id uint) error {
+    if _, ok := middleware.GetUserFromContext(ctx); !ok {
+        return ErrUnauthorized
+    }
+
+    comment, err := s.loadForPolicy(ctx, id)
+    if err != nil {
+        return err
+    }
+    if err := auth.RequireCan(ctx, "comment:delete", comment); err != nil {
+        return err
+    }
+
+    return s.commentRepo.Delete(ctx, comment.ID)
+}
+
+func (s *commentService) ListByPost(ctx context.Context, postID uint) ([]*domain.Comment, error) {
+    var viewerID uint
+    if claims, ok := middleware.GetUserFromContext(ctx); ok {
+        viewerID = claims.UserID
+    }
+
+    return s.commentRepo.FindByPostID(ctx, postID, viewerID)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+)
+
+type fakeCommentsByID struct {
+    repository.CommentRepository
+    comments map[uint]*domain.Comment
+    deleted  []uint
+}
+
+func (r *fakeCommentsByID) FindByID(ctx context.Context, id uint) (*domain.Comment, error) {
+    comment, ok := r.comments[id]
+    if !ok {
+        return nil, errors.New("comment not found")
+    }
+    copied := *comment
+    return &copied, nil
+}
+
+func (r *fakeCommentsByID) Update(ctx context.Context, comment *domain.Comment) error {
+    r.comments[comment.ID] = comment
+    return nil
+}
+
+func (r *fakeCommentsByID) Delete(ctx context.Context, id uint) error {
+    r.deleted = append(r.deleted, id)
+    return nil
+}
+
+func TestCommentUpdateDeletePolicy(t *testing.T) {
+    useShippedPolicies(t)
+
+    // 7은 게시판 3의 댓글 모더레이터
+    const moderator domain.Role = "moderator"
+    roles := &fakeRoleRepository{
+        grants:      []*domain.RolePermission{{Role: moderator, Permission: domain.PermissionCommentManage}},
+        assignments: []*domain.RoleAssignment{{UserID: 7, Role: moderator, ScopeType: domain.ScopeBoard, ScopeID: 3}},
+    }
+    domain.SetPermissionSource(NewPermissionStore(roles, &fakeTemporaryGrantRepository{}, cache.NewLocalInvalidator(), time.Hour))
+    t.Cleanup(func() { domain.SetPermissionSource(nil) })
+
+    boardID, otherBoardID := uint(3), uint(4)
+    posts := &fakePostsByID{posts: map[uint]*domain.Post{
+        10: {ID: 10, AuthorID: 1, BoardID: &boardID},
+        11: {ID: 11, AuthorID: 1, BoardID: &otherBoardID},
+    }}
+    comments := &fakeCommentsByID{comments: map[uint]*domain.Comment{
+        20: {ID: 20, PostID: 10, AuthorID: 2, Content: "댓글"},
+        21: {ID: 21, PostID: 11, AuthorID: 2, Content: "댓글"},
+    }}
+    svc := NewCommentService(comments, posts, nil, nil, nil, nil, nil, nil)
+    as := func(userID uint) context.Context {
+        return middleware.WithUser(context.Background(), &middleware.Claims{UserID: userID, Role: string(domain.RoleUser)})
+    }
+
+    tests := []struct {
+        name      string
+        ctx       context.Context
+        commentID uint
+        want      error
+    }{
+        {"작성자", as(2), 20, nil},
+        {"게시글 작성자", as(1), 20, auth.ErrNoPermission},
+        {"게시판 모더레이터", as(7), 20, nil},
+        {"다른 게시판의 모더레이터", as(7), 21, auth.ErrNoPermission},
+        {"로그인 안 함", context.Background(), 20, ErrUnauthorized},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            comment, err := svc.Update(tt.ctx, tt.commentID, &dto.UpdateCommentRequest{Content: "수정"})
+            if !errors.Is(err, tt.want) {
+                t.Fatalf("Update() error = %v, want %v", err, tt.want)
+            }
+            if err == nil && comment.Content != "수정" {
+                t.Errorf("Update() content = %q, want 수정", comment.Content)
+            }
+
+            comments.deleted = nil
+            if err := svc.Delete(tt.ctx, tt.commentID); !errors.Is(err, tt.want) {
+                t.Errorf("Delete() error = %v, want %v", err, tt.want)
+            }
+            if (tt.want == nil) != (len(comments.deleted) == 1) {
+                t.Errorf("deleted = %v", comments.deleted)
+            }
+        })
+    }
+}
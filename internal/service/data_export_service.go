@@ -0,0 +1,335 @@
+package service
+
+import (
+    "context"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "os"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/export"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/storage"
+    "goboardapi/internal/worker"
+    "goboardapi/internal/worker/handlers"
+
+    "github.com/google/uuid"
+)
+
+// DataExportConfig 개인 데이터 내보내기 정책
+type DataExportConfig struct {
+    DownloadURL  string        // 다운로드 링크 기본 주소 (예: https://board.example.com/api/v1/exports/download)
+    LinkTTL      time.Duration // 다운로드 링크 유효 기간 (지나면 파일 삭제)
+    BatchSize    int           // 한 번에 읽어 ZIP에 쓰는 행 수
+    StaleAfter   time.Duration // 이 시간이 지나도 끝나지 않은 요청은 실패 처리 (새로 요청할 수 있도록)
+    CleanupBatch int
+}
+
+// DefaultDataExportConfig 기본 정책
+func DefaultDataExportConfig(downloadURL string) DataExportConfig {
+    return DataExportConfig{
+        DownloadURL:  downloadURL,
+        LinkTTL:      7 * 24 * time.Hour,
+        BatchSize:    500,
+        StaleAfter:   time.Hour,
+        CleanupBatch: 100,
+    }
+}
+
+type DataExportService interface {
+    // Request 내보내기 요청 (진행 중인 요청이 있으면 repository.ErrDataExportInProgress)
+    Request(ctx context.Context) (*dto.DataExportResponse, error)
+    // Latest 가장 최근 요청 상태
+    Latest(ctx context.Context) (*dto.DataExportResponse, error)
+    // Build ZIP 생성 후 다운로드 링크를 메일로 발송 (워커에서 호출)
+    Build(ctx context.Context, exportID uint) error
+    // Open 메일로 받은 토큰으로 ZIP 열기
+    Open(ctx context.Context, rawToken string) (io.ReadCloser, *domain.DataExport, error)
+    // Cleanup 만료된 파일 삭제와 멈춘 요청 정리 (스케줄러에서 호출)
+    Cleanup(ctx context.Context) (int64, error)
+}
+
+type dataExportService struct {
+    exportRepo   repository.DataExportRepository
+    userRepo     repository.UserRepository
+    uploads      storage.Storage // 아바타 등 첨부 파일
+    files        storage.Storage // 내보내기 ZIP (공개 경로로 서빙하지 않는 저장소)
+    queue        worker.Queue
+    emailService *EmailService
+    config       DataExportConfig
+    now          func() time.Time
+}
+
+func NewDataExportService(
+    exportRepo repository.DataExportRepository,
+    userRepo repository.UserRepository,
+    uploads storage.Storage,
+    files storage.Storage,
+    queue worker.Queue,
+    emailService *EmailService,
+    config DataExportConfig,
+) DataExportService {
+    return &dataExportService{
+        exportRepo:   exportRepo,
+        userRepo:     userRepo,
+        uploads:      uploads,
+        files:        files,
+        queue:        queue,
+        emailService: emailService,
+        config:       config,
+        now:          time.Now,
+    }
+}
+
+func (s *dataExportService) Request(ctx context.Context) (*dto.DataExportResponse, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    dataExport := &domain.DataExport{UserID: claims.UserID, CreatedAt: s.now()}
+    if err := s.exportRepo.Create(ctx, dataExport); err != nil {
+        return nil, err
+    }
+
+    payload, _ := json.Marshal(handlers.DataExportPayload{ExportID: dataExport.ID})
+    err = s.queue.Enqueue(ctx, worker.Task{
+        ID:        uuid.New().String(),
+        Type:      worker.TaskExportData,
+        Payload:   payload,
+        CreatedAt: s.now(),
+    })
+    if err != nil {
+        // 큐에 넣지 못한 요청이 남아 있으면 새로 요청할 수 없으므로 바로 실패 처리
+        if markErr := s.exportRepo.MarkFailed(ctx, dataExport.ID, "enqueue failed", s.now()); markErr != nil {
+            middleware.LoggerFromRequestContext(ctx).Warn("내보내기 실패 처리 오류", "export_id", dataExport.ID, "error", markErr)
+        }
+        return nil, err
+    }
+
+    return dto.ToDataExportResponse(dataExport), nil
+}
+
+func (s *dataExportService) Latest(ctx context.Context) (*dto.DataExportResponse, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    dataExport, err := s.exportRepo.FindLatestByUserID(ctx, claims.UserID)
+    if err != nil {
+        return nil, err
+    }
+    return dto.ToDataExportResponse(dataExport), nil
+}
+
+func (s *dataExportService) Build(ctx context.Context, exportID uint) error {
+    if err := s.exportRepo.MarkProcessing(ctx, exportID); err != nil {
+        if errors.Is(err, repository.ErrDataExportNotFound) {
+            // 이미 끝났거나 실패 처리된 요청 (재시도로 중복 실행된 경우)
+            return nil
+        }
+        return err
+    }
+
+    dataExport, err := s.exportRepo.FindByID(ctx, exportID)
+    if err != nil {
+        return err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, dataExport.UserID)
+    if err != nil {
+        if errors.Is(err, repository.ErrUserNotFound) {
+            return s.exportRepo.MarkFailed(ctx, exportID, "user not found", s.now())
+        }
+        return err
+    }
+
+    // 재시도해도 같은 키에 덮어쓴다
+    key := fmt.Sprintf("%d/%d.zip", user.ID, dataExport.ID)
+    size, err := s.writeArchive(ctx, user, key)
+    if err != nil {
+        return err
+    }
+
+    raw, hash, err := auth.GenerateToken()
+    if err != nil {
+        return err
+    }
+
+    now := s.now()
+    expiresAt := now.Add(s.config.LinkTTL)
+    if err := s.exportRepo.MarkReady(ctx, exportID, key, size, hash, expiresAt, now); err != nil {
+        return err
+    }
+
+    link := s.config.DownloadURL + "?token=" + raw
+    if err := s.emailService.SendDataExportReady(ctx, user.Email, user.Username, link, expiresAt); err != nil {
+        // 링크는 메일로만 전달되므로 사용자는 다시 요청해야 한다 (진행 중 표시는 이미 해제됨)
+        middleware.LoggerFromRequestContext(ctx).Warn("내보내기 완료 메일 발송 실패", "export_id", exportID, "error", err)
+    }
+    return nil
+}
+
+// writeArchive 임시 파일에 ZIP을 만든 뒤 저장소에 올리고 크기를 반환
+func (s *dataExportService) writeArchive(ctx context.Context, user *domain.User, key string) (int64, error) {
+    tmp, err := os.CreateTemp("", "data-export-*.zip")
+    if err != nil {
+        return 0, err
+    }
+    defer os.Remove(tmp.Name())
+    defer tmp.Close()
+
+    archive := export.NewArchive(tmp, s.now())
+    if err := s.writeEntries(ctx, archive, user); err != nil {
+        return 0, err
+    }
+    if err := archive.Close(); err != nil {
+        return 0, err
+    }
+
+    size, err := tmp.Seek(0, io.SeekCurrent)
+    if err != nil {
+        return 0, err
+    }
+    if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+        return 0, err
+    }
+
+    if _, err := s.files.Put(ctx, key, tmp, "application/zip"); err != nil {
+        return 0, err
+    }
+    return size, nil
+}
+
+func (s *dataExportService) writeEntries(ctx context.Context, archive *export.Archive, user *domain.User) error {
+    if err := archive.WriteJSON("profile.json", user); err != nil {
+        return err
+    }
+
+    limit := s.config.BatchSize
+    if err := export.WriteJSONList(archive, "posts.json", batches(func(afterID uint) ([]*domain.Post, error) {
+        return s.exportRepo.FindPosts(ctx, user.ID, afterID, limit)
+    }, func(p *domain.Post) uint { return p.ID })); err != nil {
+        return err
+    }
+    if err := export.WriteJSONList(archive, "comments.json", batches(func(afterID uint) ([]*domain.Comment, error) {
+        return s.exportRepo.FindComments(ctx, user.ID, afterID, limit)
+    }, func(c *domain.Comment) uint { return c.ID })); err != nil {
+        return err
+    }
+    if err := export.WriteJSONList(archive, "likes.json", batches(func(afterID uint) ([]*domain.Like, error) {
+        return s.exportRepo.FindLikes(ctx, user.ID, afterID, limit)
+    }, func(l *domain.Like) uint { return l.ID })); err != nil {
+        return err
+    }
+    if err := export.WriteJSONList(archive, "notifications.json", batches(func(afterID uint) ([]*domain.Notification, error) {
+        return s.exportRepo.FindNotifications(ctx, user.ID, afterID, limit)
+    }, func(n *domain.Notification) uint { return n.ID })); err != nil {
+        return err
+    }
+    if err := export.WriteJSONList(archive, "withdraw_logs.json", batches(func(afterID uint) ([]*domain.WithdrawLog, error) {
+        return s.exportRepo.FindWithdrawLogs(ctx, user.ID, afterID, limit)
+    }, func(l *domain.WithdrawLog) uint { return l.ID })); err != nil {
+        return err
+    }
+
+    if user.AvatarKey != "" {
+        r, err := s.uploads.Open(ctx, user.AvatarKey)
+        switch {
+        case errors.Is(err, storage.ErrNotFound):
+            // 파일이 이미 지워진 경우 첨부 없이 진행
+        case err != nil:
+            return err
+        default:
+            defer r.Close()
+            if err := archive.WriteFile("attachments/avatar.jpg", r); err != nil {
+                return err
+            }
+        }
+    }
+
+    return nil
+}
+
+// batches afterID 커서로 다음 배치를 읽는 함수를 export.WriteJSONList용으로 변환
+func batches[T any](find func(afterID uint) ([]T, error), id func(T) uint) func() ([]T, error) {
+    var afterID uint
+    return func() ([]T, error) {
+        items, err := find(afterID)
+        if err != nil || len(items) == 0 {
+            return items, err
+        }
+        afterID = id(items[len(items)-1])
+        return items, nil
+    }
+}
+
+func (s *dataExportService) Open(ctx context.Context, rawToken string) (io.ReadCloser, *domain.DataExport, error) {
+    dataExport, err := s.exportRepo.FindByTokenHash(ctx, auth.HashToken(rawToken))
+    if err != nil {
+        if errors.Is(err, repository.ErrDataExportNotFound) {
+            return nil, nil, ErrInvalidExportToken
+        }
+        return nil, nil, err
+    }
+    if !dataExport.IsDownloadable(s.now()) {
+        return nil, nil, ErrInvalidExportToken
+    }
+
+    r, err := s.files.Open(ctx, dataExport.FileKey)
+    if err != nil {
+        if errors.Is(err, storage.ErrNotFound) {
+            return nil, nil, ErrInvalidExportToken
+        }
+        return nil, nil, err
+    }
+    return r, dataExport, nil
+}
+
+func (s *dataExportService) Cleanup(ctx context.Context) (int64, error) {
+    log := middleware.LoggerFromRequestContext(ctx)
+    now := s.now()
+
+    var expired int64
+    for {
+        exports, err := s.exportRepo.FindExpired(ctx, now, s.config.CleanupBatch)
+        if err != nil {
+            return expired, err
+        }
+        if len(exports) == 0 {
+            break
+        }
+
+        for _, dataExport := range exports {
+            if err := s.files.Delete(ctx, dataExport.FileKey); err != nil {
+                // 파일을 지우지 못하면 다음 정리 때 다시 시도 (같은 배치가 반복되지 않도록 중단)
+                log.Warn("만료된 내보내기 파일 삭제 실패", "export_id", dataExport.ID, "error", err)
+                return expired, err
+            }
+            if err := s.exportRepo.MarkExpired(ctx, dataExport.ID); err != nil {
+                return expired, err
+            }
+            expired++
+        }
+    }
+
+    stale, err := s.exportRepo.FindStale(ctx, now.Add(-s.config.StaleAfter), s.config.CleanupBatch)
+    if err != nil {
+        return expired, err
+    }
+    for _, dataExport := range stale {
+        if err := s.exportRepo.MarkFailed(ctx, dataExport.ID, "timed out", now); err != nil {
+            return expired, err
+        }
+        log.Warn("완료되지 않은 내보내기 실패 처리", "export_id", dataExport.ID, "user_id", dataExport.UserID)
+    }
+
+    return expired, nil
+}
@@ -0,0 +1,41 @@
+package service
+
+import "testing"
+
+func TestBatchesAdvancesCursor(t *testing.T) {
+    rows := []uint{1, 2, 3, 4, 5}
+    var cursors []uint
+
+    next := batches(func(afterID uint) ([]uint, error) {
+        cursors = append(cursors, afterID)
+        var out []uint
+        for _, id := range rows {
+            if id > afterID && len(out) < 2 {
+                out = append(out, id)
+            }
+        }
+        return out, nil
+    }, func(id uint) uint { return id })
+
+    var got []uint
+    for {
+        items, err := next()
+        if err != nil {
+            t.Fatalf("next: %v", err)
+        }
+        if len(items) == 0 {
+            break
+        }
+        got = append(got, items...)
+    }
+
+    if len(got) != len(rows) {
+        t.Fatalf("got %v, want %v", got, rows)
+    }
+    want := []uint{0, 2, 4, 5}
+    for i := range want {
+        if cursors[i] != want[i] {
+            t.Fatalf("cursors = %v, want %v", cursors, want)
+        }
+    }
+}
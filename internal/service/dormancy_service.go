@@ -0,0 +1,230 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+
+    "gorm.io/gorm"
+)
+
+// DormancyConfig 휴면 계정 정책
+//
+// 마지막 활동(로그인, 없으면 가입) 후 DormantAfter가 지나면 휴면으로 전환한다. WarnBefore 전에 안내 메일을
+// 보내고, 안내 후 WarnBefore가 지나기 전에는 전환하지 않는다 (배치가 늦게 돌아도 안내 기간은 보장).
+type DormancyConfig struct {
+    DormantAfter    time.Duration
+    WarnBefore      time.Duration
+    ReactivationURL string        // 재활성화 링크 기본 주소 (예: https://board.example.com/reactivate)
+    TokenTTL        time.Duration // 재활성화 링크 유효 기간
+    ResendCooldown  time.Duration // 재활성화 메일 재발송 간격
+}
+
+// DefaultDormancyConfig 기본 정책
+func DefaultDormancyConfig(reactivationURL string) DormancyConfig {
+    return DormancyConfig{
+        DormantAfter:    365 * 24 * time.Hour,
+        WarnBefore:      30 * 24 * time.Hour,
+        ReactivationURL: reactivationURL,
+        TokenTTL:        24 * time.Hour,
+        ResendCooldown:  time.Minute,
+    }
+}
+
+// InactiveBefore 이 시각 이전부터 활동이 없는 사용자가 안내 또는 휴면 전환 대상
+func (c DormancyConfig) InactiveBefore(now time.Time) time.Time {
+    return now.Add(-(c.DormantAfter - c.WarnBefore))
+}
+
+type DormancyService interface {
+    // Process 사용자 한 명에게 안내 또는 휴면 전환 적용 (다시 실행해도 같은 결과)
+    Process(ctx context.Context, user *domain.User, now time.Time) error
+    // RequestReactivation 휴면 계정이면 재활성화 링크 발송 (휴면 여부와 관계없이 nil)
+    RequestReactivation(ctx context.Context, email string) error
+    // Reactivate 메일로 받은 토큰으로 휴면 해제
+    Reactivate(ctx context.Context, rawToken string) error
+}
+
+type dormancyService struct {
+    db           *gorm.DB
+    userRepo     repository.UserRepository
+    dormantRepo  repository.DormantAccountRepository
+    emailService *EmailService
+    terminator   SessionTerminator
+    config       DormancyConfig
+    now          func() time.Time
+}
+
+func NewDormancyService(
+    db *gorm.DB,
+    userRepo repository.UserRepository,
+    dormantRepo repository.DormantAccountRepository,
+    emailService *EmailService,
+    terminator SessionTerminator,
+    config DormancyConfig,
+) DormancyService {
+    return &dormancyService{
+        db:           db,
+        userRepo:     userRepo,
+        dormantRepo:  dormantRepo,
+        emailService: emailService,
+        terminator:   terminator,
+        config:       config,
+        now:          time.Now,
+    }
+}
+
+// dormancyAction 배치가 사용자에게 할 일
+type dormancyAction int
+
+const (
+    dormancyNone dormancyAction = iota
+    dormancyWarn
+    dormancyArchive
+)
+
+// nextDormancyAction 마지막 활동과 안내 시각으로 다음 단계 결정
+func nextDormancyAction(user *domain.User, now time.Time, config DormancyConfig) dormancyAction {
+    if user.IsDormant() {
+        return dormancyNone
+    }
+
+    lastActive := user.LastActiveAt()
+    if now.Before(lastActive.Add(config.DormantAfter - config.WarnBefore)) {
+        return dormancyNone
+    }
+
+    // 마지막 활동 이전의 안내는 무효 (로그인하면 다시 처음부터)
+    if user.DormancyWarnedAt == nil || user.DormancyWarnedAt.Before(lastActive) {
+        return dormancyWarn
+    }
+
+    if now.Before(lastActive.Add(config.DormantAfter)) || now.Before(user.DormancyWarnedAt.Add(config.WarnBefore)) {
+        return dormancyNone
+    }
+    return dormancyArchive
+}
+
+func (s *dormancyService) Process(ctx context.Context, user *domain.User, now time.Time) error {
+    switch nextDormancyAction(user, now, s.config) {
+    case dormancyWarn:
+        return s.warn(ctx, user, now)
+    case dormancyArchive:
+        return s.archive(ctx, user, now)
+    default:
+        return nil
+    }
+}
+
+// warn 발송 표시를 먼저 남겨 중복 발송을 막고, 메일 큐에 넣지 못하면 표시를 되돌린다
+func (s *dormancyService) warn(ctx context.Context, user *domain.User, now time.Time) error {
+    if err := s.dormantRepo.MarkWarned(ctx, user.ID, now); err != nil {
+        if errors.Is(err, repository.ErrNotDormancyCandidate) {
+            return nil
+        }
+        return err
+    }
+
+    dormantAt := user.LastActiveAt().Add(s.config.DormantAfter)
+    if earliest := now.Add(s.config.WarnBefore); dormantAt.Before(earliest) {
+        dormantAt = earliest
+    }
+
+    if err := s.emailService.SendDormancyWarning(ctx, user.Email, user.Username, dormantAt); err != nil {
+        if clearErr := s.dormantRepo.ClearWarned(ctx, user.ID, now); clearErr != nil {
+            middleware.LoggerFromRequestContext(ctx).Warn("휴면 안내 표시 복구 실패", "user_id", user.ID, "error", clearErr)
+        }
+        return err
+    }
+    return nil
+}
+
+func (s *dormancyService) archive(ctx context.Context, user *domain.User, now time.Time) error {
+    var sessionIDs []uint
+    err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        inactiveBefore := now.Add(-s.config.DormantAfter)
+        if err := repository.NewDormantAccountRepository(tx).Archive(ctx, user.ID, inactiveBefore, now); err != nil {
+            return err
+        }
+
+        var err error
+        sessionIDs, err = revokeUserSessions(ctx, tx, user.ID, 0, now)
+        return err
+    })
+    if errors.Is(err, repository.ErrNotDormancyCandidate) {
+        // 그 사이 로그인했거나 이미 전환됨
+        return nil
+    }
+    if err != nil {
+        return err
+    }
+
+    s.terminator.CloseSessions(sessionIDs...)
+    return nil
+}
+
+func (s *dormancyService) RequestReactivation(ctx context.Context, email string) error {
+    account, err := s.dormantRepo.FindByEmail(ctx, email)
+    if err != nil {
+        if errors.Is(err, repository.ErrDormantAccountNotFound) {
+            return nil
+        }
+        return err
+    }
+
+    now := s.now()
+    if account.TokenSentAt != nil && now.Before(account.TokenSentAt.Add(s.config.ResendCooldown)) {
+        return nil
+    }
+
+    user, err := s.userRepo.FindByID(ctx, account.UserID)
+    if err != nil {
+        return err
+    }
+
+    raw, hash, err := auth.GenerateToken()
+    if err != nil {
+        return err
+    }
+
+    expiresAt := now.Add(s.config.TokenTTL)
+    if err := s.dormantRepo.SetToken(ctx, account.UserID, hash, expiresAt, now); err != nil {
+        return err
+    }
+
+    link := s.config.ReactivationURL + "?token=" + raw
+    if err := s.emailService.SendDormantReactivation(ctx, account.Email, user.Username, link, expiresAt); err != nil {
+        // 발송 실패 여부로 휴면 계정 존재가 드러나지 않게 한다
+        middleware.LoggerFromRequestContext(ctx).Warn("휴면 해제 메일 발송 실패", "user_id", user.ID, "error", err)
+    }
+    return nil
+}
+
+func (s *dormancyService) Reactivate(ctx context.Context, rawToken string) error {
+    account, err := s.dormantRepo.FindByTokenHash(ctx, auth.HashToken(rawToken))
+    if err != nil {
+        if errors.Is(err, repository.ErrDormantAccountNotFound) {
+            return ErrInvalidReactivationToken
+        }
+        return err
+    }
+
+    now := s.now()
+    if account.TokenExpiresAt == nil || !now.Before(*account.TokenExpiresAt) {
+        return ErrInvalidReactivationToken
+    }
+
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        return repository.NewDormantAccountRepository(tx).Restore(ctx, account, now)
+    })
+    if errors.Is(err, repository.ErrDormantAccountNotFound) {
+        // 같은 링크로 동시에 요청한 경우
+        return ErrInvalidReactivationToken
+    }
+    return err
+}
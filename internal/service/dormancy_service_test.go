@@ -0,0 +1,56 @@
+package service
+
+import (
+    "testing"
+    "time"
+
+    "goboardapi/internal/domain"
+)
+
+func TestNextDormancyAction(t *testing.T) {
+    day := 24 * time.Hour
+    config := DormancyConfig{DormantAfter: 365 * day, WarnBefore: 30 * day}
+    lastLogin := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+    at := func(d int) *time.Time {
+        v := lastLogin.Add(time.Duration(d) * day)
+        return &v
+    }
+
+    tests := []struct {
+        name   string
+        warned *time.Time
+        now    time.Time
+        want   dormancyAction
+    }{
+        {"active", nil, *at(300), dormancyNone},
+        {"warn window", nil, *at(340), dormancyWarn},
+        {"already warned", at(340), *at(350), dormancyNone},
+        {"due", at(335), *at(365), dormancyArchive},
+        // 배치가 늦게 돌아 기한을 넘겼어도 안내 후 WarnBefore는 기다린다
+        {"late warning", at(400), *at(410), dormancyNone},
+        {"late warning elapsed", at(400), *at(430), dormancyArchive},
+        {"overdue without warning", nil, *at(500), dormancyWarn},
+        // 마지막 로그인 이전의 안내는 무효
+        {"stale warning", at(-10), *at(340), dormancyWarn},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            user := &domain.User{LastLoginAt: &lastLogin, DormancyWarnedAt: tt.warned}
+            if got := nextDormancyAction(user, tt.now, config); got != tt.want {
+                t.Fatalf("got %v, want %v", got, tt.want)
+            }
+        })
+    }
+}
+
+func TestNextDormancyActionSkipsDormant(t *testing.T) {
+    config := DefaultDormancyConfig("")
+    created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+    dormantAt := created.Add(config.DormantAfter)
+    user := &domain.User{CreatedAt: created, DormantAt: &dormantAt}
+
+    if got := nextDormancyAction(user, dormantAt.Add(config.DormantAfter), config); got != dormancyNone {
+        t.Fatalf("got %v, want none", got)
+    }
+}
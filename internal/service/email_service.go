@@ -3,6 +3,7 @@ package service
 import (
     "context"
     "encoding/json"
+    "time"
 
     "yourproject/internal/email"
     "yourproject/internal/worker"
@@ -21,40 +22,94 @@ func NewEmailService(queue worker.Queue, appName string) *EmailService {
 }
 
 func (s *EmailService) SendWelcome(ctx context.Context, to, username string) error {
-    html, err := email.RenderTemplate("welcome", email.TemplateData{
+    return s.send(ctx, to, s.appName+"에 오신 것을 환영합니다!", "welcome", email.TemplateData{
         Username: username,
-        AppName:  s.appName,
     })
-    if err != nil {
-        return err
-    }
+}
 
-    payload, _ := json.Marshal(handlers.EmailPayload{
-        To:       []string{to},
-        Subject:  s.appName + "에 오신 것을 환영합니다!",
-        HTMLBody: html,
+func (s *EmailService) SendPasswordReset(ctx context.Context, to, username, resetLink string) error {
+    return s.send(ctx, to, "["+s.appName+"] 비밀번호 재설정", "reset_password", email.TemplateData{
+        Username: username,
+        Link:     resetLink,
     })
+}
 
-    return s.queue.Enqueue(ctx, worker.Task{
-        ID:      uuid.New().String(),
-        Type:    worker.TaskSendEmail,
-        Payload: payload,
+func (s *EmailService) SendEmailVerification(ctx context.Context, to, username, verifyLink string) error {
+    return s.send(ctx, to, "["+s.appName+"] 이메일 인증", "verify_email", email.TemplateData{
+        Username: username,
+        Link:     verifyLink,
     })
 }
 
-func (s *EmailService) SendPasswordReset(ctx context.Context, to, username, resetLink string) error {
-    html, err := email.RenderTemplate("reset_password", email.TemplateData{
+// SendAccountLocked 로그인 실패 누적으로 계정이 잠겼음을 알림
+func (s *EmailService) SendAccountLocked(ctx context.Context, to, username, ip string, until time.Time) error {
+    return s.send(ctx, to, "["+s.appName+"] 계정 잠금 안내", "account_locked", email.TemplateData{
         Username: username,
-        Link:     resetLink,
-        AppName:  s.appName,
+        IP:       ip,
+        Until:    until.Format("2006-01-02 15:04"),
+    })
+}
+
+// SendDataExportReady 개인 데이터 내보내기 파일 다운로드 링크 안내
+func (s *EmailService) SendDataExportReady(ctx context.Context, to, username, downloadLink string, expiresAt time.Time) error {
+    return s.send(ctx, to, "["+s.appName+"] 개인 데이터 내보내기 완료", "data_export_ready", email.TemplateData{
+        Username: username,
+        Link:     downloadLink,
+        Until:    expiresAt.Format("2006-01-02 15:04"),
+    })
+}
+
+// SendDormancyWarning 휴면 전환 예정 안내
+func (s *EmailService) SendDormancyWarning(ctx context.Context, to, username string, dormantAt time.Time) error {
+    return s.send(ctx, to, "["+s.appName+"] 휴면 계정 전환 예정 안내", "dormancy_warning", email.TemplateData{
+        Username: username,
+        Until:    dormantAt.Format("2006-01-02"),
     })
+}
+
+// SendDormantReactivation 휴면 계정 재활성화 링크 발송
+func (s *EmailService) SendDormantReactivation(ctx context.Context, to, username, link string, expiresAt time.Time) error {
+    return s.send(ctx, to, "["+s.appName+"] 휴면 계정 재활성화", "dormant_reactivate", email.TemplateData{
+        Username: username,
+        Link:     link,
+        Until:    expiresAt.Format("2006-01-02 15:04"),
+    })
+}
+
+// SendSanctionNotice 이용 제재 안내 (endsAt이 nil이면 해제 시까지)
+func (s *EmailService) SendSanctionNotice(ctx context.Context, to, username, kind, reason string, startsAt time.Time, endsAt *time.Time) error {
+    data := email.TemplateData{
+        Username: username,
+        Kind:     kind,
+        Reason:   reason,
+        Since:    startsAt.Format("2006-01-02 15:04"),
+    }
+    if endsAt != nil {
+        data.Until = endsAt.Format("2006-01-02 15:04")
+    }
+    return s.send(ctx, to, "["+s.appName+"] 계정 "+kind+" 안내", "sanction_notice", data)
+}
+
+// SendSanctionRevoked 이용 제재 해제 안내
+func (s *EmailService) SendSanctionRevoked(ctx context.Context, to, username, kind string) error {
+    return s.send(ctx, to, "["+s.appName+"] 계정 "+kind+" 해제 안내", "sanction_revoked", email.TemplateData{
+        Username: username,
+        Kind:     kind,
+    })
+}
+
+// send 템플릿을 렌더링해 이메일 발송 태스크를 큐에 추가
+func (s *EmailService) send(ctx context.Context, to, subject, templateName string, data email.TemplateData) error {
+    data.AppName = s.appName
+
+    html, err := email.RenderTemplate(templateName, data)
     if err != nil {
         return err
     }
 
     payload, _ := json.Marshal(handlers.EmailPayload{
         To:       []string{to},
-        Subject:  "[" + s.appName + "] 비밀번호 재설정",
+        Subject:  subject,
         HTMLBody: html,
     })
 
@@ -0,0 +1,200 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+)
+
+// EmailVerificationConfig 이메일 인증 정책
+type EmailVerificationConfig struct {
+    VerifyURL      string        // 인증 링크 기본 주소 (예: https://board.example.com/verify-email)
+    TokenTTL       time.Duration // 토큰 유효 기간
+    ResendCooldown time.Duration // 재발송 최소 간격
+    MaxPerDay      int           // 24시간 내 최대 발송 횟수
+}
+
+// DefaultEmailVerificationConfig 기본 정책
+func DefaultEmailVerificationConfig(verifyURL string) EmailVerificationConfig {
+    return EmailVerificationConfig{
+        VerifyURL:      verifyURL,
+        TokenTTL:       24 * time.Hour,
+        ResendCooldown: time.Minute,
+        MaxPerDay:      5,
+    }
+}
+
+type EmailVerificationService interface {
+    // Issue 새 인증 토큰을 발급하고 메일을 보낸다 (기존 미사용 토큰은 폐기)
+    Issue(ctx context.Context, user *domain.User) error
+    // Verify 토큰을 확인하고 사용자를 인증 상태로 변경한다
+    Verify(ctx context.Context, rawToken string) error
+    // Resend 로그인한 사용자에게 인증 메일을 다시 보낸다
+    Resend(ctx context.Context) error
+    // MarkVerified 관리자가 사용자를 인증 상태로 변경한다
+    MarkVerified(ctx context.Context, userID uint) error
+}
+
+type emailVerificationService struct {
+    verificationRepo repository.EmailVerificationRepository
+    userRepo         repository.UserRepository
+    emailService     *EmailService
+    config           EmailVerificationConfig
+    now              func() time.Time
+}
+
+func NewEmailVerificationService(
+    verificationRepo repository.EmailVerificationRepository,
+    userRepo repository.UserRepository,
+    emailService *EmailService,
+    config EmailVerificationConfig,
+) EmailVerificationService {
+    return &emailVerificationService{
+        verificationRepo: verificationRepo,
+        userRepo:         userRepo,
+        emailService:     emailService,
+        config:           config,
+        now:              time.Now,
+    }
+}
+
+func (s *emailVerificationService) Issue(ctx context.Context, user *domain.User) error {
+    if user.IsEmailVerified() {
+        return ErrEmailAlreadyVerified
+    }
+
+    now := s.now()
+
+    // 이전 링크는 더 이상 쓸 수 없도록 폐기
+    if err := s.verificationRepo.InvalidateByUserID(ctx, user.ID, now); err != nil {
+        return err
+    }
+
+    raw, hash, err := auth.GenerateToken()
+    if err != nil {
+        return err
+    }
+
+    verification := &domain.EmailVerification{
+        UserID:    user.ID,
+        TokenHash: hash,
+        ExpiresAt: now.Add(s.config.TokenTTL),
+    }
+    if err := s.verificationRepo.Create(ctx, verification); err != nil {
+        return err
+    }
+
+    link := s.config.VerifyURL + "?token=" + raw
+    return s.emailService.SendEmailVerification(ctx, user.Email, user.Username, link)
+}
+
+func (s *emailVerificationService) Verify(ctx context.Context, rawToken string) error {
+    verification, err := s.verificationRepo.FindByTokenHash(ctx, auth.HashToken(rawToken))
+    if err != nil {
+        if errors.Is(err, repository.ErrVerificationNotFound) {
+            return ErrInvalidVerificationToken
+        }
+        return err
+    }
+
+    now := s.now()
+    if verification.IsUsed() {
+        return ErrInvalidVerificationToken
+    }
+    if verification.IsExpired(now) {
+        return ErrVerificationTokenExpired
+    }
+
+    // 동시 요청 시 한 번만 성공하도록 조건부 업데이트
+    if err := s.verificationRepo.MarkUsed(ctx, verification.ID, now); err != nil {
+        if errors.Is(err, repository.ErrVerificationNotFound) {
+            return ErrInvalidVerificationToken
+        }
+        return err
+    }
+
+    return s.userRepo.MarkEmailVerified(ctx, verification.UserID, now)
+}
+
+func (s *emailVerificationService) Resend(ctx context.Context) error {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return ErrUnauthorized
+    }
+
+    user, err := s.userRepo.FindByID(ctx, claims.UserID)
+    if err != nil {
+        return err
+    }
+    if user.IsEmailVerified() {
+        return ErrEmailAlreadyVerified
+    }
+
+    if err := s.checkThrottle(ctx, user.ID); err != nil {
+        return err
+    }
+
+    return s.Issue(ctx, user)
+}
+
+func (s *emailVerificationService) MarkVerified(ctx context.Context, userID uint) error {
+    if err := auth.RequirePermission(ctx, domain.PermissionUserManage); err != nil {
+        return err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, userID)
+    if err != nil {
+        return err
+    }
+    if user.IsEmailVerified() {
+        return ErrEmailAlreadyVerified
+    }
+
+    now := s.now()
+    if err := s.verificationRepo.InvalidateByUserID(ctx, userID, now); err != nil {
+        return err
+    }
+
+    return s.userRepo.MarkEmailVerified(ctx, userID, now)
+}
+
+// checkThrottle 재발송 간격과 일일 발송 횟수 확인
+func (s *emailVerificationService) checkThrottle(ctx context.Context, userID uint) error {
+    now := s.now()
+
+    latest, err := s.verificationRepo.FindLatestByUserID(ctx, userID)
+    if err != nil && !errors.Is(err, repository.ErrVerificationNotFound) {
+        return err
+    }
+    if latest != nil && now.Sub(latest.CreatedAt) < s.config.ResendCooldown {
+        return ErrVerificationThrottled
+    }
+
+    count, err := s.verificationRepo.CountSince(ctx, userID, now.Add(-24*time.Hour))
+    if err != nil {
+        return err
+    }
+    if int(count) >= s.config.MaxPerDay {
+        return ErrVerificationThrottled
+    }
+
+    return nil
+}
+
+// requireVerifiedEmail 이메일 인증 전 계정은 읽기만 허용 (작성 경로의 서비스에서 확인)
+// REST와 WebSocket 요청에 같이 적용하려고 미들웨어가 아니라 서비스에서 확인한다.
+func requireVerifiedEmail(ctx context.Context, userRepo repository.UserRepository, userID uint) error {
+    user, err := userRepo.FindByID(ctx, userID)
+    if err != nil {
+        return err
+    }
+    if !user.IsEmailVerified() {
+        return ErrEmailNotVerified
+    }
+    return nil
+}
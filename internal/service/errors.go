@@ -1,4 +1,112 @@
 
 var (
     ErrCannotWithdrawAdmin = errors.New("admin cannot withdraw")
+    ErrEmailAlreadyExists  = errors.New("email already exists")
+
+    // 이메일 인증
+    ErrInvalidVerificationToken = errors.New("invalid verification token")
+    ErrVerificationTokenExpired = errors.New("verification token expired")
+    ErrEmailAlreadyVerified     = errors.New("email already verified")
+    ErrVerificationThrottled    = errors.New("verification email requested too often")
+    ErrEmailNotVerified         = errors.New("email not verified")
+
+    // 비밀번호 재설정
+    ErrInvalidResetToken = errors.New("invalid password reset token")
+    ErrResetTokenExpired = errors.New("password reset token expired")
+    ErrWeakPassword      = errors.New("password does not satisfy the password policy")
+    ErrBreachedPassword  = errors.New("password appears in a breached password list")
+
+    // 로그인 / 2단계 인증
+    ErrInvalidCredentials       = errors.New("invalid email or password")
+    ErrInvalidChallenge         = errors.New("invalid or expired two factor challenge")
+    ErrInvalidTwoFactorCode     = errors.New("invalid two factor code")
+    ErrTwoFactorAlreadyEnabled  = errors.New("two factor already enabled")
+    ErrTwoFactorNotEnabled      = errors.New("two factor not enabled")
+    ErrTwoFactorSetupNotStarted = errors.New("two factor setup not started")
+    ErrTwoFactorRequiredForRole = errors.New("two factor is mandatory for this role")
+
+    // 외부 로그인 (OIDC)
+    ErrUnknownProvider           = errors.New("unknown identity provider")
+    ErrInvalidOIDCState          = errors.New("invalid or expired oidc state")
+    ErrOIDCAuthenticationFailed  = errors.New("oidc authentication failed")
+    ErrOIDCEmailNotVerified      = errors.New("identity provider did not return a verified email")
+    ErrIdentityLinkedToOtherUser = errors.New("external identity is linked to another user")
+    ErrProviderAlreadyLinked     = errors.New("another account of this provider is already linked")
+    ErrIdentityNotLinked         = errors.New("external identity not linked")
+    ErrLinkRequiresLogin         = errors.New("log in with password to link an unverified account")
+    ErrPasswordRequiredToUnlink  = errors.New("set a password before unlinking the last login method")
+    ErrUsernameUnavailable       = errors.New("could not allocate a username")
+
+    // 세션
+    ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+    ErrSessionNotFound     = errors.New("session not found")
+
+    // 개인 액세스 토큰
+    ErrInvalidPersonalToken    = errors.New("invalid or expired personal access token")
+    ErrPersonalTokenNotFound   = errors.New("personal access token not found")
+    ErrPersonalTokenNotAllowed = errors.New("personal access tokens cannot manage credentials")
+    ErrInvalidTokenScope       = errors.New("token scope is not granted to the user role")
+    ErrTokenExpiryTooLong      = errors.New("token expiry exceeds the maximum")
+    ErrTooManyPersonalTokens   = errors.New("too many active personal access tokens")
+
+    // 로그인 시도 제한 (상세 정보는 *LoginThrottledError)
+    ErrLoginThrottled = errors.New("too many login attempts")
+
+    // 프로필
+    ErrInvalidWebsite = errors.New("website must be an http or https url")
+    ErrInvalidAvatar  = errors.New("avatar must be a jpeg, png or gif image")
+    ErrAvatarTooLarge = errors.New("avatar image is too large")
+
+    // 팔로우 / 피드
+    ErrCannotFollowSelf = errors.New("cannot follow yourself")
+    ErrInvalidCursor    = errors.New("invalid cursor")
+
+    // 차단 / 뮤트
+    ErrBlocked         = errors.New("interaction blocked between users")
+    ErrCannotBlockSelf = errors.New("cannot block or mute yourself")
+
+    // 메시지
+    ErrEmptyMessage       = errors.New("message content is empty")
+    ErrMessageTooLong     = errors.New("message content is too long")
+    ErrMessageRateLimited = errors.New("too many messages")
+    ErrGroupTooLarge      = errors.New("too many conversation members")
+    ErrNoRecipients       = errors.New("conversation needs at least one other member")
+
+    // 개인 데이터 내보내기
+    ErrInvalidExportToken = errors.New("invalid or expired export download token")
+
+    // 휴면 계정
+    ErrAccountDormant           = errors.New("account is dormant")
+    ErrInvalidReactivationToken = errors.New("invalid or expired reactivation token")
+
+    // 역할 / 권한
+    ErrInvalidRoleName       = errors.New("role name must be 2-20 lowercase letters, digits or underscores")
+    ErrInvalidPermissionName = errors.New("permission name must look like resource:action")
+    ErrSystemRole            = errors.New("built-in roles cannot be deleted")
+    ErrSystemPermission      = errors.New("built-in permissions cannot be deleted")
+    ErrRoleInUse             = errors.New("role is assigned to users")
+    ErrProtectedGrant        = errors.New("grant is required to manage roles")
+
+    // 권한 판단 설명
+    ErrUnsupportedResource = errors.New("unsupported resource type")
+
+    // 기간 한정 부여
+    ErrInvalidGrantTarget = errors.New("exactly one of role or permission must be granted")
+    ErrInvalidGrantPeriod = errors.New("grant must end in the future, after it starts and within the maximum duration")
+
+    // 신고 / 모더레이션
+    ErrReportTargetNotFound   = errors.New("report target not found")
+    ErrCannotReportOwnContent = errors.New("cannot report your own content")
+
+    // 금칙어
+    ErrBannedWord        = errors.New("contains banned words")
+    ErrInvalidBannedWord = errors.New("banned word must have letters or digits and a valid action")
+
+    // 이용 제재
+    ErrAccountSuspended      = errors.New("account is suspended")
+    ErrAccountBanned         = errors.New("account is banned")
+    ErrWriteRestricted       = errors.New("account is restricted from writing")
+    ErrInvalidSanctionType   = errors.New("unknown sanction type")
+    ErrInvalidSanctionPeriod = errors.New("sanction must end in the future and after it starts; suspensions need an end")
+    ErrCannotSanctionUser    = errors.New("cannot sanction yourself or an admin")
 )
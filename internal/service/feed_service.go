@@ -0,0 +1,206 @@
+package service
+
+import (
+    "context"
+    "encoding/json"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/worker"
+    "goboardapi/internal/worker/handlers"
+
+    "github.com/google/uuid"
+)
+
+// FeedConfig 홈 피드 정책
+//
+// 기본은 fan-out-on-write: 글을 쓰면 워커가 팔로워마다 feed_items를 넣는다.
+// 팔로워가 PopularFollowerCount 이상인 작성자와 게시판 글은 쓰기 비용이 너무 커서
+// 넣지 않고, 홈 피드를 읽을 때 posts에서 직접 합친다 (fan-out-on-read).
+type FeedConfig struct {
+    PopularFollowerCount int64         // 이 이상이면 fan-out 생략
+    FanOutBatchSize      int           // 한 번에 넣는 팔로워 수
+    BackfillSize         int           // 팔로우 직후 채워 넣는 최근 글 수
+    Retention            time.Duration // 이보다 오래된 feed_items는 정리
+    DefaultPageSize      int
+    MaxPageSize          int
+}
+
+// DefaultFeedConfig 기본 정책
+func DefaultFeedConfig() FeedConfig {
+    return FeedConfig{
+        PopularFollowerCount: 10000,
+        FanOutBatchSize:      1000,
+        BackfillSize:         20,
+        Retention:            30 * 24 * time.Hour,
+        DefaultPageSize:      20,
+        MaxPageSize:          100,
+    }
+}
+
+type FeedService interface {
+    // OnPostCreated 게시글 작성 후 호출 (팔로워 피드 반영을 큐에 등록)
+    OnPostCreated(ctx context.Context, post *domain.Post) error
+    // FanOut 팔로워 피드에 게시글 추가 (워커에서 호출)
+    FanOut(ctx context.Context, postID uint) error
+    // OnFollow 새로 팔로우한 작성자의 최근 글을 피드에 채움
+    OnFollow(ctx context.Context, followerID uint, author *domain.User) error
+    // OnUnfollow 언팔로우한 작성자의 글을 피드에서 제거
+    OnUnfollow(ctx context.Context, followerID, authorID uint) error
+    // Home 내 홈 피드 (커서 페이징)
+    Home(ctx context.Context, req *dto.CursorPagination) (*dto.FeedResponse, error)
+    // Cleanup 보존 기간이 지난 피드 항목 정리 (스케줄러에서 호출)
+    Cleanup(ctx context.Context) (int64, error)
+}
+
+type feedService struct {
+    feedRepo   repository.FeedRepository
+    followRepo repository.FollowRepository
+    postRepo   repository.PostRepository
+    userRepo   repository.UserRepository
+    queue      worker.Queue
+    config     FeedConfig
+    now        func() time.Time
+}
+
+func NewFeedService(
+    feedRepo repository.FeedRepository,
+    followRepo repository.FollowRepository,
+    postRepo repository.PostRepository,
+    userRepo repository.UserRepository,
+    queue worker.Queue,
+    config FeedConfig,
+) FeedService {
+    return &feedService{
+        feedRepo:   feedRepo,
+        followRepo: followRepo,
+        postRepo:   postRepo,
+        userRepo:   userRepo,
+        queue:      queue,
+        config:     config,
+        now:        time.Now,
+    }
+}
+
+func (s *feedService) OnPostCreated(ctx context.Context, post *domain.Post) error {
+    payload, _ := json.Marshal(handlers.FanOutPayload{PostID: post.ID})
+
+    return s.queue.Enqueue(ctx, worker.Task{
+        ID:        uuid.New().String(),
+        Type:      worker.TaskFanOutPost,
+        Payload:   payload,
+        CreatedAt: s.now(),
+    })
+}
+
+func (s *feedService) FanOut(ctx context.Context, postID uint) error {
+    post, err := s.postRepo.FindByID(ctx, postID)
+    if err != nil {
+        return err
+    }
+
+    author, err := s.userRepo.FindByID(ctx, post.AuthorID)
+    if err != nil {
+        return err
+    }
+    if s.isPopular(author) {
+        return nil
+    }
+
+    // 재시도돼도 (user_id, post_id) 유니크 인덱스로 중복은 무시된다
+    var afterID uint
+    for {
+        followerIDs, err := s.followRepo.FindFollowerIDsAfter(ctx, author.ID, afterID, s.config.FanOutBatchSize)
+        if err != nil {
+            return err
+        }
+        if len(followerIDs) == 0 {
+            return nil
+        }
+
+        items := make([]*domain.FeedItem, 0, len(followerIDs))
+        for _, followerID := range followerIDs {
+            items = append(items, &domain.FeedItem{
+                UserID:    followerID,
+                PostID:    post.ID,
+                AuthorID:  post.AuthorID,
+                CreatedAt: post.CreatedAt,
+            })
+        }
+        if err := s.feedRepo.AddItems(ctx, items); err != nil {
+            return err
+        }
+
+        afterID = followerIDs[len(followerIDs)-1]
+    }
+}
+
+func (s *feedService) OnFollow(ctx context.Context, followerID uint, author *domain.User) error {
+    // 인기 작성자의 글은 읽을 때 합쳐지므로 채울 필요가 없다
+    if s.isPopular(author) {
+        return nil
+    }
+    return s.feedRepo.Backfill(ctx, followerID, author.ID, s.config.BackfillSize)
+}
+
+func (s *feedService) OnUnfollow(ctx context.Context, followerID, authorID uint) error {
+    return s.feedRepo.RemoveAuthor(ctx, followerID, authorID)
+}
+
+func (s *feedService) Home(ctx context.Context, req *dto.CursorPagination) (*dto.FeedResponse, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    size := req.Size
+    if size < 1 {
+        size = s.config.DefaultPageSize
+    }
+    if size > s.config.MaxPageSize {
+        size = s.config.MaxPageSize
+    }
+
+    query := repository.HomeFeedQuery{
+        UserID:               claims.UserID,
+        PopularFollowerCount: s.config.PopularFollowerCount,
+        Limit:                size + 1, // 다음 페이지 존재 여부 확인용
+    }
+    if req.Cursor != "" {
+        cursor, err := dto.DecodeCursor(req.Cursor)
+        if err != nil {
+            return nil, ErrInvalidCursor
+        }
+        query.BeforeCreatedAt = cursor.CreatedAt
+        query.BeforeID = cursor.ID
+    }
+
+    posts, err := s.feedRepo.FindHome(ctx, query)
+    if err != nil {
+        return nil, err
+    }
+
+    resp := &dto.FeedResponse{Posts: make([]*dto.PostResponse, 0, len(posts))}
+    if len(posts) > size {
+        posts = posts[:size]
+        last := posts[len(posts)-1]
+        cursor := dto.Cursor{ID: last.ID, CreatedAt: last.CreatedAt}
+        resp.Meta = dto.CursorMeta{NextCursor: cursor.Encode(), HasMore: true}
+    }
+    for _, post := range posts {
+        resp.Posts = append(resp.Posts, dto.ToPostResponse(post))
+    }
+
+    return resp, nil
+}
+
+func (s *feedService) Cleanup(ctx context.Context) (int64, error) {
+    return s.feedRepo.DeleteOlderThan(ctx, s.now().Add(-s.config.Retention))
+}
+
+func (s *feedService) isPopular(author *domain.User) bool {
+    return author.FollowerCount >= s.config.PopularFollowerCount
+}
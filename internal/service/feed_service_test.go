@@ -0,0 +1,162 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/worker"
+    "goboardapi/internal/worker/handlers"
+)
+
+func (r *fakePostRepository) Create(ctx context.Context, post *domain.Post) error {
+    post.ID = 10
+    post.CreatedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+    r.post = post
+    return nil
+}
+
+type fakeFeedRepository struct {
+    repository.FeedRepository
+    items      []*domain.FeedItem
+    backfilled map[uint]uint // follower -> author
+    home       []*domain.Post
+    query      repository.HomeFeedQuery
+}
+
+func (r *fakeFeedRepository) AddItems(ctx context.Context, items []*domain.FeedItem) error {
+    r.items = append(r.items, items...)
+    return nil
+}
+
+func (r *fakeFeedRepository) Backfill(ctx context.Context, userID, authorID uint, limit int) error {
+    r.backfilled[userID] = authorID
+    return nil
+}
+
+func (r *fakeFeedRepository) FindHome(ctx context.Context, q repository.HomeFeedQuery) ([]*domain.Post, error) {
+    r.query = q
+    if len(r.home) > q.Limit {
+        return r.home[:q.Limit], nil
+    }
+    return r.home, nil
+}
+
+type fakeFollowRepository struct {
+    repository.FollowRepository
+    followers []uint
+}
+
+func (r *fakeFollowRepository) FindFollowerIDsAfter(ctx context.Context, userID, afterID uint, limit int) ([]uint, error) {
+    var ids []uint
+    for _, id := range r.followers {
+        if id > afterID && len(ids) < limit {
+            ids = append(ids, id)
+        }
+    }
+    return ids, nil
+}
+
+func TestFeedFanOutOnPostCreated(t *testing.T) {
+    verifiedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        1: {ID: 1, EmailVerifiedAt: &verifiedAt, FollowerCount: 3},
+    }}
+    posts := &fakePostRepository{}
+    feeds := &fakeFeedRepository{}
+    queue := worker.NewMemoryQueue(10)
+    config := DefaultFeedConfig()
+    config.FanOutBatchSize = 2
+    feed := NewFeedService(feeds, &fakeFollowRepository{followers: []uint{2, 3, 4}}, posts, users, queue, config)
+
+    ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 1})
+    post, err := NewPostWriteService(posts, users, feed, nil, nil, nil).Create(ctx, &dto.CreatePostRequest{Title: "제목", Content: "본문"})
+    if err != nil {
+        t.Fatalf("Create() error = %v", err)
+    }
+
+    // 작성하면 fan-out 태스크가 큐에 들어가고, 워커가 팔로워마다 피드 항목을 넣는다
+    task, err := queue.Dequeue(context.Background())
+    if err != nil || task.Type != worker.TaskFanOutPost {
+        t.Fatalf("Dequeue() = %+v, %v, want fan-out task", task, err)
+    }
+    if err := handlers.NewFeedHandler(feed).Handle(context.Background(), task.Payload); err != nil {
+        t.Fatalf("Handle() error = %v", err)
+    }
+    if len(feeds.items) != 3 {
+        t.Fatalf("feed items = %d, want 3 (one per follower across batches)", len(feeds.items))
+    }
+    for _, item := range feeds.items {
+        if item.PostID != post.ID || item.AuthorID != 1 || !item.CreatedAt.Equal(post.CreatedAt) {
+            t.Errorf("feed item = %+v, want post %d by author 1", item, post.ID)
+        }
+    }
+
+    // 인기 작성자의 글은 읽을 때 합치므로 넣지 않는다
+    users.users[1].FollowerCount = config.PopularFollowerCount
+    feeds.items = nil
+    if err := feed.FanOut(context.Background(), post.ID); err != nil || len(feeds.items) != 0 {
+        t.Errorf("FanOut(popular) = %d items, %v, want none", len(feeds.items), err)
+    }
+}
+
+func TestFeedOnFollow(t *testing.T) {
+    feeds := &fakeFeedRepository{backfilled: map[uint]uint{}}
+    feed := NewFeedService(feeds, nil, nil, nil, nil, DefaultFeedConfig())
+
+    if err := feed.OnFollow(context.Background(), 2, &domain.User{ID: 1, FollowerCount: 10}); err != nil {
+        t.Fatalf("OnFollow() error = %v", err)
+    }
+    if feeds.backfilled[2] != 1 {
+        t.Errorf("backfilled = %v, want follower 2 filled with author 1", feeds.backfilled)
+    }
+
+    if err := feed.OnFollow(context.Background(), 3, &domain.User{ID: 5, FollowerCount: DefaultFeedConfig().PopularFollowerCount}); err != nil {
+        t.Fatalf("OnFollow(popular) error = %v", err)
+    }
+    if _, ok := feeds.backfilled[3]; ok {
+        t.Error("popular author should not be backfilled")
+    }
+}
+
+func TestFeedHome(t *testing.T) {
+    base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+    feeds := &fakeFeedRepository{}
+    for i := uint(5); i >= 1; i-- {
+        feeds.home = append(feeds.home, &domain.Post{ID: i, CreatedAt: base.Add(time.Duration(i) * time.Minute)})
+    }
+    feed := NewFeedService(feeds, nil, nil, nil, nil, DefaultFeedConfig())
+
+    if _, err := feed.Home(context.Background(), &dto.CursorPagination{}); !errors.Is(err, ErrUnauthorized) {
+        t.Errorf("Home() without login error = %v, want ErrUnauthorized", err)
+    }
+
+    ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 7})
+    resp, err := feed.Home(ctx, &dto.CursorPagination{Size: 2})
+    if err != nil {
+        t.Fatalf("Home() error = %v", err)
+    }
+    if len(resp.Posts) != 2 || resp.Posts[0].ID != 5 || !resp.Meta.HasMore {
+        t.Fatalf("Home() = %d posts, has_more %v, want newest 2 with more", len(resp.Posts), resp.Meta.HasMore)
+    }
+    if feeds.query.UserID != 7 || feeds.query.PopularFollowerCount != DefaultFeedConfig().PopularFollowerCount {
+        t.Errorf("query = %+v, want user 7 with popular threshold", feeds.query)
+    }
+
+    // 다음 페이지는 마지막 글 다음부터
+    if _, err := feed.Home(ctx, &dto.CursorPagination{Size: 2, Cursor: resp.Meta.NextCursor}); err != nil {
+        t.Fatalf("Home(next) error = %v", err)
+    }
+    if feeds.query.BeforeID != 4 || !feeds.query.BeforeCreatedAt.Equal(base.Add(4*time.Minute)) {
+        t.Errorf("next query = %+v, want before post 4", feeds.query)
+    }
+
+    if _, err := feed.Home(ctx, &dto.CursorPagination{Cursor: "not-a-cursor"}); !errors.Is(err, ErrInvalidCursor) {
+        t.Errorf("Home(bad cursor) error = %v, want ErrInvalidCursor", err)
+    }
+}
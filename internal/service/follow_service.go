@@ -0,0 +1,188 @@
+package service
+
+import (
+    "context"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+
+    "gorm.io/gorm"
+)
+
+type FollowService interface {
+    FollowUser(ctx context.Context, username string) error
+    UnfollowUser(ctx context.Context, username string) error
+    FollowBoard(ctx context.Context, boardID uint) error
+    UnfollowBoard(ctx context.Context, boardID uint) error
+    // Followers username을 팔로우하는 사용자 목록
+    Followers(ctx context.Context, username string, p *dto.Pagination) ([]*domain.User, int64, error)
+    // Following username이 팔로우하는 사용자 목록
+    Following(ctx context.Context, username string, p *dto.Pagination) ([]*domain.User, int64, error)
+}
+
+type followService struct {
+    db              *gorm.DB
+    userRepo        repository.UserRepository
+    boardRepo       repository.BoardRepository
+    followRepo      repository.FollowRepository
+    blockRepo       repository.UserBlockRepository
+    feedSvc         FeedService
+    notificationSvc *NotificationService
+}
+
+func NewFollowService(
+    db *gorm.DB,
+    userRepo repository.UserRepository,
+    boardRepo repository.BoardRepository,
+    followRepo repository.FollowRepository,
+    blockRepo repository.UserBlockRepository,
+    feedSvc FeedService,
+    notificationSvc *NotificationService,
+) FollowService {
+    return &followService{
+        db:              db,
+        userRepo:        userRepo,
+        boardRepo:       boardRepo,
+        followRepo:      followRepo,
+        blockRepo:       blockRepo,
+        feedSvc:         feedSvc,
+        notificationSvc: notificationSvc,
+    }
+}
+
+func (s *followService) FollowUser(ctx context.Context, username string) error {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return ErrUnauthorized
+    }
+
+    followee, err := s.userRepo.FindByUsername(ctx, username)
+    if err != nil {
+        return err
+    }
+    if followee.ID == claims.UserID {
+        return ErrCannotFollowSelf
+    }
+
+    blocked, err := s.blockRepo.IsBlockedEither(ctx, claims.UserID, followee.ID)
+    if err != nil {
+        return err
+    }
+    if blocked {
+        return ErrBlocked
+    }
+
+    var created bool
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        followRepo := repository.NewFollowRepository(tx)
+
+        created, err = followRepo.Create(ctx, &domain.Follow{
+            FollowerID: claims.UserID,
+            TargetType: domain.FollowTargetUser,
+            TargetID:   followee.ID,
+        })
+        if err != nil || !created {
+            return err
+        }
+        return followRepo.AdjustUserCounts(ctx, claims.UserID, followee.ID, 1)
+    })
+    if err != nil || !created {
+        return err
+    }
+
+    log := middleware.LoggerFromRequestContext(ctx)
+    if err := s.feedSvc.OnFollow(ctx, claims.UserID, followee); err != nil {
+        log.Warn("팔로우 피드 채우기 실패", "followee_id", followee.ID, "error", err)
+    }
+
+    follower, err := s.userRepo.FindByID(ctx, claims.UserID)
+    if err == nil {
+        err = s.notificationSvc.NotifyNewFollower(ctx, followee.ID, follower)
+    }
+    if err != nil {
+        log.Warn("팔로우 알림 실패", "followee_id", followee.ID, "error", err)
+    }
+
+    return nil
+}
+
+func (s *followService) UnfollowUser(ctx context.Context, username string) error {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return ErrUnauthorized
+    }
+
+    followee, err := s.userRepo.FindByUsername(ctx, username)
+    if err != nil {
+        return err
+    }
+
+    var deleted bool
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        followRepo := repository.NewFollowRepository(tx)
+
+        deleted, err = followRepo.Delete(ctx, claims.UserID, domain.FollowTargetUser, followee.ID)
+        if err != nil || !deleted {
+            return err
+        }
+        return followRepo.AdjustUserCounts(ctx, claims.UserID, followee.ID, -1)
+    })
+    if err != nil || !deleted {
+        return err
+    }
+
+    if err := s.feedSvc.OnUnfollow(ctx, claims.UserID, followee.ID); err != nil {
+        middleware.LoggerFromRequestContext(ctx).Warn("언팔로우 피드 정리 실패", "followee_id", followee.ID, "error", err)
+    }
+
+    return nil
+}
+
+func (s *followService) FollowBoard(ctx context.Context, boardID uint) error {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return ErrUnauthorized
+    }
+
+    if _, err := s.boardRepo.FindByID(ctx, boardID); err != nil {
+        return err
+    }
+
+    // 게시판 글은 읽을 때 합치므로 피드를 채울 필요가 없다
+    _, err := s.followRepo.Create(ctx, &domain.Follow{
+        FollowerID: claims.UserID,
+        TargetType: domain.FollowTargetBoard,
+        TargetID:   boardID,
+    })
+    return err
+}
+
+func (s *followService) UnfollowBoard(ctx context.Context, boardID uint) error {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return ErrUnauthorized
+    }
+
+    _, err := s.followRepo.Delete(ctx, claims.UserID, domain.FollowTargetBoard, boardID)
+    return err
+}
+
+func (s *followService) Followers(ctx context.Context, username string, p *dto.Pagination) ([]*domain.User, int64, error) {
+    user, err := s.userRepo.FindByUsername(ctx, username)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    return s.followRepo.FindFollowers(ctx, user.ID, p.Offset(), p.Size)
+}
+
+func (s *followService) Following(ctx context.Context, username string, p *dto.Pagination) ([]*domain.User, int64, error) {
+    user, err := s.userRepo.FindByUsername(ctx, username)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    return s.followRepo.FindFollowing(ctx, user.ID, p.Offset(), p.Size)
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+    "context"
+    "fmt"
+    "strings"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+)
+
+// LoginAttemptConfig 로그인 무차별 대입 방어 정책
+type LoginAttemptConfig struct {
+    MaxAccountFailures int           // 계정별 실패 허용 횟수 (초과 시 잠금)
+    MaxIPFailures      int           // IP별 실패 허용 횟수 (여러 계정 대상 시도 포함)
+    FailureWindow      time.Duration // 실패 횟수를 세는 기간
+    LockoutDuration    time.Duration
+    DelayAfter         int           // 이 횟수부터 다음 시도까지 대기 시간 부여
+    BaseDelay          time.Duration // 실패할 때마다 두 배씩 증가
+    MaxDelay           time.Duration
+}
+
+// DefaultLoginAttemptConfig 기본 정책
+func DefaultLoginAttemptConfig() LoginAttemptConfig {
+    return LoginAttemptConfig{
+        MaxAccountFailures: 10,
+        MaxIPFailures:      50,
+        FailureWindow:      15 * time.Minute,
+        LockoutDuration:    15 * time.Minute,
+        DelayAfter:         3,
+        BaseDelay:          time.Second,
+        MaxDelay:           30 * time.Second,
+    }
+}
+
+// LoginThrottledError 잠금/대기 중이라 로그인을 시도할 수 없음
+type LoginThrottledError struct {
+    RetryAfter time.Duration
+    Locked     bool // true면 계정 또는 IP 잠금, false면 점진적 대기
+}
+
+func (e *LoginThrottledError) Error() string {
+    if e.Locked {
+        return fmt.Sprintf("login locked, retry after %s", e.RetryAfter)
+    }
+    return fmt.Sprintf("login throttled, retry after %s", e.RetryAfter)
+}
+
+// Is errors.Is(err, ErrLoginThrottled) 지원
+func (e *LoginThrottledError) Is(target error) bool {
+    return target == ErrLoginThrottled
+}
+
+type LoginAttemptService interface {
+    // Check 로그인 시도 가능 여부 (잠금/대기 중이면 *LoginThrottledError)
+    Check(ctx context.Context, email, ip string) error
+    // RecordFailure 실패 기록 (user는 존재하지 않는 계정이면 nil)
+    RecordFailure(ctx context.Context, email, ip string, user *domain.User)
+    // RecordSuccess 계정 실패 기록 초기화
+    RecordSuccess(ctx context.Context, email string)
+    // Unlock 관리자용 계정 잠금 해제
+    Unlock(ctx context.Context, userID uint) error
+}
+
+type loginAttemptService struct {
+    store        cache.CounterStore
+    userRepo     repository.UserRepository
+    emailService *EmailService
+    config       LoginAttemptConfig
+    now          func() time.Time
+}
+
+func NewLoginAttemptService(
+    store cache.CounterStore,
+    userRepo repository.UserRepository,
+    emailService *EmailService,
+    config LoginAttemptConfig,
+) LoginAttemptService {
+    return &loginAttemptService{
+        store:        store,
+        userRepo:     userRepo,
+        emailService: emailService,
+        config:       config,
+        now:          time.Now,
+    }
+}
+
+// 키 구성: 계정은 소문자 이메일 기준 (존재하는 계정만 센다)
+func accountKey(kind, email string) string {
+    return "login:" + kind + ":account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipKey(kind, ip string) string {
+    return "login:" + kind + ":ip:" + ip
+}
+
+func (s *loginAttemptService) Check(ctx context.Context, email, ip string) error {
+    for _, key := range []string{accountKey("lock", email), ipKey("lock", ip)} {
+        ttl, err := s.store.TTL(ctx, key)
+        if err != nil {
+            return err
+        }
+        if ttl > 0 {
+            return &LoginThrottledError{RetryAfter: ttl, Locked: true}
+        }
+    }
+
+    ttl, err := s.store.TTL(ctx, accountKey("delay", email))
+    if err != nil {
+        return err
+    }
+    if ttl > 0 {
+        return &LoginThrottledError{RetryAfter: ttl}
+    }
+
+    return nil
+}
+
+func (s *loginAttemptService) RecordFailure(ctx context.Context, email, ip string, user *domain.User) {
+    logger := middleware.LoggerFromRequestContext(ctx)
+
+    ipFailures, err := s.store.Incr(ctx, ipKey("fail", ip), s.config.FailureWindow)
+    if err != nil {
+        logger.Error("로그인 실패 기록 오류", "error", err)
+        return
+    }
+
+    if int(ipFailures) >= s.config.MaxIPFailures {
+        s.store.SetFlag(ctx, ipKey("lock", ip), s.config.LockoutDuration)
+        s.store.Delete(ctx, ipKey("fail", ip))
+        logger.Warn("IP 로그인 잠금", "ip", ip, "failures", ipFailures)
+    }
+
+    // 없는 이메일은 IP 제한만 적용한다 (임의의 이메일로 키를 무한히 만들 수 없게)
+    if user == nil {
+        return
+    }
+
+    accountFailures, err := s.store.Incr(ctx, accountKey("fail", email), s.config.FailureWindow)
+    if err != nil {
+        logger.Error("로그인 실패 기록 오류", "error", err)
+        return
+    }
+
+    if delay := progressiveDelay(int(accountFailures), s.config); delay > 0 {
+        s.store.SetFlag(ctx, accountKey("delay", email), delay)
+    }
+
+    if int(accountFailures) >= s.config.MaxAccountFailures {
+        until := s.now().Add(s.config.LockoutDuration)
+        s.store.SetFlag(ctx, accountKey("lock", email), s.config.LockoutDuration)
+        s.store.Delete(ctx, accountKey("fail", email), accountKey("delay", email))
+
+        logger.Warn("계정 로그인 잠금", "user_id", user.ID, "ip", ip, "failures", accountFailures, "until", until)
+        if err := s.emailService.SendAccountLocked(ctx, user.Email, user.Username, ip, until); err != nil {
+            logger.Error("계정 잠금 메일 발송 실패", "user_id", user.ID, "error", err)
+        }
+    }
+}
+
+// progressiveDelay 실패 횟수에 따른 다음 시도 대기 시간 (DelayAfter부터 두 배씩, MaxDelay까지)
+func progressiveDelay(failures int, config LoginAttemptConfig) time.Duration {
+    if failures < config.DelayAfter {
+        return 0
+    }
+
+    delay := config.BaseDelay
+    for i := config.DelayAfter; i < failures; i++ {
+        delay *= 2
+        if delay >= config.MaxDelay {
+            return config.MaxDelay
+        }
+    }
+    return delay
+}
+
+func (s *loginAttemptService) RecordSuccess(ctx context.Context, email string) {
+    if err := s.store.Delete(ctx, accountKey("fail", email), accountKey("delay", email)); err != nil {
+        middleware.LoggerFromRequestContext(ctx).Error("로그인 실패 기록 초기화 오류", "error", err)
+    }
+}
+
+func (s *loginAttemptService) Unlock(ctx context.Context, userID uint) error {
+    if err := auth.RequirePermission(ctx, domain.PermissionUserManage); err != nil {
+        return err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, userID)
+    if err != nil {
+        return err
+    }
+
+    if err := s.store.Delete(ctx,
+        accountKey("lock", user.Email),
+        accountKey("fail", user.Email),
+        accountKey("delay", user.Email),
+    ); err != nil {
+        return err
+    }
+
+    adminID, _ := auth.NewChecker().GetCurrentUserID(ctx)
+    middleware.LoggerFromRequestContext(ctx).Info("계정 로그인 잠금 해제", "user_id", user.ID, "admin_id", adminID)
+    return nil
+}
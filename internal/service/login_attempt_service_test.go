@@ -0,0 +1,67 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+)
+
+func TestProgressiveDelay(t *testing.T) {
+    config := LoginAttemptConfig{
+        DelayAfter: 3,
+        BaseDelay:  time.Second,
+        MaxDelay:   10 * time.Second,
+    }
+
+    tests := []struct {
+        failures int
+        want     time.Duration
+    }{
+        {1, 0},
+        {2, 0},
+        {3, time.Second},
+        {4, 2 * time.Second},
+        {5, 4 * time.Second},
+        {6, 8 * time.Second},
+        {7, 10 * time.Second},
+        {20, 10 * time.Second},
+    }
+
+    for _, tt := range tests {
+        if got := progressiveDelay(tt.failures, config); got != tt.want {
+            t.Errorf("progressiveDelay(%d) = %v, want %v", tt.failures, got, tt.want)
+        }
+    }
+}
+
+func TestRecordFailureUnknownEmail(t *testing.T) {
+    ctx := context.Background()
+    config := DefaultLoginAttemptConfig()
+    config.DelayAfter = 1
+    config.MaxAccountFailures = 100
+    config.MaxIPFailures = 3
+    svc := NewLoginAttemptService(cache.NewMemoryCounterStore(), nil, nil, config)
+
+    // 없는 이메일은 계정 지연/잠금 키를 만들지 않는다
+    svc.RecordFailure(ctx, "nobody@example.com", "10.0.0.1", nil)
+    if err := svc.Check(ctx, "nobody@example.com", "10.0.0.2"); err != nil {
+        t.Errorf("Check(unknown email) error = %v, want nil", err)
+    }
+
+    // 있는 계정은 실패가 쌓이면 지연된다
+    svc.RecordFailure(ctx, "user@example.com", "10.0.0.1", &domain.User{ID: 1, Email: "user@example.com"})
+    var throttled *LoginThrottledError
+    if err := svc.Check(ctx, "user@example.com", "10.0.0.2"); !errors.As(err, &throttled) || throttled.Locked {
+        t.Errorf("Check(known email) error = %v, want delay", err)
+    }
+
+    // 없는 이메일로 계속 시도하면 IP 제한에 걸린다
+    svc.RecordFailure(ctx, "other@example.com", "10.0.0.1", nil)
+    if err := svc.Check(ctx, "nobody@example.com", "10.0.0.1"); !errors.As(err, &throttled) || !throttled.Locked {
+        t.Errorf("Check(ip) error = %v, want IP lock", err)
+    }
+}
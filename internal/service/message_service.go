@@ -0,0 +1,454 @@
+package service
+
+import (
+    "context"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "strings"
+    "time"
+    "unicode/utf8"
+
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/notification"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/ws"
+)
+
+// MessageConfig 대화/메시지 정책
+type MessageConfig struct {
+    MaxGroupSize     int   // 그룹 대화방 최대 인원 (만든 사람 포함)
+    MaxContentLength int   // 메시지 최대 글자 수
+    RateLimit        int64 // RateWindow 동안 보낼 수 있는 메시지 수 (사용자별)
+    RateWindow       time.Duration
+    DefaultPageSize  int
+    MaxPageSize      int
+    PreviewLength    int // 알림에 표시할 미리보기 글자 수
+}
+
+// DefaultMessageConfig 기본 정책
+func DefaultMessageConfig() MessageConfig {
+    return MessageConfig{
+        MaxGroupSize:     10,
+        MaxContentLength: 2000,
+        RateLimit:        30,
+        RateWindow:       time.Minute,
+        DefaultPageSize:  30,
+        MaxPageSize:      100,
+        PreviewLength:    50,
+    }
+}
+
+type MessageService interface {
+    // CreateConversation 대화방 생성 (상대가 한 명이고 제목이 없으면 기존 1:1 대화방을 돌려준다)
+    CreateConversation(ctx context.Context, req *dto.CreateConversationRequest) (*dto.ConversationResponse, error)
+    // List 참여 중인 대화방 목록 (최근 메시지 순, 안 읽은 수 포함)
+    List(ctx context.Context, p *dto.Pagination) ([]*dto.ConversationResponse, error)
+    // Send 메시지 저장 후 참여자에게 실시간 전달
+    Send(ctx context.Context, conversationID uint, content string) (*dto.MessageResponse, error)
+    // History beforeID 이전 메시지 (최신순, 실시간 연결이 끊겼을 때의 대체 경로)
+    History(ctx context.Context, conversationID, beforeID uint, size int) ([]*dto.MessageResponse, error)
+    // MarkRead 읽음 위치 갱신 후 다른 참여자에게 읽음 표시 전달
+    MarkRead(ctx context.Context, conversationID, messageID uint) error
+    // Unread 전체 및 대화방별 안 읽은 메시지 수
+    Unread(ctx context.Context) (*dto.UnreadResponse, error)
+}
+
+type messageService struct {
+    hub       *ws.Hub
+    store     cache.CounterStore
+    convRepo  repository.ConversationRepository
+    userRepo  repository.UserRepository
+    blockRepo repository.UserBlockRepository
+    sanctions *SanctionStore
+    config    MessageConfig
+}
+
+func NewMessageService(
+    hub *ws.Hub,
+    store cache.CounterStore,
+    convRepo repository.ConversationRepository,
+    userRepo repository.UserRepository,
+    blockRepo repository.UserBlockRepository,
+    sanctions *SanctionStore,
+    config MessageConfig,
+) MessageService {
+    return &messageService{
+        hub:       hub,
+        store:     store,
+        convRepo:  convRepo,
+        userRepo:  userRepo,
+        blockRepo: blockRepo,
+        sanctions: sanctions,
+        config:    config,
+    }
+}
+
+// directKey 1:1 대화방 키 (참여자 순서와 무관하게 같은 값)
+func directKey(a, b uint) string {
+    if a > b {
+        a, b = b, a
+    }
+    return fmt.Sprintf("%d:%d", a, b)
+}
+
+func (s *messageService) CreateConversation(ctx context.Context, req *dto.CreateConversationRequest) (*dto.ConversationResponse, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    if err := requireVerifiedEmail(ctx, s.userRepo, claims.UserID); err != nil {
+        return nil, err
+    }
+
+    memberIDs := []uint{claims.UserID}
+    seen := map[uint]bool{claims.UserID: true}
+    for _, username := range req.Usernames {
+        user, err := s.userRepo.FindByUsername(ctx, strings.TrimSpace(username))
+        if err != nil {
+            return nil, err
+        }
+        if seen[user.ID] {
+            continue
+        }
+        seen[user.ID] = true
+
+        if len(memberIDs) >= s.config.MaxGroupSize {
+            return nil, ErrGroupTooLarge
+        }
+
+        // 만든 사람뿐 아니라 이미 들어간 모든 참여자와 차단 관계가 없어야 한다
+        blocked, err := s.blockRepo.IsBlockedAny(ctx, user.ID, memberIDs)
+        if err != nil {
+            return nil, err
+        }
+        if blocked {
+            return nil, ErrBlocked
+        }
+        memberIDs = append(memberIDs, user.ID)
+    }
+
+    if len(memberIDs) < 2 {
+        return nil, ErrNoRecipients
+    }
+
+    var conversation *domain.Conversation
+    var err error
+    if len(memberIDs) == 2 && strings.TrimSpace(req.Title) == "" {
+        conversation, err = s.findOrCreateDirect(ctx, claims.UserID, memberIDs[1])
+    } else {
+        conversation = &domain.Conversation{
+            IsGroup:   true,
+            Title:     strings.TrimSpace(req.Title),
+            CreatorID: claims.UserID,
+        }
+        if err = s.convRepo.Create(ctx, conversation, memberIDs); err == nil {
+            conversation, err = s.convRepo.FindByID(ctx, conversation.ID)
+        }
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    unread, err := s.convRepo.CountUnread(ctx, claims.UserID)
+    if err != nil {
+        return nil, err
+    }
+    return dto.ToConversationResponse(conversation, unread[conversation.ID]), nil
+}
+
+func (s *messageService) findOrCreateDirect(ctx context.Context, userID, otherID uint) (*domain.Conversation, error) {
+    key := directKey(userID, otherID)
+
+    conversation, err := s.convRepo.FindByDirectKey(ctx, key)
+    if err == nil {
+        return conversation, nil
+    }
+    if !errors.Is(err, repository.ErrConversationNotFound) {
+        return nil, err
+    }
+
+    conversation = &domain.Conversation{DirectKey: &key, CreatorID: userID}
+    if err := s.convRepo.Create(ctx, conversation, []uint{userID, otherID}); err != nil {
+        // 동시에 만들어진 경우 유니크 제약에 걸리므로 먼저 만들어진 대화방을 쓴다
+        if existing, findErr := s.convRepo.FindByDirectKey(ctx, key); findErr == nil {
+            return existing, nil
+        }
+        return nil, err
+    }
+    return s.convRepo.FindByID(ctx, conversation.ID)
+}
+
+func (s *messageService) List(ctx context.Context, p *dto.Pagination) ([]*dto.ConversationResponse, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    conversations, err := s.convRepo.FindByUserID(ctx, claims.UserID, p.Offset(), p.Size)
+    if err != nil {
+        return nil, err
+    }
+
+    unread, err := s.convRepo.CountUnread(ctx, claims.UserID)
+    if err != nil {
+        return nil, err
+    }
+
+    result := make([]*dto.ConversationResponse, 0, len(conversations))
+    for _, conversation := range conversations {
+        result = append(result, dto.ToConversationResponse(conversation, unread[conversation.ID]))
+    }
+    return result, nil
+}
+
+func (s *messageService) Send(ctx context.Context, conversationID uint, content string) (*dto.MessageResponse, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    content = strings.TrimSpace(content)
+    if content == "" {
+        return nil, ErrEmptyMessage
+    }
+    if utf8.RuneCountInString(content) > s.config.MaxContentLength {
+        return nil, ErrMessageTooLong
+    }
+
+    if _, err := s.convRepo.FindMember(ctx, conversationID, claims.UserID); err != nil {
+        return nil, err
+    }
+
+    // WebSocket으로 보내는 메시지는 미들웨어를 거치지 않는다
+    if err := s.sanctions.CheckWrite(ctx, claims.UserID); err != nil {
+        return nil, err
+    }
+    if err := requireVerifiedEmail(ctx, s.userRepo, claims.UserID); err != nil {
+        return nil, err
+    }
+
+    if err := s.checkRate(ctx, claims.UserID); err != nil {
+        return nil, err
+    }
+
+    members, err := s.convRepo.FindMembers(ctx, conversationID)
+    if err != nil {
+        return nil, err
+    }
+
+    // 1:1 대화는 차단 관계면 보낼 수 없다 (그룹은 차단한 사람에게만 전달되지 않는다)
+    if len(members) == 2 {
+        for _, member := range members {
+            if member.UserID == claims.UserID {
+                continue
+            }
+            blocked, err := s.blockRepo.IsBlockedEither(ctx, claims.UserID, member.UserID)
+            if err != nil {
+                return nil, err
+            }
+            if blocked {
+                return nil, ErrBlocked
+            }
+        }
+    }
+
+    message := &domain.Message{
+        ConversationID: conversationID,
+        SenderID:       claims.UserID,
+        Content:        content,
+    }
+    if err := s.convRepo.CreateMessage(ctx, message); err != nil {
+        return nil, err
+    }
+
+    resp := dto.ToMessageResponse(message)
+    notif := notification.NewNotification(
+        notification.NotificationNewMessage,
+        "새 메시지",
+        s.preview(content),
+        resp,
+    )
+    payload := notif.JSON()
+    for _, member := range members {
+        // 보낸 사람의 다른 기기에도 전달한다 (차단/뮤트 필터는 hub에서 적용)
+        s.hub.SendToUser(member.UserID, claims.UserID, payload)
+    }
+
+    return resp, nil
+}
+
+func (s *messageService) checkRate(ctx context.Context, userID uint) error {
+    count, err := s.store.Incr(ctx, fmt.Sprintf("dm:rate:%d", userID), s.config.RateWindow)
+    if err != nil {
+        // 카운터 저장소 장애로 메시지 전송 전체를 막지는 않는다
+        middleware.LoggerFromRequestContext(ctx).Warn("메시지 전송 횟수 확인 실패", "user_id", userID, "error", err)
+        return nil
+    }
+    if count > s.config.RateLimit {
+        return ErrMessageRateLimited
+    }
+    return nil
+}
+
+func (s *messageService) preview(content string) string {
+    if utf8.RuneCountInString(content) <= s.config.PreviewLength {
+        return content
+    }
+    return string([]rune(content)[:s.config.PreviewLength]) + "…"
+}
+
+func (s *messageService) History(ctx context.Context, conversationID, beforeID uint, size int) ([]*dto.MessageResponse, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    if _, err := s.convRepo.FindMember(ctx, conversationID, claims.UserID); err != nil {
+        return nil, err
+    }
+
+    if size <= 0 {
+        size = s.config.DefaultPageSize
+    }
+    if size > s.config.MaxPageSize {
+        size = s.config.MaxPageSize
+    }
+
+    messages, err := s.convRepo.FindMessages(ctx, conversationID, claims.UserID, beforeID, size)
+    if err != nil {
+        return nil, err
+    }
+
+    result := make([]*dto.MessageResponse, 0, len(messages))
+    for _, message := range messages {
+        result = append(result, dto.ToMessageResponse(message))
+    }
+    return result, nil
+}
+
+func (s *messageService) MarkRead(ctx context.Context, conversationID, messageID uint) error {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return ErrUnauthorized
+    }
+
+    if _, err := s.convRepo.FindMember(ctx, conversationID, claims.UserID); err != nil {
+        return err
+    }
+
+    changed, err := s.convRepo.MarkRead(ctx, conversationID, claims.UserID, messageID)
+    if err != nil || !changed {
+        return err
+    }
+
+    members, err := s.convRepo.FindMembers(ctx, conversationID)
+    if err != nil {
+        return err
+    }
+
+    notif := notification.NewNotification(
+        notification.NotificationMessageRead,
+        "",
+        "",
+        dto.ReadReceipt{ConversationID: conversationID, UserID: claims.UserID, MessageID: messageID},
+    )
+    payload := notif.JSON()
+    for _, member := range members {
+        s.hub.SendToUser(member.UserID, claims.UserID, payload)
+    }
+    return nil
+}
+
+func (s *messageService) Unread(ctx context.Context) (*dto.UnreadResponse, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    counts, err := s.convRepo.CountUnread(ctx, claims.UserID)
+    if err != nil {
+        return nil, err
+    }
+
+    resp := &dto.UnreadResponse{ByConversation: counts}
+    for _, count := range counts {
+        resp.Total += count
+    }
+    return resp, nil
+}
+
+// RegisterMessageHandlers WebSocket으로 메시지 전송(dm_send)과 읽음 처리(dm_read) 등록
+func RegisterMessageHandlers(hub *ws.Hub, svc MessageService) {
+    hub.HandleFunc("dm_send", func(ctx context.Context, client *ws.Client, data json.RawMessage) (interface{}, error) {
+        var req dto.SendMessageRequest
+        if err := json.Unmarshal(data, &req); err != nil || req.ConversationID == 0 {
+            return nil, &ws.HandlerError{Code: "INVALID_REQUEST", Message: "잘못된 요청입니다"}
+        }
+
+        resp, err := svc.Send(clientContext(ctx, client), req.ConversationID, req.Content)
+        if err != nil {
+            return nil, toHandlerError(err)
+        }
+        return resp, nil
+    })
+
+    hub.HandleFunc("dm_read", func(ctx context.Context, client *ws.Client, data json.RawMessage) (interface{}, error) {
+        var req dto.MarkReadRequest
+        if err := json.Unmarshal(data, &req); err != nil || req.ConversationID == 0 || req.MessageID == 0 {
+            return nil, &ws.HandlerError{Code: "INVALID_REQUEST", Message: "잘못된 요청입니다"}
+        }
+
+        if err := svc.MarkRead(clientContext(ctx, client), req.ConversationID, req.MessageID); err != nil {
+            return nil, toHandlerError(err)
+        }
+        return nil, nil
+    })
+}
+
+// ChatWriteGuard 전체 채팅(chat)에도 메시지 전송과 같은 제재/이메일 인증 확인 적용 (hub.SetWriteGuard)
+func ChatWriteGuard(sanctions *SanctionStore, userRepo repository.UserRepository) ws.WriteGuard {
+    return func(ctx context.Context, userID uint) error {
+        if err := sanctions.CheckWrite(ctx, userID); err != nil {
+            return toHandlerError(err)
+        }
+        if err := requireVerifiedEmail(ctx, userRepo, userID); err != nil {
+            return toHandlerError(err)
+        }
+        return nil
+    }
+}
+
+func clientContext(ctx context.Context, client *ws.Client) context.Context {
+    return middleware.WithUser(ctx, &middleware.Claims{UserID: client.UserID, SessionID: client.SessionID})
+}
+
+// toHandlerError REST 응답과 같은 코드로 변환 (그 외 오류는 그대로 돌려 서버 오류로 처리)
+func toHandlerError(err error) error {
+    switch {
+    case errors.Is(err, repository.ErrConversationNotFound):
+        return &ws.HandlerError{Code: "CONVERSATION_NOT_FOUND", Message: "대화방을 찾을 수 없습니다"}
+    case errors.Is(err, ErrAccountBanned):
+        return &ws.HandlerError{Code: "ACCOUNT_BANNED", Message: "이용이 정지된 계정입니다"}
+    case errors.Is(err, ErrAccountSuspended):
+        return &ws.HandlerError{Code: "ACCOUNT_SUSPENDED", Message: "일시적으로 이용이 정지된 계정입니다"}
+    case errors.Is(err, ErrWriteRestricted):
+        return &ws.HandlerError{Code: "WRITE_RESTRICTED", Message: "글 작성이 제한된 계정입니다"}
+    case errors.Is(err, ErrEmailNotVerified):
+        return &ws.HandlerError{Code: "EMAIL_NOT_VERIFIED", Message: "이메일 인증 후 이용할 수 있습니다"}
+    case errors.Is(err, ErrBlocked):
+        return &ws.HandlerError{Code: "BLOCKED", Message: "차단 관계인 사용자에게는 메시지를 보낼 수 없습니다"}
+    case errors.Is(err, ErrMessageRateLimited):
+        return &ws.HandlerError{Code: "MESSAGE_RATE_LIMITED", Message: "메시지를 너무 자주 보내고 있습니다. 잠시 후 다시 시도해주세요"}
+    case errors.Is(err, ErrEmptyMessage):
+        return &ws.HandlerError{Code: "EMPTY_MESSAGE", Message: "메시지 내용을 입력해주세요"}
+    case errors.Is(err, ErrMessageTooLong):
+        return &ws.HandlerError{Code: "MESSAGE_TOO_LONG", Message: "메시지가 너무 깁니다"}
+    default:
+        return err
+    }
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/ws"
+)
+
+func TestDirectKey(t *testing.T) {
+    if directKey(3, 12) != "3:12" {
+        t.Fatalf("directKey(3, 12) = %q", directKey(3, 12))
+    }
+    if directKey(12, 3) != directKey(3, 12) {
+        t.Fatal("directKey는 참여자 순서와 무관해야 합니다")
+    }
+}
+
+func TestMessagePreview(t *testing.T) {
+    s := &messageService{config: DefaultMessageConfig()}
+    s.config.PreviewLength = 3
+
+    if got := s.preview("안녕"); got != "안녕" {
+        t.Fatalf("preview = %q", got)
+    }
+    if got := s.preview("안녕하세요"); got != "안녕하…" {
+        t.Fatalf("preview = %q", got)
+    }
+}
+
+func TestMessageRequiresVerifiedEmail(t *testing.T) {
+    verifiedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        1: {ID: 1},
+        2: {ID: 2, EmailVerifiedAt: &verifiedAt},
+    }}
+    s := &messageService{userRepo: users, config: DefaultMessageConfig()}
+    ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 1})
+
+    if _, err := s.CreateConversation(ctx, &dto.CreateConversationRequest{Usernames: []string{"friend"}}); !errors.Is(err, ErrEmailNotVerified) {
+        t.Errorf("CreateConversation() error = %v, want ErrEmailNotVerified", err)
+    }
+
+    // 전체 채팅도 같은 코드로 거부한다
+    guard := ChatWriteGuard(nil, users)
+    var handlerErr *ws.HandlerError
+    if err := guard(context.Background(), 1); !errors.As(err, &handlerErr) || handlerErr.Code != "EMAIL_NOT_VERIFIED" {
+        t.Errorf("ChatWriteGuard(unverified) error = %v, want EMAIL_NOT_VERIFIED", err)
+    }
+    if err := guard(context.Background(), 2); err != nil {
+        t.Errorf("ChatWriteGuard(verified) error = %v", err)
+    }
+}
+
+func (r *fakeUserBlockRepository) IsBlockedAny(ctx context.Context, userID uint, others []uint) (bool, error) {
+    for _, other := range others {
+        if blocked, _ := r.IsBlockedEither(ctx, userID, other); blocked {
+            return true, r.err
+        }
+    }
+    return false, r.err
+}
+
+func TestCreateGroupConversationBlockedMembers(t *testing.T) {
+    verifiedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        1: {ID: 1, Username: "creator", EmailVerifiedAt: &verifiedAt},
+        2: {ID: 2, Username: "alice"},
+        3: {ID: 3, Username: "bob"},
+    }}
+    // 만든 사람과는 관계가 없어도 참여자끼리 차단했으면 같은 방에 넣지 않는다
+    blocks := &fakeUserBlockRepository{blocks: map[uint]map[uint]bool{2: {3: true}}}
+    s := &messageService{userRepo: users, blockRepo: blocks, config: DefaultMessageConfig()}
+    ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 1})
+
+    _, err := s.CreateConversation(ctx, &dto.CreateConversationRequest{Title: "모임", Usernames: []string{"alice", "bob"}})
+    if !errors.Is(err, ErrBlocked) {
+        t.Errorf("CreateConversation() error = %v, want ErrBlocked", err)
+    }
+}
@@ -0,0 +1,317 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "log"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+
+    "gorm.io/gorm"
+)
+
+const (
+    moderationQueueDefaultSize = 20
+    moderationQueueMaxSize     = 100
+)
+
+// ModerationConfig 신고 처리 정책
+type ModerationConfig struct {
+    AutoHideThreshold int           // 열린 처리 건에 이만큼 신고가 쌓이면 대상을 자동으로 숨김 (0이면 끔)
+    ClaimTimeout      time.Duration // 맡은 뒤 이 시간 동안 처리하지 않으면 다른 모더레이터가 가져갈 수 있음
+}
+
+// DefaultModerationConfig 기본 정책
+func DefaultModerationConfig() ModerationConfig {
+    return ModerationConfig{
+        AutoHideThreshold: 5,
+        ClaimTimeout:      30 * time.Minute,
+    }
+}
+
+// ModerationService 게시글/댓글 신고와 모더레이션 큐
+// 큐는 대상 종류의 관리 권한(post:manage, comment:manage)이 있는 사용자만 다룰 수 있고,
+// 게시판 모더레이터는 자기 게시판의 건만 다룬다.
+type ModerationService interface {
+    // Report 신고 (같은 대상은 한 번만, 기준 이상 쌓이면 대상을 자동으로 숨김)
+    Report(ctx context.Context, req *dto.CreateReportRequest) (*dto.ReportResponse, error)
+    ListQueue(ctx context.Context, query *dto.ModerationQueueQuery) ([]*dto.ModerationCaseResponse, error)
+    // GetCase 처리 건과 신고 목록
+    GetCase(ctx context.Context, id uint) (*dto.ModerationCaseResponse, error)
+    // Claim 처리 건을 맡는다 (다른 모더레이터가 맡은 건은 ClaimTimeout이 지나야 가져올 수 있음)
+    Claim(ctx context.Context, id uint) (*dto.ModerationCaseResponse, error)
+    // Resolve 신고를 인정하고 대상을 숨긴 뒤 신고자에게 알림 (맡은 건만)
+    Resolve(ctx context.Context, id uint, req *dto.ModerationNoteRequest) error
+    // Dismiss 신고를 기각하고 자동 숨김을 해제한 뒤 신고자에게 알림 (맡은 건만)
+    Dismiss(ctx context.Context, id uint, req *dto.ModerationNoteRequest) error
+}
+
+type moderationService struct {
+    reportRepo      repository.ReportRepository
+    postRepo        repository.PostRepository
+    commentRepo     repository.CommentRepository
+    notificationSvc *NotificationService
+    config          ModerationConfig
+    now             func() time.Time
+}
+
+func NewModerationService(
+    reportRepo repository.ReportRepository,
+    postRepo repository.PostRepository,
+    commentRepo repository.CommentRepository,
+    notificationSvc *NotificationService,
+    config ModerationConfig,
+) ModerationService {
+    return &moderationService{
+        reportRepo:      reportRepo,
+        postRepo:        postRepo,
+        commentRepo:     commentRepo,
+        notificationSvc: notificationSvc,
+        config:          config,
+        now:             time.Now,
+    }
+}
+
+func (s *moderationService) Report(ctx context.Context, req *dto.CreateReportRequest) (*dto.ReportResponse, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    now := s.now()
+    c, err := s.loadTarget(ctx, domain.ReportTargetType(req.TargetType), req.TargetID)
+    if err != nil {
+        return nil, err
+    }
+    if c.AuthorID == claims.UserID {
+        return nil, ErrCannotReportOwnContent
+    }
+    c.Status = domain.ModerationOpen
+    c.Source = domain.ModerationSourceReport
+    c.OpenedAt = now
+
+    report := &domain.Report{
+        ReporterID: claims.UserID,
+        TargetType: c.TargetType,
+        TargetID:   c.TargetID,
+        Reason:     domain.ReportReason(req.Reason),
+        Detail:     req.Detail,
+        CreatedAt:  now,
+    }
+    c, err = s.reportRepo.CreateReport(ctx, report, c)
+    if err != nil {
+        return nil, err
+    }
+
+    s.autoHide(ctx, c)
+    return dto.ToReportResponse(report), nil
+}
+
+// loadTarget 신고 대상을 확인하고 처리 건의 대상 정보를 채운다
+func (s *moderationService) loadTarget(ctx context.Context, targetType domain.ReportTargetType, targetID uint) (*domain.ModerationCase, error) {
+    c := &domain.ModerationCase{TargetType: targetType, TargetID: targetID}
+
+    postID := targetID
+    if targetType == domain.ReportTargetComment {
+        comment, err := s.commentRepo.FindByID(ctx, targetID)
+        if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && comment.IsDeleted) {
+            return nil, ErrReportTargetNotFound
+        }
+        if err != nil {
+            return nil, err
+        }
+        c.AuthorID = comment.AuthorID
+        postID = comment.PostID
+    }
+
+    // 댓글도 게시판 범위를 알기 위해 게시글을 읽는다
+    post, err := s.postRepo.FindByID(ctx, postID)
+    if errors.Is(err, repository.ErrPostNotFound) || errors.Is(err, gorm.ErrRecordNotFound) {
+        return nil, ErrReportTargetNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+    if targetType == domain.ReportTargetPost {
+        c.AuthorID = post.AuthorID
+    }
+    c.BoardID = post.BoardID
+    return c, nil
+}
+
+// autoHide 신고가 기준 이상 쌓인 열린 처리 건의 대상을 숨긴다
+// 이미 다른 이유로 숨겨진 대상은 자동 숨김으로 표시하지 않는다 (기각해도 그대로 두도록).
+func (s *moderationService) autoHide(ctx context.Context, c *domain.ModerationCase) {
+    if s.config.AutoHideThreshold <= 0 || c.ReportCount < s.config.AutoHideThreshold || c.AutoHidden || c.Closed() {
+        return
+    }
+
+    hidden, err := s.reportRepo.HideTarget(ctx, c.TargetType, c.TargetID, s.now())
+    if err != nil {
+        log.Printf("신고 누적 자동 숨김 실패: case=%d - %v", c.ID, err)
+        return
+    }
+    if !hidden {
+        return
+    }
+    if err := s.reportRepo.MarkAutoHidden(ctx, c.ID); err != nil {
+        log.Printf("자동 숨김 표시 실패: case=%d - %v", c.ID, err)
+    }
+}
+
+func (s *moderationService) ListQueue(ctx context.Context, query *dto.ModerationQueueQuery) ([]*dto.ModerationCaseResponse, error) {
+    scope := domain.Scope{}
+    if query.BoardID > 0 {
+        scope = domain.BoardScope(query.BoardID)
+    }
+
+    // 관리 권한이 있는 대상 종류만 보여준다
+    requested := []domain.ReportTargetType{domain.ReportTargetPost, domain.ReportTargetComment}
+    if query.TargetType != "" {
+        requested = []domain.ReportTargetType{domain.ReportTargetType(query.TargetType)}
+    }
+    checker := auth.NewChecker()
+    var types []domain.ReportTargetType
+    for _, targetType := range requested {
+        if checker.HasPermission(ctx, targetType.ManagePermission(), scope) {
+            types = append(types, targetType)
+        }
+    }
+    if len(types) == 0 {
+        return nil, auth.RequirePermission(ctx, requested[0].ManagePermission(), scope)
+    }
+
+    statuses := []domain.ModerationStatus{domain.ModerationOpen, domain.ModerationClaimed}
+    if query.Status != "" {
+        statuses = []domain.ModerationStatus{domain.ModerationStatus(query.Status)}
+    }
+
+    size := query.Size
+    if size <= 0 {
+        size = moderationQueueDefaultSize
+    }
+    if size > moderationQueueMaxSize {
+        size = moderationQueueMaxSize
+    }
+
+    cases, err := s.reportRepo.FindCases(ctx, repository.ModerationQuery{
+        Statuses:    statuses,
+        Source:      domain.ModerationSource(query.Source),
+        TargetTypes: types,
+        BoardID:     query.BoardID,
+        BeforeID:    query.Before,
+        Limit:       size,
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    resp := make([]*dto.ModerationCaseResponse, 0, len(cases))
+    for _, c := range cases {
+        resp = append(resp, dto.ToModerationCaseResponse(c))
+    }
+    return resp, nil
+}
+
+// findCase 처리 건을 읽고 대상 종류의 관리 권한을 확인
+func (s *moderationService) findCase(ctx context.Context, id uint) (*domain.ModerationCase, error) {
+    c, err := s.reportRepo.FindCase(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+    if err := auth.RequirePermission(ctx, c.TargetType.ManagePermission(), c.Scope()); err != nil {
+        return nil, err
+    }
+    return c, nil
+}
+
+func (s *moderationService) GetCase(ctx context.Context, id uint) (*dto.ModerationCaseResponse, error) {
+    c, err := s.findCase(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+
+    reports, err := s.reportRepo.FindReports(ctx, c)
+    if err != nil {
+        return nil, err
+    }
+
+    resp := dto.ToModerationCaseResponse(c)
+    resp.Reports = make([]*dto.ReportResponse, 0, len(reports))
+    for _, report := range reports {
+        resp.Reports = append(resp.Reports, dto.ToReportResponse(report))
+    }
+    return resp, nil
+}
+
+func (s *moderationService) Claim(ctx context.Context, id uint) (*dto.ModerationCaseResponse, error) {
+    c, err := s.findCase(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+    claims, _ := middleware.GetUserFromContext(ctx)
+
+    now := s.now()
+    if err := s.reportRepo.Claim(ctx, c.ID, claims.UserID, now, now.Add(-s.config.ClaimTimeout)); err != nil {
+        return nil, err
+    }
+
+    c, err = s.reportRepo.FindCase(ctx, c.ID)
+    if err != nil {
+        return nil, err
+    }
+    return dto.ToModerationCaseResponse(c), nil
+}
+
+func (s *moderationService) Resolve(ctx context.Context, id uint, req *dto.ModerationNoteRequest) error {
+    return s.close(ctx, id, domain.ModerationResolved, req.Note)
+}
+
+func (s *moderationService) Dismiss(ctx context.Context, id uint, req *dto.ModerationNoteRequest) error {
+    return s.close(ctx, id, domain.ModerationDismissed, req.Note)
+}
+
+// close 맡은 처리 건을 닫고 대상 숨김 상태를 맞춘 뒤 신고자에게 결과를 알린다
+func (s *moderationService) close(ctx context.Context, id uint, status domain.ModerationStatus, note string) error {
+    c, err := s.findCase(ctx, id)
+    if err != nil {
+        return err
+    }
+    claims, _ := middleware.GetUserFromContext(ctx)
+
+    now := s.now()
+    if err := s.reportRepo.Close(ctx, c.ID, claims.UserID, status, note, now); err != nil {
+        return err
+    }
+    c.Status = status
+
+    switch {
+    case status == domain.ModerationResolved:
+        if _, err := s.reportRepo.HideTarget(ctx, c.TargetType, c.TargetID, now); err != nil {
+            return err
+        }
+    case c.AutoHidden:
+        if err := s.reportRepo.UnhideTarget(ctx, c.TargetType, c.TargetID); err != nil {
+            return err
+        }
+    }
+
+    reports, err := s.reportRepo.FindReports(ctx, c)
+    if err != nil {
+        log.Printf("신고자 조회 실패: case=%d - %v", c.ID, err)
+        return nil
+    }
+    reporterIDs := make([]uint, 0, len(reports))
+    for _, report := range reports {
+        reporterIDs = append(reporterIDs, report.ReporterID)
+    }
+    if err := s.notificationSvc.NotifyReportOutcome(ctx, reporterIDs, c); err != nil {
+        log.Printf("신고 처리 결과 알림 실패: case=%d - %v", c.ID, err)
+    }
+    return nil
+}
@@ -0,0 +1,318 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/notification"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/ws"
+
+    "gorm.io/driver/sqlite"
+    "gorm.io/gorm"
+)
+
+type fakeReportRepository struct {
+    repository.ReportRepository
+    c         *domain.ModerationCase
+    reporters map[uint]bool
+    hidden    bool
+}
+
+func (r *fakeReportRepository) CreateReport(ctx context.Context, report *domain.Report, c *domain.ModerationCase) (*domain.ModerationCase, error) {
+    if r.c == nil {
+        c.ID = 1
+        r.c = c
+    }
+    if r.reporters[report.ReporterID] {
+        return nil, repository.ErrReportExists
+    }
+    r.reporters[report.ReporterID] = true
+    r.c.ReportCount++
+
+    current := *r.c
+    return &current, nil
+}
+
+func (r *fakeReportRepository) HideTarget(ctx context.Context, targetType domain.ReportTargetType, targetID uint, now time.Time) (bool, error) {
+    if r.hidden {
+        return false, nil
+    }
+    r.hidden = true
+    return true, nil
+}
+
+func (r *fakeReportRepository) MarkAutoHidden(ctx context.Context, id uint) error {
+    r.c.AutoHidden = true
+    return nil
+}
+
+type fakePostRepository struct {
+    repository.PostRepository
+    post *domain.Post
+}
+
+func (r *fakePostRepository) FindByID(ctx context.Context, id uint) (*domain.Post, error) {
+    if r.post == nil || r.post.ID != id {
+        return nil, repository.ErrPostNotFound
+    }
+    return r.post, nil
+}
+
+func TestModerationReportAutoHide(t *testing.T) {
+    boardID := uint(3)
+    newService := func(reportRepo *fakeReportRepository) ModerationService {
+        return NewModerationService(
+            reportRepo,
+            &fakePostRepository{post: &domain.Post{ID: 10, AuthorID: 1, BoardID: &boardID}},
+            nil, nil,
+            ModerationConfig{AutoHideThreshold: 2, ClaimTimeout: time.Minute},
+        )
+    }
+    report := func(svc ModerationService, userID uint) error {
+        ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: userID, Role: string(domain.RoleUser)})
+        _, err := svc.Report(ctx, &dto.CreateReportRequest{TargetType: "post", TargetID: 10, Reason: "spam"})
+        return err
+    }
+
+    repo := &fakeReportRepository{reporters: map[uint]bool{}}
+    svc := newService(repo)
+
+    if err := report(svc, 1); !errors.Is(err, ErrCannotReportOwnContent) {
+        t.Fatalf("self report error = %v, want ErrCannotReportOwnContent", err)
+    }
+    if err := report(svc, 2); err != nil {
+        t.Fatalf("Report() error = %v", err)
+    }
+    if repo.hidden {
+        t.Fatal("target should stay visible below the threshold")
+    }
+    if err := report(svc, 2); !errors.Is(err, repository.ErrReportExists) {
+        t.Fatalf("duplicate report error = %v, want ErrReportExists", err)
+    }
+    if err := report(svc, 3); err != nil {
+        t.Fatalf("Report() error = %v", err)
+    }
+    if !repo.hidden || !repo.c.AutoHidden {
+        t.Fatal("target should be auto-hidden at the threshold")
+    }
+    if repo.c.BoardID == nil || *repo.c.BoardID != boardID {
+        t.Errorf("case board = %v, want %d", repo.c.BoardID, boardID)
+    }
+
+    // 이미 숨겨진 대상은 자동 숨김으로 표시하지 않는다 (기각해도 숨김 유지)
+    repo = &fakeReportRepository{reporters: map[uint]bool{}, hidden: true}
+    svc = newService(repo)
+    for _, userID := range []uint{2, 3} {
+        if err := report(svc, userID); err != nil {
+            t.Fatalf("Report() error = %v", err)
+        }
+    }
+    if repo.c.AutoHidden {
+        t.Error("already hidden target should not be marked auto-hidden")
+    }
+
+    _, err := svc.Report(context.Background(), &dto.CreateReportRequest{TargetType: "post", TargetID: 10, Reason: "spam"})
+    if !errors.Is(err, ErrUnauthorized) {
+        t.Errorf("unauthenticated report error = %v, want ErrUnauthorized", err)
+    }
+}
+
+// moderationFixture 게시판 3의 게시글들과 실제 신고 저장소(인메모리 DB)로 만든 모더레이션 서비스
+// 7, 8은 게시판 3 모더레이터, 9는 게시판 4 모더레이터다.
+type moderationFixture struct {
+    db     *gorm.DB
+    svc    ModerationService
+    notifs *fakeNotificationRepository
+    now    time.Time
+}
+
+func newModerationFixture(t *testing.T, posts ...*domain.Post) *moderationFixture {
+    t.Helper()
+    db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+    if err != nil {
+        t.Fatal(err)
+    }
+    if err := db.AutoMigrate(&domain.Post{}, &domain.Comment{}, &domain.ModerationCase{}, &domain.Report{}); err != nil {
+        t.Fatal(err)
+    }
+    postsByID := map[uint]*domain.Post{}
+    for _, post := range posts {
+        if err := db.Create(post).Error; err != nil {
+            t.Fatal(err)
+        }
+        postsByID[post.ID] = post
+    }
+
+    const moderator domain.Role = "moderator"
+    roles := &fakeRoleRepository{
+        grants: []*domain.RolePermission{{Role: moderator, Permission: domain.PermissionPostManage}},
+        assignments: []*domain.RoleAssignment{
+            {UserID: 7, Role: moderator, ScopeType: domain.ScopeBoard, ScopeID: 3},
+            {UserID: 8, Role: moderator, ScopeType: domain.ScopeBoard, ScopeID: 3},
+            {UserID: 9, Role: moderator, ScopeType: domain.ScopeBoard, ScopeID: 4},
+        },
+    }
+    domain.SetPermissionSource(NewPermissionStore(roles, &fakeTemporaryGrantRepository{}, cache.NewLocalInvalidator(), time.Hour))
+    t.Cleanup(func() { domain.SetPermissionSource(nil) })
+
+    f := &moderationFixture{
+        db:     db,
+        notifs: &fakeNotificationRepository{},
+        now:    time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+    }
+    svc := NewModerationService(
+        repository.NewReportRepository(db),
+        &fakePostsByID{posts: postsByID},
+        nil,
+        NewNotificationService(ws.NewHub(), f.notifs, nil, &fakeUserBlockRepository{}),
+        ModerationConfig{AutoHideThreshold: 2, ClaimTimeout: 30 * time.Minute},
+    )
+    svc.(*moderationService).now = func() time.Time { return f.now }
+    f.svc = svc
+    return f
+}
+
+type fakePostsByID struct {
+    repository.PostRepository
+    posts map[uint]*domain.Post
+}
+
+func (r *fakePostsByID) FindByID(ctx context.Context, id uint) (*domain.Post, error) {
+    post, ok := r.posts[id]
+    if !ok {
+        return nil, repository.ErrPostNotFound
+    }
+    return post, nil
+}
+
+func moderatorContext(userID uint) context.Context {
+    return middleware.WithUser(context.Background(), &middleware.Claims{UserID: userID, Role: string(domain.RoleUser)})
+}
+
+// report reporterIDs가 게시글을 신고하고 처리 건 ID를 돌려준다
+func (f *moderationFixture) report(t *testing.T, postID uint, reporterIDs ...uint) uint {
+    t.Helper()
+    for _, reporterID := range reporterIDs {
+        _, err := f.svc.Report(moderatorContext(reporterID), &dto.CreateReportRequest{TargetType: "post", TargetID: postID, Reason: "spam"})
+        if err != nil {
+            t.Fatalf("Report() error = %v", err)
+        }
+    }
+    var c domain.ModerationCase
+    if err := f.db.Where("target_type = ? AND target_id = ?", domain.ReportTargetPost, postID).First(&c).Error; err != nil {
+        t.Fatal(err)
+    }
+    return c.ID
+}
+
+func (f *moderationFixture) hidden(postID uint) bool {
+    var post domain.Post
+    f.db.First(&post, postID)
+    return post.HiddenAt != nil
+}
+
+func TestModerationClaim(t *testing.T) {
+    boardID := uint(3)
+    f := newModerationFixture(t, &domain.Post{ID: 10, Title: "글", Content: "본문", AuthorID: 1, BoardID: &boardID})
+    caseID := f.report(t, 10, 2)
+
+    // 다른 게시판의 모더레이터는 건을 볼 수도 맡을 수도 없다
+    if _, err := f.svc.GetCase(moderatorContext(9), caseID); !errors.Is(err, auth.ErrNoPermission) {
+        t.Errorf("GetCase(other board) error = %v, want ErrNoPermission", err)
+    }
+    if _, err := f.svc.Claim(moderatorContext(9), caseID); !errors.Is(err, auth.ErrNoPermission) {
+        t.Errorf("Claim(other board) error = %v, want ErrNoPermission", err)
+    }
+
+    resp, err := f.svc.Claim(moderatorContext(7), caseID)
+    if err != nil || resp.Status != domain.ModerationClaimed || resp.ClaimedBy == nil || *resp.ClaimedBy != 7 {
+        t.Fatalf("Claim() = %+v, %v, want claimed by 7", resp, err)
+    }
+
+    // 맡은 지 ClaimTimeout이 지나기 전에는 다른 모더레이터가 가져갈 수 없다
+    f.now = f.now.Add(29 * time.Minute)
+    if _, err := f.svc.Claim(moderatorContext(8), caseID); !errors.Is(err, repository.ErrModerationCaseUnavailable) {
+        t.Fatalf("Claim(fresh claim) error = %v, want ErrModerationCaseUnavailable", err)
+    }
+
+    // 처리하지 않고 시간이 지나면 가져간다
+    f.now = f.now.Add(2 * time.Minute)
+    resp, err = f.svc.Claim(moderatorContext(8), caseID)
+    if err != nil || resp.ClaimedBy == nil || *resp.ClaimedBy != 8 {
+        t.Fatalf("Claim(stale claim) = %+v, %v, want taken over by 8", resp, err)
+    }
+
+    // 넘겨준 모더레이터는 더 이상 닫을 수 없다
+    if err := f.svc.Resolve(moderatorContext(7), caseID, &dto.ModerationNoteRequest{}); !errors.Is(err, repository.ErrModerationCaseUnavailable) {
+        t.Errorf("Resolve(claimed by another) error = %v, want ErrModerationCaseUnavailable", err)
+    }
+    if err := f.svc.Dismiss(moderatorContext(9), caseID, &dto.ModerationNoteRequest{}); !errors.Is(err, auth.ErrNoPermission) {
+        t.Errorf("Dismiss(other board) error = %v, want ErrNoPermission", err)
+    }
+    if len(f.notifs.recipients) != 0 {
+        t.Errorf("notified %v before the case was closed", f.notifs.recipients)
+    }
+}
+
+func TestModerationClose(t *testing.T) {
+    boardID := uint(3)
+    hiddenAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+    f := newModerationFixture(t,
+        &domain.Post{ID: 10, Title: "자동 숨김", Content: "본문", AuthorID: 1, BoardID: &boardID},
+        &domain.Post{ID: 11, Title: "이미 숨김", Content: "본문", AuthorID: 1, BoardID: &boardID, HiddenAt: &hiddenAt},
+        &domain.Post{ID: 12, Title: "인정", Content: "본문", AuthorID: 1, BoardID: &boardID},
+    )
+    ctx := moderatorContext(7)
+    closeCase := func(caseID uint, close func(context.Context, uint, *dto.ModerationNoteRequest) error) {
+        t.Helper()
+        if _, err := f.svc.Claim(ctx, caseID); err != nil {
+            t.Fatalf("Claim() error = %v", err)
+        }
+        if err := close(ctx, caseID, &dto.ModerationNoteRequest{Note: "검토"}); err != nil {
+            t.Fatalf("close error = %v", err)
+        }
+    }
+
+    // 신고 누적으로 자동 숨김된 대상은 기각하면 다시 보인다
+    autoHidden := f.report(t, 10, 2, 3)
+    if !f.hidden(10) {
+        t.Fatal("post 10 should be auto-hidden at the threshold")
+    }
+    closeCase(autoHidden, f.svc.Dismiss)
+    if f.hidden(10) {
+        t.Error("dismissing an auto-hidden case should unhide the target")
+    }
+    if len(f.notifs.recipients) != 2 || f.notifs.types[0] != notification.NotificationReportDismissed {
+        t.Errorf("notified %v %v, want both reporters told it was dismissed", f.notifs.recipients, f.notifs.types)
+    }
+
+    // 다른 이유로 숨겨져 있던 대상은 기각해도 그대로 둔다
+    f.notifs.recipients, f.notifs.types = nil, nil
+    closeCase(f.report(t, 11, 4, 5), f.svc.Dismiss)
+    if !f.hidden(11) {
+        t.Error("dismissing should not unhide a target that was not auto-hidden")
+    }
+
+    // 인정하면 숨기고 신고자에게만 알린다 (작성자와 모더레이터는 받지 않음)
+    f.notifs.recipients, f.notifs.types = nil, nil
+    closeCase(f.report(t, 12, 2), f.svc.Resolve)
+    if !f.hidden(12) {
+        t.Error("resolving should hide the target")
+    }
+    if len(f.notifs.recipients) != 1 || f.notifs.recipients[0] != 2 || f.notifs.types[0] != notification.NotificationReportResolved {
+        t.Errorf("notified %v %v, want only reporter 2 told it was resolved", f.notifs.recipients, f.notifs.types)
+    }
+
+    // 닫힌 건은 다시 맡을 수 없다
+    if _, err := f.svc.Claim(ctx, autoHidden); !errors.Is(err, repository.ErrModerationCaseUnavailable) {
+        t.Errorf("Claim(closed) error = %v, want ErrModerationCaseUnavailable", err)
+    }
+}
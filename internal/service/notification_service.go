@@ -2,20 +2,34 @@ package service
 
 import (
     "context"
+    "log"
 
     "yourproject/internal/notification"
+    "yourproject/internal/util"
     "yourproject/internal/ws"
 )
 
+// 댓글 하나에서 보내는 멘션 알림 최대 수 (대량 멘션 스팸 방지)
+const maxMentionNotifications = 10
+
 type NotificationService struct {
-    hub        *ws.Hub
-    notifRepo  repository.NotificationRepository
+    hub       *ws.Hub
+    notifRepo repository.NotificationRepository
+    userRepo  repository.UserRepository
+    blockRepo repository.UserBlockRepository
 }
 
-func NewNotificationService(hub *ws.Hub, repo repository.NotificationRepository) *NotificationService {
+func NewNotificationService(
+    hub *ws.Hub,
+    repo repository.NotificationRepository,
+    userRepo repository.UserRepository,
+    blockRepo repository.UserBlockRepository,
+) *NotificationService {
     return &NotificationService{
         hub:       hub,
         notifRepo: repo,
+        userRepo:  userRepo,
+        blockRepo: blockRepo,
     }
 }
 
@@ -30,15 +44,7 @@ func (s *NotificationService) NotifyNewComment(ctx context.Context, postAuthorID
         },
     )
 
-    // DB에 저장
-    if err := s.notifRepo.Create(ctx, postAuthorID, notif); err != nil {
-        return err
-    }
-
-    // 실시간 전송
-    s.hub.SendToUser(postAuthorID, notif.JSON())
-
-    return nil
+    return s.deliver(ctx, postAuthorID, comment.AuthorID, notif)
 }
 
 func (s *NotificationService) NotifyNewLike(ctx context.Context, postAuthorID uint, likedBy *domain.User) error {
@@ -49,11 +55,175 @@ func (s *NotificationService) NotifyNewLike(ctx context.Context, postAuthorID ui
         nil,
     )
 
-    if err := s.notifRepo.Create(ctx, postAuthorID, notif); err != nil {
+    return s.deliver(ctx, postAuthorID, likedBy.ID, notif)
+}
+
+func (s *NotificationService) NotifyNewFollower(ctx context.Context, followeeID uint, follower *domain.User) error {
+    notif := notification.NewNotification(
+        notification.NotificationNewFollower,
+        "새 팔로워",
+        fmt.Sprintf("%s님이 회원님을 팔로우하기 시작했습니다.", follower.Username),
+        map[string]interface{}{
+            "user_id":  follower.ID,
+            "username": follower.Username,
+        },
+    )
+
+    return s.deliver(ctx, followeeID, follower.ID, notif)
+}
+
+func (s *NotificationService) CreateCommentNotification(ctx context.Context, post *domain.Post, comment *domain.Comment, actorID uint) error {
+    notif := notification.NewNotification(
+        notification.NotificationNewComment,
+        "새 댓글",
+        "회원님의 게시글에 새 댓글이 달렸습니다.",
+        map[string]interface{}{
+            "post_id":    post.ID,
+            "comment_id": comment.ID,
+        },
+    )
+
+    return s.deliver(ctx, post.AuthorID, actorID, notif)
+}
+
+func (s *NotificationService) CreateReplyNotification(ctx context.Context, parent *domain.Comment, comment *domain.Comment, actorID uint) error {
+    notif := notification.NewNotification(
+        notification.NotificationNewComment,
+        "새 답글",
+        "회원님의 댓글에 답글이 달렸습니다.",
+        map[string]interface{}{
+            "post_id":    comment.PostID,
+            "comment_id": comment.ID,
+            "parent_id":  parent.ID,
+        },
+    )
+
+    return s.deliver(ctx, parent.AuthorID, actorID, notif)
+}
+
+// CreateMentionNotifications 본문에서 멘션된 사용자에게 알림
+// 없는 사용자, 자기 자신, 차단/뮤트 관계인 사용자는 건너뛴다.
+func (s *NotificationService) CreateMentionNotifications(ctx context.Context, content string, postID, commentID, actorID uint) error {
+    usernames := util.ParseMentions(content)
+    if len(usernames) > maxMentionNotifications {
+        usernames = usernames[:maxMentionNotifications]
+    }
+
+    for _, username := range usernames {
+        user, err := s.userRepo.FindByUsername(ctx, username)
+        if err != nil {
+            continue
+        }
+
+        notif := notification.NewNotification(
+            notification.NotificationMention,
+            "멘션",
+            "댓글에서 회원님을 언급했습니다.",
+            map[string]interface{}{
+                "post_id":    postID,
+                "comment_id": commentID,
+            },
+        )
+
+        // 한 명에게 실패해도 나머지 멘션은 계속 보낸다
+        if err := s.deliver(ctx, user.ID, actorID, notif); err != nil {
+            log.Printf("멘션 알림 전달 실패: user=%d - %v", user.ID, err)
+        }
+    }
+
+    return nil
+}
+
+// NotifyTemporaryGrant 기간 한정 역할/권한을 받은 사용자에게 기간과 사유 안내
+func (s *NotificationService) NotifyTemporaryGrant(ctx context.Context, grant *domain.TemporaryGrant) error {
+    notif := notification.NewNotification(
+        notification.NotificationRoleGranted,
+        "임시 권한 부여",
+        fmt.Sprintf("%s 권한이 %s까지 부여되었습니다. (사유: %s)",
+            temporaryGrantLabel(grant), grant.EndsAt.Format("2006-01-02 15:04"), grant.Reason),
+        temporaryGrantData(grant),
+    )
+
+    return s.deliver(ctx, grant.UserID, grant.GrantedBy, notif)
+}
+
+// NotifyTemporaryGrantExpired 기간이 끝났거나 회수된 임시 권한 안내
+func (s *NotificationService) NotifyTemporaryGrantExpired(ctx context.Context, grant *domain.TemporaryGrant) error {
+    notif := notification.NewNotification(
+        notification.NotificationRoleExpired,
+        "임시 권한 만료",
+        fmt.Sprintf("%s 권한이 만료되었습니다.", temporaryGrantLabel(grant)),
+        temporaryGrantData(grant),
+    )
+
+    return s.deliver(ctx, grant.UserID, 0, notif)
+}
+
+// temporaryGrantLabel 알림에 표시할 부여 대상 (예: "게시판 3의 moderator 역할")
+func temporaryGrantLabel(grant *domain.TemporaryGrant) string {
+    label := string(grant.Permission)
+    if grant.Role != "" {
+        label = string(grant.Role) + " 역할"
+    }
+    if scope := grant.Scope(); scope.Type == domain.ScopeBoard {
+        label = fmt.Sprintf("게시판 %d의 %s", scope.ID, label)
+    }
+    return label
+}
+
+func temporaryGrantData(grant *domain.TemporaryGrant) map[string]interface{} {
+    return map[string]interface{}{
+        "grant_id":   grant.ID,
+        "role":       grant.Role,
+        "permission": grant.Permission,
+        "scope":      grant.Scope().String(),
+        "starts_at":  grant.StartsAt,
+        "ends_at":    grant.EndsAt,
+    }
+}
+
+// NotifyReportOutcome 신고자들에게 처리 결과 안내 (모더레이터는 드러내지 않음)
+func (s *NotificationService) NotifyReportOutcome(ctx context.Context, reporterIDs []uint, c *domain.ModerationCase) error {
+    notifType := notification.NotificationReportResolved
+    body := "신고하신 콘텐츠가 운영 정책에 따라 숨김 처리되었습니다."
+    if c.Status == domain.ModerationDismissed {
+        notifType = notification.NotificationReportDismissed
+        body = "신고하신 콘텐츠를 검토했으나 운영 정책 위반이 확인되지 않았습니다."
+    }
+
+    for _, reporterID := range reporterIDs {
+        notif := notification.NewNotification(notifType, "신고 처리 결과", body, map[string]interface{}{
+            "case_id":     c.ID,
+            "target_type": c.TargetType,
+            "target_id":   c.TargetID,
+        })
+        if err := s.deliver(ctx, reporterID, 0, notif); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// deliver 알림 저장 후 실시간 전송
+// 자기 자신이 일으킨 알림과, 수신자가 actor를 차단/뮤트했거나 actor가 수신자를 차단한 경우에는 보내지 않는다.
+func (s *NotificationService) deliver(ctx context.Context, recipientID, actorID uint, notif *notification.Notification) error {
+    if recipientID == actorID {
+        return nil
+    }
+
+    hidden, err := s.blockRepo.Hides(ctx, recipientID, actorID)
+    if err != nil {
+        return err
+    }
+    if hidden {
+        return nil
+    }
+
+    if err := s.notifRepo.Create(ctx, recipientID, notif); err != nil {
         return err
     }
 
-    s.hub.SendToUser(postAuthorID, notif.JSON())
+    s.hub.SendToUser(recipientID, actorID, notif.JSON())
 
     return nil
 }
@@ -0,0 +1,66 @@
+package service
+
+import (
+    "context"
+
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/validator"
+)
+
+// BreachMode 유출 비밀번호 처리 방식
+type BreachMode string
+
+const (
+    BreachModeOff   BreachMode = "off"   // 검사하지 않음
+    BreachModeWarn  BreachMode = "warn"  // 허용하되 경고를 응답에 포함
+    BreachModeBlock BreachMode = "block" // 거부
+)
+
+// BreachedPasswordWarning 경고 모드 응답 문구
+const BreachedPasswordWarning = "이 비밀번호는 유출된 비밀번호 목록에 포함되어 있습니다. 다른 비밀번호로 변경하는 것을 권장합니다"
+
+// BreachChecker 유출 비밀번호 조회 (breach.Checker가 구현)
+type BreachChecker interface {
+    Contains(password string) (bool, error)
+}
+
+// PasswordPolicy 새 비밀번호 검사 (가입, 재설정, 변경에서 공통 사용)
+type PasswordPolicy struct {
+    checker BreachChecker
+    mode    BreachMode
+}
+
+// NewPasswordPolicy checker가 nil이면 유출 검사는 off로 동작한다
+func NewPasswordPolicy(checker BreachChecker, mode BreachMode) *PasswordPolicy {
+    if checker == nil {
+        mode = BreachModeOff
+    }
+    return &PasswordPolicy{checker: checker, mode: mode}
+}
+
+// Validate 형식 위반은 ErrWeakPassword, block 모드의 유출 비밀번호는 ErrBreachedPassword
+// warn 모드에서는 통과시키고 응답에 실을 경고를 반환한다.
+func (p *PasswordPolicy) Validate(ctx context.Context, password string) ([]string, error) {
+    if !validator.IsValidPassword(password) {
+        return nil, ErrWeakPassword
+    }
+    if p.mode == BreachModeOff {
+        return nil, nil
+    }
+
+    breached, err := p.checker.Contains(password)
+    if err != nil {
+        // 조회 파일 문제로 가입/변경이 막히지 않도록 통과시킨다
+        middleware.LoggerFromRequestContext(ctx).Error("유출 비밀번호 조회 실패", "error", err)
+        return nil, nil
+    }
+    if !breached {
+        return nil, nil
+    }
+
+    if p.mode == BreachModeBlock {
+        return nil, ErrBreachedPassword
+    }
+    middleware.LoggerFromRequestContext(ctx).Info("유출 비밀번호 사용 허용 (warn 모드)")
+    return []string{BreachedPasswordWarning}, nil
+}
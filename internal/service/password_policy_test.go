@@ -0,0 +1,51 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+)
+
+type fakeBreachChecker map[string]bool
+
+func (f fakeBreachChecker) Contains(password string) (bool, error) {
+    return f[password], nil
+}
+
+type failingBreachChecker struct{}
+
+func (failingBreachChecker) Contains(string) (bool, error) {
+    return false, errors.New("read error")
+}
+
+func TestPasswordPolicyValidate(t *testing.T) {
+    breached := fakeBreachChecker{"Passw0rd!": true}
+
+    tests := []struct {
+        name         string
+        policy       *PasswordPolicy
+        password     string
+        wantErr      error
+        wantWarnings int
+    }{
+        {"형식 위반", NewPasswordPolicy(breached, BreachModeBlock), "short", ErrWeakPassword, 0},
+        {"off 모드", NewPasswordPolicy(breached, BreachModeOff), "Passw0rd!", nil, 0},
+        {"block 모드 유출", NewPasswordPolicy(breached, BreachModeBlock), "Passw0rd!", ErrBreachedPassword, 0},
+        {"block 모드 안전", NewPasswordPolicy(breached, BreachModeBlock), "Corr3ct-Horse", nil, 0},
+        {"warn 모드 유출", NewPasswordPolicy(breached, BreachModeWarn), "Passw0rd!", nil, 1},
+        {"checker 없음", NewPasswordPolicy(nil, BreachModeBlock), "Passw0rd!", nil, 0},
+        {"조회 실패는 통과", NewPasswordPolicy(failingBreachChecker{}, BreachModeBlock), "Passw0rd!", nil, 0},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            warnings, err := tt.policy.Validate(context.Background(), tt.password)
+            if !errors.Is(err, tt.wantErr) {
+                t.Fatalf("Validate() error = %v, want %v", err, tt.wantErr)
+            }
+            if len(warnings) != tt.wantWarnings {
+                t.Errorf("Validate() warnings = %v, want %d", warnings, tt.wantWarnings)
+            }
+        })
+    }
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "log"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+
+    "gorm.io/gorm"
+)
+
+// PasswordResetConfig 비밀번호 재설정 정책
+type PasswordResetConfig struct {
+    ResetURL        string        // 재설정 링크 기본 주소 (예: https://board.example.com/reset-password)
+    TokenTTL        time.Duration // 토큰 유효 기간 (메일 템플릿에 1시간으로 안내됨)
+    RequestCooldown time.Duration // 같은 계정에 대한 재요청 최소 간격
+}
+
+// DefaultPasswordResetConfig 기본 정책
+func DefaultPasswordResetConfig(resetURL string) PasswordResetConfig {
+    return PasswordResetConfig{
+        ResetURL:        resetURL,
+        TokenTTL:        time.Hour,
+        RequestCooldown: time.Minute,
+    }
+}
+
+type PasswordResetService interface {
+    // RequestReset 재설정 메일 발송
+    // 이메일 존재 여부를 노출하지 않도록 가입되지 않은 주소여도 nil을 반환한다.
+    RequestReset(ctx context.Context, email string) error
+    // ResetPassword 토큰을 확인하고 비밀번호를 변경한 뒤 모든 세션을 폐기한다
+    // 유출 비밀번호 warn 모드에서는 경고 문구를 함께 반환한다
+    ResetPassword(ctx context.Context, rawToken, newPassword string) ([]string, error)
+}
+
+type passwordResetService struct {
+    db             *gorm.DB
+    resetRepo      repository.PasswordResetRepository
+    userRepo       repository.UserRepository
+    passwordHasher auth.PasswordHasher
+    passwordPolicy *PasswordPolicy
+    emailService   *EmailService
+    terminator     SessionTerminator
+    config         PasswordResetConfig
+    now            func() time.Time
+}
+
+func NewPasswordResetService(
+    db *gorm.DB,
+    resetRepo repository.PasswordResetRepository,
+    userRepo repository.UserRepository,
+    passwordHasher auth.PasswordHasher,
+    passwordPolicy *PasswordPolicy,
+    emailService *EmailService,
+    terminator SessionTerminator,
+    config PasswordResetConfig,
+) PasswordResetService {
+    return &passwordResetService{
+        db:             db,
+        resetRepo:      resetRepo,
+        userRepo:       userRepo,
+        passwordHasher: passwordHasher,
+        passwordPolicy: passwordPolicy,
+        emailService:   emailService,
+        terminator:     terminator,
+        config:         config,
+        now:            time.Now,
+    }
+}
+
+func (s *passwordResetService) RequestReset(ctx context.Context, email string) error {
+    user, err := s.userRepo.FindByEmail(ctx, email)
+    if err != nil {
+        if errors.Is(err, repository.ErrUserNotFound) {
+            return nil
+        }
+        return err
+    }
+
+    now := s.now()
+
+    // 짧은 시간 내 반복 요청은 조용히 무시 (응답은 동일)
+    latest, err := s.resetRepo.FindLatestByUserID(ctx, user.ID)
+    if err != nil && !errors.Is(err, repository.ErrPasswordResetNotFound) {
+        return err
+    }
+    if latest != nil && now.Sub(latest.CreatedAt) < s.config.RequestCooldown {
+        return nil
+    }
+
+    // 이전 링크는 더 이상 쓸 수 없도록 폐기
+    if err := s.resetRepo.InvalidateByUserID(ctx, user.ID, now); err != nil {
+        return err
+    }
+
+    raw, hash, err := auth.GenerateToken()
+    if err != nil {
+        return err
+    }
+
+    reset := &domain.PasswordReset{
+        UserID:    user.ID,
+        TokenHash: hash,
+        ExpiresAt: now.Add(s.config.TokenTTL),
+    }
+    if err := s.resetRepo.Create(ctx, reset); err != nil {
+        return err
+    }
+
+    link := s.config.ResetURL + "?token=" + raw
+    if err := s.emailService.SendPasswordReset(ctx, user.Email, user.Username, link); err != nil {
+        // 발송 실패 여부도 응답으로 드러내지 않는다
+        log.Printf("비밀번호 재설정 메일 발송 실패: user=%d - %v", user.ID, err)
+    }
+
+    return nil
+}
+
+func (s *passwordResetService) ResetPassword(ctx context.Context, rawToken, newPassword string) ([]string, error) {
+    warnings, err := s.passwordPolicy.Validate(ctx, newPassword)
+    if err != nil {
+        return nil, err
+    }
+
+    reset, err := s.resetRepo.FindByTokenHash(ctx, auth.HashToken(rawToken))
+    if err != nil {
+        if errors.Is(err, repository.ErrPasswordResetNotFound) {
+            return nil, ErrInvalidResetToken
+        }
+        return nil, err
+    }
+
+    now := s.now()
+    if reset.IsUsed() {
+        return nil, ErrInvalidResetToken
+    }
+    if reset.IsExpired(now) {
+        return nil, ErrResetTokenExpired
+    }
+
+    hashed, err := s.passwordHasher.Hash(newPassword)
+    if err != nil {
+        return nil, err
+    }
+
+    var revoked []uint
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        // 동시 요청 시 한 번만 성공하도록 조건부 업데이트
+        if err := repository.NewPasswordResetRepository(tx).MarkUsed(ctx, reset.ID, now); err != nil {
+            if errors.Is(err, repository.ErrPasswordResetNotFound) {
+                return ErrInvalidResetToken
+            }
+            return err
+        }
+
+        if err := repository.NewUserRepository(tx).UpdatePassword(ctx, reset.UserID, hashed, now); err != nil {
+            return err
+        }
+
+        // 기존 로그인 세션 모두 종료
+        revoked, err = revokeUserSessions(ctx, tx, reset.UserID, 0, now)
+        if err != nil {
+            return err
+        }
+        return repository.NewRefreshTokenRepository(tx).RevokeAllByUserID(ctx, reset.UserID, now)
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    s.terminator.CloseSessions(revoked...)
+    return warnings, nil
+}
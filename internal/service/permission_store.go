@@ -0,0 +1,178 @@
+package service
+
+import (
+    "context"
+    "log"
+    "sort"
+    "time"
+
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+)
+
+// permissionInvalidationChannel 권한 부여가 바뀌면 모든 인스턴스에 알리는 채널
+const permissionInvalidationChannel = "rbac:invalidate"
+
+// rolePermissionSet 역할별 권한 집합
+type rolePermissionSet map[domain.Role]map[domain.Permission]struct{}
+
+// scopedRoles 사용자별 범위 한정 역할
+type scopedRoles map[uint][]*domain.RoleAssignment
+
+// temporaryGrants 사용자별로 아직 끝나지 않은 임시 부여
+type temporaryGrants map[uint][]*domain.TemporaryGrant
+
+// PermissionStore DB에 저장된 역할 권한 조회 (domain.PermissionSource)
+//
+// 부여 관계와 범위 한정 역할, 임시 부여 전체를 한 번에 읽어 cache.SnapshotGroup에 두고, 변경 시 Invalidate로
+// 모든 인스턴스의 캐시를 비운다. 알림이 유실되어도 ttl이 지나면 다시 읽는다.
+// 임시 부여는 확인할 때마다 기간을 비교하므로 만료되면 캐시를 다시 읽지 않아도 바로 적용되지 않는다.
+type PermissionStore struct {
+    roleRepo    repository.RoleRepository
+    grantRepo   repository.TemporaryGrantRepository
+    snapshots   *cache.SnapshotGroup
+    snapshot    *cache.Snapshot[rolePermissionSet]
+    assignments *cache.Snapshot[scopedRoles]
+    temporary   *cache.Snapshot[temporaryGrants]
+    now         func() time.Time
+}
+
+func NewPermissionStore(
+    roleRepo repository.RoleRepository,
+    grantRepo repository.TemporaryGrantRepository,
+    invalidator cache.Invalidator,
+    ttl time.Duration,
+) *PermissionStore {
+    s := &PermissionStore{
+        roleRepo:  roleRepo,
+        grantRepo: grantRepo,
+        snapshots: cache.NewSnapshotGroup(invalidator, permissionInvalidationChannel),
+        now:       time.Now,
+    }
+    s.snapshot = cache.NewGroupSnapshot(s.snapshots, ttl, s.load)
+    s.assignments = cache.NewGroupSnapshot(s.snapshots, ttl, s.loadAssignments)
+    s.temporary = cache.NewGroupSnapshot(s.snapshots, ttl, s.loadTemporaryGrants)
+    return s
+}
+
+// Seed 처음 실행이면 domain.RolePermissions로 역할과 권한을 채운다
+func (s *PermissionStore) Seed(ctx context.Context) error {
+    return s.roleRepo.Seed(ctx, domain.RolePermissions)
+}
+
+// Listen 다른 인스턴스의 변경 알림 구독 (ctx가 끝날 때까지)
+func (s *PermissionStore) Listen(ctx context.Context) {
+    s.snapshots.Listen(ctx)
+}
+
+// Invalidate 현재 인스턴스 캐시를 비우고 다른 인스턴스에 알림
+func (s *PermissionStore) Invalidate(ctx context.Context) {
+    s.snapshots.Invalidate(ctx)
+}
+
+// RoleHasPermission 처음 읽기부터 실패하면 모두 거부한다
+func (s *PermissionStore) RoleHasPermission(role domain.Role, permission domain.Permission) bool {
+    set, err := s.snapshot.Get(context.Background())
+    if err != nil {
+        log.Printf("권한 조회 실패: %v", err)
+        return false
+    }
+    _, ok := set[role][permission]
+    return ok
+}
+
+// UserHasPermission 지금 적용 중인 전역 임시 부여로 권한을 가지면 true
+func (s *PermissionStore) UserHasPermission(userID uint, permission domain.Permission) bool {
+    return s.hasTemporaryGrant(context.Background(), userID, permission, domain.Scope{})
+}
+
+// UserHasPermissionIn 사용자가 scope에 부여받은 역할이나 임시 부여 중 하나라도 권한을 가지면 true
+func (s *PermissionStore) UserHasPermissionIn(ctx context.Context, userID uint, permission domain.Permission, scope domain.Scope) bool {
+    assignments, err := s.assignments.Get(ctx)
+    if err != nil {
+        log.Printf("범위 역할 조회 실패: %v", err)
+        return false
+    }
+
+    for _, assignment := range assignments[userID] {
+        if assignment.Scope() == scope && s.RoleHasPermission(assignment.Role, permission) {
+            return true
+        }
+    }
+    return s.hasTemporaryGrant(ctx, userID, permission, scope)
+}
+
+// hasTemporaryGrant scope가 같은 임시 부여 중 지금 기간 안에 있는 것으로 권한을 가지는지
+func (s *PermissionStore) hasTemporaryGrant(ctx context.Context, userID uint, permission domain.Permission, scope domain.Scope) bool {
+    grants, err := s.temporary.Get(ctx)
+    if err != nil {
+        log.Printf("임시 부여 조회 실패: %v", err)
+        return false
+    }
+
+    now := s.now()
+    for _, grant := range grants[userID] {
+        if grant.Scope() == scope && grant.ActiveAt(now) && grant.Grants(permission, s.RoleHasPermission) {
+            return true
+        }
+    }
+    return false
+}
+
+// Permissions 역할에 부여된 권한 (이름 순)
+func (s *PermissionStore) Permissions(ctx context.Context, role domain.Role) ([]domain.Permission, error) {
+    set, err := s.snapshot.Get(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    permissions := make([]domain.Permission, 0, len(set[role]))
+    for p := range set[role] {
+        permissions = append(permissions, p)
+    }
+    sort.Slice(permissions, func(i, j int) bool { return permissions[i] < permissions[j] })
+    return permissions, nil
+}
+
+func (s *PermissionStore) load(ctx context.Context) (rolePermissionSet, error) {
+    grants, err := s.roleRepo.ListGrants(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    set := make(rolePermissionSet)
+    for _, grant := range grants {
+        if set[grant.Role] == nil {
+            set[grant.Role] = make(map[domain.Permission]struct{})
+        }
+        set[grant.Role][grant.Permission] = struct{}{}
+    }
+    return set, nil
+}
+
+func (s *PermissionStore) loadAssignments(ctx context.Context) (scopedRoles, error) {
+    assignments, err := s.roleRepo.ListAssignments(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    roles := make(scopedRoles)
+    for _, assignment := range assignments {
+        roles[assignment.UserID] = append(roles[assignment.UserID], assignment)
+    }
+    return roles, nil
+}
+
+func (s *PermissionStore) loadTemporaryGrants(ctx context.Context) (temporaryGrants, error) {
+    current, err := s.grantRepo.ListCurrent(ctx, s.now())
+    if err != nil {
+        return nil, err
+    }
+
+    grants := make(temporaryGrants)
+    for _, grant := range current {
+        grants[grant.UserID] = append(grants[grant.UserID], grant)
+    }
+    return grants, nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+)
+
+type fakeRoleRepository struct {
+    repository.RoleRepository
+    grants      []*domain.RolePermission
+    assignments []*domain.RoleAssignment
+}
+
+func (r *fakeRoleRepository) ListGrants(ctx context.Context) ([]*domain.RolePermission, error) {
+    return r.grants, nil
+}
+
+func (r *fakeRoleRepository) ListAssignments(ctx context.Context) ([]*domain.RoleAssignment, error) {
+    return r.assignments, nil
+}
+
+type fakeTemporaryGrantRepository struct {
+    repository.TemporaryGrantRepository
+    grants []*domain.TemporaryGrant
+}
+
+func (r *fakeTemporaryGrantRepository) ListCurrent(ctx context.Context, now time.Time) ([]*domain.TemporaryGrant, error) {
+    return r.grants, nil
+}
+
+func TestPermissionStoreInvalidatesAcrossInstances(t *testing.T) {
+    ctx := context.Background()
+    repo := &fakeRoleRepository{grants: []*domain.RolePermission{
+        {Role: domain.RoleUser, Permission: domain.PermissionPostRead},
+    }}
+    invalidator := cache.NewLocalInvalidator()
+
+    a := NewPermissionStore(repo, &fakeTemporaryGrantRepository{}, invalidator, time.Hour)
+    b := NewPermissionStore(repo, &fakeTemporaryGrantRepository{}, invalidator, time.Hour)
+    a.Listen(ctx)
+    b.Listen(ctx)
+
+    if !b.RoleHasPermission(domain.RoleUser, domain.PermissionPostRead) {
+        t.Fatal("user should have post:read")
+    }
+    if b.RoleHasPermission(domain.RoleUser, domain.PermissionPostManage) {
+        t.Fatal("user should not have post:manage yet")
+    }
+
+    repo.grants = append(repo.grants, &domain.RolePermission{Role: domain.RoleUser, Permission: domain.PermissionPostManage})
+    a.Invalidate(ctx)
+
+    if !b.RoleHasPermission(domain.RoleUser, domain.PermissionPostManage) {
+        t.Fatal("grant should be visible on the other instance after invalidation")
+    }
+
+    permissions, err := b.Permissions(ctx, domain.RoleUser)
+    if err != nil {
+        t.Fatalf("Permissions() error = %v", err)
+    }
+    if len(permissions) != 2 || permissions[0] != domain.PermissionPostManage {
+        t.Fatalf("Permissions() = %v, want sorted [post:manage post:read]", permissions)
+    }
+}
+
+func TestPermissionStoreScopedAssignment(t *testing.T) {
+    ctx := context.Background()
+    const moderator domain.Role = "moderator"
+    repo := &fakeRoleRepository{
+        grants: []*domain.RolePermission{
+            {Role: moderator, Permission: domain.PermissionPostManage},
+        },
+        assignments: []*domain.RoleAssignment{
+            {UserID: 7, Role: moderator, ScopeType: domain.ScopeBoard, ScopeID: 3},
+        },
+    }
+    store := NewPermissionStore(repo, &fakeTemporaryGrantRepository{}, cache.NewLocalInvalidator(), time.Hour)
+
+    tests := []struct {
+        name   string
+        userID uint
+        scope  domain.Scope
+        want   bool
+    }{
+        {"assigned board", 7, domain.BoardScope(3), true},
+        {"other board", 7, domain.BoardScope(4), false},
+        {"other user", 8, domain.BoardScope(3), false},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := store.UserHasPermissionIn(ctx, tt.userID, domain.PermissionPostManage, tt.scope); got != tt.want {
+                t.Errorf("UserHasPermissionIn() = %v, want %v", got, tt.want)
+            }
+        })
+    }
+
+    if store.UserHasPermissionIn(ctx, 7, domain.PermissionUserManage, domain.BoardScope(3)) {
+        t.Error("scoped role should only grant its own permissions")
+    }
+}
+
+func TestPermissionStoreTemporaryGrant(t *testing.T) {
+    ctx := context.Background()
+    const moderator domain.Role = "moderator"
+    repo := &fakeRoleRepository{grants: []*domain.RolePermission{
+        {Role: moderator, Permission: domain.PermissionPostManage},
+    }}
+    now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+    grantRepo := &fakeTemporaryGrantRepository{grants: []*domain.TemporaryGrant{
+        {UserID: 7, Role: moderator, StartsAt: now, EndsAt: now.Add(48 * time.Hour)},
+        {UserID: 8, Permission: domain.PermissionUserManage, StartsAt: now.Add(24 * time.Hour), EndsAt: now.Add(48 * time.Hour)},
+        {UserID: 9, Role: moderator, ScopeType: domain.ScopeBoard, ScopeID: 3, StartsAt: now, EndsAt: now.Add(48 * time.Hour)},
+    }}
+    store := NewPermissionStore(repo, grantRepo, cache.NewLocalInvalidator(), time.Hour)
+
+    at := func(offset time.Duration) { store.now = func() time.Time { return now.Add(offset) } }
+
+    at(time.Hour)
+    if !store.UserHasPermission(7, domain.PermissionPostManage) {
+        t.Error("active role grant should apply")
+    }
+    if store.UserHasPermission(7, domain.PermissionUserManage) {
+        t.Error("role grant should only carry the role's permissions")
+    }
+    if store.UserHasPermission(8, domain.PermissionUserManage) {
+        t.Error("grant should not apply before it starts")
+    }
+    if store.UserHasPermission(9, domain.PermissionPostManage) {
+        t.Error("board grant should not apply globally")
+    }
+    if !store.UserHasPermissionIn(ctx, 9, domain.PermissionPostManage, domain.BoardScope(3)) {
+        t.Error("board grant should apply on its board")
+    }
+
+    at(25 * time.Hour)
+    if !store.UserHasPermission(8, domain.PermissionUserManage) {
+        t.Error("grant should apply once it starts")
+    }
+
+    // 캐시를 비우지 않아도 끝나는 즉시 적용되지 않는다
+    at(48 * time.Hour)
+    if store.UserHasPermission(7, domain.PermissionPostManage) || store.UserHasPermission(8, domain.PermissionUserManage) {
+        t.Error("expired grants should stop applying without invalidation")
+    }
+}
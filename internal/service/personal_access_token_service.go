@@ -0,0 +1,219 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "log"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+)
+
+// PersonalAccessTokenConfig 개인 액세스 토큰 정책
+type PersonalAccessTokenConfig struct {
+    DefaultTTL       time.Duration // 만료일을 지정하지 않았을 때
+    MaxTTL           time.Duration
+    MaxTokensPerUser int
+    LastUsedInterval time.Duration // 마지막 사용 시각을 DB에 반영하는 최소 간격
+}
+
+// DefaultPersonalAccessTokenConfig 기본 정책
+func DefaultPersonalAccessTokenConfig() PersonalAccessTokenConfig {
+    return PersonalAccessTokenConfig{
+        DefaultTTL:       30 * 24 * time.Hour,
+        MaxTTL:           365 * 24 * time.Hour,
+        MaxTokensPerUser: 20,
+        LastUsedInterval: time.Minute,
+    }
+}
+
+type PersonalAccessTokenService interface {
+    // Create 토큰 발급 (원본 토큰은 이 응답에서만 확인 가능)
+    Create(ctx context.Context, req *dto.CreatePersonalAccessTokenRequest) (*dto.PersonalAccessTokenCreatedResponse, error)
+    List(ctx context.Context) ([]*domain.PersonalAccessToken, error)
+    Revoke(ctx context.Context, tokenID uint) error
+    // Authenticate 원본 토큰 검증 후 인증 정보 반환 (middleware.AuthWithPersonalTokens용)
+    Authenticate(ctx context.Context, rawToken string) (*middleware.Claims, error)
+}
+
+type personalAccessTokenService struct {
+    tokenRepo repository.PersonalAccessTokenRepository
+    userRepo  repository.UserRepository
+    sanctions *SanctionStore
+    config    PersonalAccessTokenConfig
+    now       func() time.Time
+}
+
+func NewPersonalAccessTokenService(
+    tokenRepo repository.PersonalAccessTokenRepository,
+    userRepo repository.UserRepository,
+    sanctions *SanctionStore,
+    config PersonalAccessTokenConfig,
+) PersonalAccessTokenService {
+    return &personalAccessTokenService{
+        tokenRepo: tokenRepo,
+        userRepo:  userRepo,
+        sanctions: sanctions,
+        config:    config,
+        now:       time.Now,
+    }
+}
+
+// currentInteractiveUser 토큰 관리는 로그인 세션에서만 허용 (토큰으로 토큰을 만들 수 없다)
+func currentInteractiveUser(ctx context.Context) (*middleware.Claims, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+    if claims.IsPersonalToken() {
+        return nil, ErrPersonalTokenNotAllowed
+    }
+    return claims, nil
+}
+
+func (s *personalAccessTokenService) Create(ctx context.Context, req *dto.CreatePersonalAccessTokenRequest) (*dto.PersonalAccessTokenCreatedResponse, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    scopes, err := validateScopes(domain.Role(claims.Role), req.Scopes)
+    if err != nil {
+        return nil, err
+    }
+
+    now := s.now()
+    ttl := s.config.DefaultTTL
+    if req.ExpiresInDays > 0 {
+        ttl = time.Duration(req.ExpiresInDays) * 24 * time.Hour
+    }
+    if ttl > s.config.MaxTTL {
+        return nil, ErrTokenExpiryTooLong
+    }
+
+    count, err := s.tokenRepo.CountActiveByUserID(ctx, claims.UserID, now)
+    if err != nil {
+        return nil, err
+    }
+    if count >= int64(s.config.MaxTokensPerUser) {
+        return nil, ErrTooManyPersonalTokens
+    }
+
+    raw, hash, err := auth.GeneratePrefixedToken(domain.PersonalAccessTokenPrefix)
+    if err != nil {
+        return nil, err
+    }
+
+    token := &domain.PersonalAccessToken{
+        UserID:    claims.UserID,
+        Name:      req.Name,
+        TokenHash: hash,
+        TokenHint: raw[:len(domain.PersonalAccessTokenPrefix)+4],
+        MFA:       claims.MFA,
+        ExpiresAt: now.Add(ttl),
+    }
+    token.SetScopes(scopes)
+
+    if err := s.tokenRepo.Create(ctx, token); err != nil {
+        return nil, err
+    }
+
+    return &dto.PersonalAccessTokenCreatedResponse{
+        PersonalAccessTokenResponse: dto.ToPersonalAccessTokenResponse(token),
+        Token:                       raw,
+    }, nil
+}
+
+// validateScopes 요청한 권한이 모두 알려진 권한이고 현재 역할이 가진 권한인지 확인
+func validateScopes(role domain.Role, requested []string) ([]domain.Permission, error) {
+    if len(requested) == 0 {
+        return nil, ErrInvalidTokenScope
+    }
+
+    seen := make(map[domain.Permission]bool, len(requested))
+    scopes := make([]domain.Permission, 0, len(requested))
+    for _, r := range requested {
+        p := domain.Permission(r)
+        if !domain.HasPermission(role, p) {
+            return nil, ErrInvalidTokenScope
+        }
+        if seen[p] {
+            continue
+        }
+        seen[p] = true
+        scopes = append(scopes, p)
+    }
+
+    return scopes, nil
+}
+
+func (s *personalAccessTokenService) List(ctx context.Context) ([]*domain.PersonalAccessToken, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    return s.tokenRepo.FindActiveByUserID(ctx, claims.UserID)
+}
+
+func (s *personalAccessTokenService) Revoke(ctx context.Context, tokenID uint) error {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return err
+    }
+
+    if err := s.tokenRepo.Revoke(ctx, tokenID, claims.UserID, s.now()); err != nil {
+        if errors.Is(err, repository.ErrPersonalAccessTokenNotFound) {
+            return ErrPersonalTokenNotFound
+        }
+        return err
+    }
+
+    return nil
+}
+
+func (s *personalAccessTokenService) Authenticate(ctx context.Context, rawToken string) (*middleware.Claims, error) {
+    token, err := s.tokenRepo.FindByTokenHash(ctx, auth.HashToken(rawToken))
+    if err != nil {
+        if errors.Is(err, repository.ErrPersonalAccessTokenNotFound) {
+            return nil, ErrInvalidPersonalToken
+        }
+        return nil, err
+    }
+
+    now := s.now()
+    if !token.IsActive(now) {
+        return nil, ErrInvalidPersonalToken
+    }
+
+    // 역할은 발급 시점이 아니라 현재 값을 쓴다 (강등되면 토큰 권한도 함께 줄어듦)
+    user, err := s.userRepo.FindByID(ctx, token.UserID)
+    if err != nil {
+        return nil, ErrInvalidPersonalToken
+    }
+    // 휴면 계정은 재활성화 전까지 토큰으로도 접근할 수 없다
+    if user.IsDormant() {
+        return nil, ErrInvalidPersonalToken
+    }
+    // 이용 정지 중에는 발급해 둔 토큰도 막는다 (*SanctionError)
+    if err := s.sanctions.CheckAccess(ctx, user.ID); err != nil {
+        return nil, err
+    }
+
+    if err := s.tokenRepo.TouchLastUsed(ctx, token.ID, now, now.Add(-s.config.LastUsedInterval)); err != nil {
+        log.Printf("토큰 사용 시각 갱신 실패: token=%d - %v", token.ID, err)
+    }
+
+    return &middleware.Claims{
+        UserID:          user.ID,
+        Email:           user.Email,
+        Role:            string(user.Role),
+        MFA:             token.MFA,
+        PersonalTokenID: token.ID,
+        Scopes:          token.ScopeList(),
+    }, nil
+}
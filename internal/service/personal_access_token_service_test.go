@@ -0,0 +1,187 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "strings"
+    "testing"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+)
+
+type fakePersonalAccessTokenRepository struct {
+    repository.PersonalAccessTokenRepository
+    tokens map[string]*domain.PersonalAccessToken // 해시 -> 토큰
+}
+
+func (r *fakePersonalAccessTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+    token, ok := r.tokens[tokenHash]
+    if !ok {
+        return nil, repository.ErrPersonalAccessTokenNotFound
+    }
+    return token, nil
+}
+
+func (r *fakePersonalAccessTokenRepository) TouchLastUsed(ctx context.Context, id uint, usedAt, since time.Time) error {
+    return nil
+}
+
+func TestValidateScopes(t *testing.T) {
+    tests := []struct {
+        name      string
+        role      domain.Role
+        requested []string
+        want      []domain.Permission
+        wantErr   error
+    }{
+        {
+            name:      "역할이 가진 권한",
+            role:      domain.RoleUser,
+            requested: []string{"post:read", "post:create"},
+            want:      []domain.Permission{domain.PermissionPostRead, domain.PermissionPostCreate},
+        },
+        {
+            name:      "중복 제거",
+            role:      domain.RoleUser,
+            requested: []string{"post:read", "post:read"},
+            want:      []domain.Permission{domain.PermissionPostRead},
+        },
+        {
+            name:      "역할에 없는 권한",
+            role:      domain.RoleUser,
+            requested: []string{"post:read", "user:manage"},
+            wantErr:   ErrInvalidTokenScope,
+        },
+        {
+            name:      "알 수 없는 권한",
+            role:      domain.RoleAdmin,
+            requested: []string{"everything"},
+            wantErr:   ErrInvalidTokenScope,
+        },
+        {
+            name:      "빈 목록",
+            role:      domain.RoleAdmin,
+            requested: nil,
+            wantErr:   ErrInvalidTokenScope,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            got, err := validateScopes(tt.role, tt.requested)
+            if !errors.Is(err, tt.wantErr) {
+                t.Fatalf("validateScopes() error = %v, want %v", err, tt.wantErr)
+            }
+            if len(got) != len(tt.want) {
+                t.Fatalf("validateScopes() = %v, want %v", got, tt.want)
+            }
+            for i := range got {
+                if got[i] != tt.want[i] {
+                    t.Errorf("validateScopes()[%d] = %q, want %q", i, got[i], tt.want[i])
+                }
+            }
+        })
+    }
+}
+
+func TestAccountSecurityRejectsPersonalToken(t *testing.T) {
+    ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 1, PersonalTokenID: 9})
+
+    // 토큰이 유출되어도 2단계 인증, 탈퇴, 소셜 연결, 프로필을 바꿀 수 없다
+    calls := map[string]func() error{
+        "StartLink": func() error {
+            _, err := (&socialLoginService{}).StartLink(ctx, "google")
+            return err
+        },
+        "Unlink": func() error { return (&socialLoginService{}).Unlink(ctx, "google") },
+        "TwoFactorSetup": func() error {
+            _, err := (&twoFactorService{}).Setup(ctx)
+            return err
+        },
+        "TwoFactorConfirm": func() error {
+            _, err := (&twoFactorService{}).Confirm(ctx, "123456")
+            return err
+        },
+        "TwoFactorDisable": func() error { return (&twoFactorService{}).Disable(ctx, "password", "123456") },
+        "RegenerateRecoveryCodes": func() error {
+            _, err := (&twoFactorService{}).RegenerateRecoveryCodes(ctx, "123456")
+            return err
+        },
+        "Withdraw": func() error { return (&withdrawalService{}).Withdraw(ctx, &dto.WithdrawRequest{}) },
+        "DataExportRequest": func() error {
+            _, err := (&dataExportService{}).Request(ctx)
+            return err
+        },
+        "UpdateMe": func() error {
+            _, err := (&profileService{}).UpdateMe(ctx, &dto.UpdateProfileRequest{})
+            return err
+        },
+        "UploadAvatar": func() error {
+            _, err := (&profileService{}).UploadAvatar(ctx, strings.NewReader(""))
+            return err
+        },
+        "DeleteAvatar": func() error { return (&profileService{}).DeleteAvatar(ctx) },
+    }
+    for name, call := range calls {
+        if err := call(); !errors.Is(err, ErrPersonalTokenNotAllowed) {
+            t.Errorf("%s() error = %v, want ErrPersonalTokenNotAllowed", name, err)
+        }
+    }
+}
+
+func TestAuthenticateRejectsDormantUser(t *testing.T) {
+    now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        1: {ID: 1, Role: domain.RoleUser},
+        2: {ID: 2, Role: domain.RoleUser, DormantAt: &now},
+    }}
+    tokens := &fakePersonalAccessTokenRepository{tokens: map[string]*domain.PersonalAccessToken{
+        auth.HashToken("gbpat_active"):  {ID: 10, UserID: 1, ExpiresAt: now.Add(time.Hour)},
+        auth.HashToken("gbpat_dormant"): {ID: 11, UserID: 2, ExpiresAt: now.Add(time.Hour)},
+    }}
+    svc := NewPersonalAccessTokenService(tokens, users, nil, DefaultPersonalAccessTokenConfig())
+    svc.(*personalAccessTokenService).now = func() time.Time { return now }
+
+    claims, err := svc.Authenticate(context.Background(), "gbpat_active")
+    if err != nil || claims.UserID != 1 || claims.PersonalTokenID != 10 {
+        t.Fatalf("Authenticate(active) = %+v, %v, want user 1 via token 10", claims, err)
+    }
+
+    // 휴면 전환된 계정의 토큰은 재활성화 전까지 쓸 수 없다
+    if _, err := svc.Authenticate(context.Background(), "gbpat_dormant"); !errors.Is(err, ErrInvalidPersonalToken) {
+        t.Errorf("Authenticate(dormant) error = %v, want ErrInvalidPersonalToken", err)
+    }
+}
+
+func TestAuthenticateRejectsSanctionedUser(t *testing.T) {
+    now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        1: {ID: 1, Role: domain.RoleUser},
+        2: {ID: 2, Role: domain.RoleUser},
+    }}
+    tokens := &fakePersonalAccessTokenRepository{tokens: map[string]*domain.PersonalAccessToken{
+        auth.HashToken("gbpat_restricted"): {ID: 10, UserID: 1, ExpiresAt: now.Add(time.Hour)},
+        auth.HashToken("gbpat_banned"):     {ID: 11, UserID: 2, ExpiresAt: now.Add(time.Hour)},
+    }}
+    sanctions := NewSanctionStore(&fakeSanctionRepository{sanctions: []*domain.Sanction{
+        {ID: 1, UserID: 1, Type: domain.SanctionWriteRestricted, StartsAt: now.Add(-time.Hour)},
+        {ID: 2, UserID: 2, Type: domain.SanctionBanned, StartsAt: now.Add(-time.Hour)},
+    }}, cache.NewLocalInvalidator(), time.Minute)
+    sanctions.now = func() time.Time { return now }
+    svc := NewPersonalAccessTokenService(tokens, users, sanctions, DefaultPersonalAccessTokenConfig())
+    svc.(*personalAccessTokenService).now = func() time.Time { return now }
+
+    // 작성 제한은 읽기를 막지 않으므로 인증은 된다
+    if claims, err := svc.Authenticate(context.Background(), "gbpat_restricted"); err != nil || claims.UserID != 1 {
+        t.Fatalf("Authenticate(restricted) = %+v, %v, want user 1", claims, err)
+    }
+    if _, err := svc.Authenticate(context.Background(), "gbpat_banned"); !errors.Is(err, ErrAccountBanned) {
+        t.Errorf("Authenticate(banned) error = %v, want ErrAccountBanned", err)
+    }
+}
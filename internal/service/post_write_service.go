@@ -0,0 +1,171 @@
+package service
+
+import (
+    "context"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+)
+
+// PostWriteService 게시글 작성 경로 (제재, 이메일 인증, 금칙어, 스팸 확인 후 저장하고 팔로워 피드에 반영)
+type PostWriteService interface {
+    Create(ctx context.Context, req *dto.CreatePostRequest) (*domain.Post, error)
+    // Update 수정 (작성자는 작성 후 24시간 안, 게시판 모더레이터를 포함한 관리 권한은 언제나)
+    Update(ctx context.Context, id uint, req *dto.UpdatePostRequest) (*domain.Post, error)
+    // Delete 삭제 (좋아요가 많은 글은 관리 권한이 있어야 지울 수 있다)
+    Delete(ctx context.Context, id uint) error
+}
+
+type postWriteService struct {
+    postRepo   repository.PostRepository
+    userRepo   repository.UserRepository
+    feed       FeedService
+    spamGate   *SpamGate
+    wordFilter *WordFilterService
+    sanctions  *SanctionStore
+}
+
+func NewPostWriteService(
+    postRepo repository.PostRepository,
+    userRepo repository.UserRepository,
+    feed FeedService,
+    spamGate *SpamGate,
+    wordFilter *WordFilterService,
+    sanctions *SanctionStore,
+) PostWriteService {
+    return &postWriteService{
+        postRepo:   postRepo,
+        userRepo:   userRepo,
+        feed:       feed,
+        spamGate:   spamGate,
+        wordFilter: wordFilter,
+        sanctions:  sanctions,
+    }
+}
+
+func (s *postWriteService) Create(ctx context.Context, req *dto.CreatePostRequest) (*domain.Post, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    if err := s.sanctions.CheckWrite(ctx, claims.UserID); err != nil {
+        return nil, err
+    }
+    if err := requireVerifiedEmail(ctx, s.userRepo, claims.UserID); err != nil {
+        return nil, err
+    }
+
+    // 금칙어: reject면 거부, mask는 가린 채 저장, flag는 저장 후 검토 큐에 올린다
+    filtered, err := s.wordFilter.Screen(ctx,
+        FilterInput{Field: FilterFieldTitle, Text: req.Title},
+        FilterInput{Field: FilterFieldContent, Text: req.Content},
+    )
+    if err != nil {
+        return nil, err
+    }
+
+    post := &domain.Post{
+        Title:    filtered.Texts[0],
+        Content:  filtered.Texts[1],
+        AuthorID: claims.UserID,
+    }
+
+    // 스팸 의심이면 숨긴 채 저장하고 보류 큐로 보낸다 (승인 전까지 피드에 넣지 않음)
+    hold := s.spamGate.Screen(ctx, &SpamCandidate{
+        AuthorID:   claims.UserID,
+        TargetType: domain.ReportTargetPost,
+        Title:      post.Title,
+        Content:    post.Content,
+    })
+    if hold != nil {
+        now := time.Now()
+        post.HiddenAt = &now
+    }
+
+    if err := s.postRepo.Create(ctx, post); err != nil {
+        return nil, err
+    }
+
+    if hold != nil {
+        if err := s.spamGate.Hold(ctx, domain.ReportTargetPost, post.ID, claims.UserID, post.BoardID, hold); err != nil {
+            middleware.LoggerFromRequestContext(ctx).Warn("게시글 보류 실패 (공개 처리)", "post_id", post.ID, "error", err)
+            post.HiddenAt = nil
+        } else {
+            return post, nil
+        }
+    }
+
+    s.wordFilter.Flag(ctx, domain.ReportTargetPost, post.ID, claims.UserID, post.BoardID, filtered.Flagged)
+
+    // 피드 반영은 워커가 하므로 큐에 넣지 못해도 작성은 되돌리지 않는다
+    if err := s.feed.OnPostCreated(ctx, post); err != nil {
+        middleware.LoggerFromRequestContext(ctx).Warn("피드 반영 등록 실패", "post_id", post.ID, "error", err)
+    }
+
+    return post, nil
+}
+
+// 수정/삭제 가능 여부는 config/policies/content.yaml의 post:update, post:delete 정책으로 판단한다
+func (s *postWriteService) Update(ctx context.Context, id uint, req *dto.UpdatePostRequest) (*domain.Post, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    if err := s.sanctions.CheckWrite(ctx, claims.UserID); err != nil {
+        return nil, err
+    }
+
+    post, err := s.postRepo.FindByID(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+    if err := auth.RequireCan(ctx, "post:update", post); err != nil {
+        return nil, err
+    }
+
+    title, content := post.Title, post.Content
+    if req.Title != nil {
+        title = *req.Title
+    }
+    if req.Content != nil {
+        content = *req.Content
+    }
+    filtered, err := s.wordFilter.Screen(ctx,
+        FilterInput{Field: FilterFieldTitle, Text: title},
+        FilterInput{Field: FilterFieldContent, Text: content},
+    )
+    if err != nil {
+        return nil, err
+    }
+
+    post.Title = filtered.Texts[0]
+    post.Content = filtered.Texts[1]
+    if err := s.postRepo.Update(ctx, post); err != nil {
+        return nil, err
+    }
+
+    s.wordFilter.Flag(ctx, domain.ReportTargetPost, post.ID, post.AuthorID, post.BoardID, filtered.Flagged)
+    return post, nil
+}
+
+func (s *postWriteService) Delete(ctx context.Context, id uint) error {
+    if _, ok := middleware.GetUserFromContext(ctx); !ok {
+        return ErrUnauthorized
+    }
+
+    post, err := s.postRepo.FindByID(ctx, id)
+    if err != nil {
+        return err
+    }
+    if err := auth.RequireCan(ctx, "post:delete", post); err != nil {
+        return err
+    }
+
+    return s.postRepo.Delete(ctx, post.ID)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "path/filepath"
+    "testing"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/policy"
+)
+
+func (r *fakePostRepository) Update(ctx context.Context, post *domain.Post) error {
+    r.post = post
+    return nil
+}
+
+func (r *fakePostRepository) Delete(ctx context.Context, id uint) error {
+    r.post = nil
+    return nil
+}
+
+// useShippedPolicies config/policies의 접근 정책으로 auth.RequireCan을 판단하게 한다
+func useShippedPolicies(t *testing.T) {
+    t.Helper()
+    engine, err := policy.LoadDir(filepath.Join("..", "..", "config", "policies"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    auth.SetPolicyEngine(engine)
+    t.Cleanup(func() {
+        denyAll, _ := policy.NewEngine(nil)
+        auth.SetPolicyEngine(denyAll)
+    })
+}
+
+func TestPostUpdateDeletePolicy(t *testing.T) {
+    useShippedPolicies(t)
+    title := "수정한 제목"
+    author := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 1, Role: string(domain.RoleUser)})
+    other := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 2, Role: string(domain.RoleUser)})
+    admin := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 3, Role: string(domain.RoleAdmin)})
+
+    tests := []struct {
+        name      string
+        ctx       context.Context
+        createdAt time.Time
+        likes     int
+        update    error
+        delete    error
+    }{
+        {"작성자", author, time.Now().Add(-time.Hour), 0, nil, nil},
+        {"수정 기한이 지난 작성자", author, time.Now().Add(-48 * time.Hour), 0, auth.ErrNoPermission, nil},
+        {"다른 사용자", other, time.Now().Add(-time.Hour), 0, auth.ErrNoPermission, auth.ErrNoPermission},
+        {"인기 글 작성자", author, time.Now().Add(-time.Hour), 100, nil, auth.ErrNoPermission},
+        {"관리자", admin, time.Now().Add(-48 * time.Hour), 100, nil, nil},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            posts := &fakePostRepository{post: &domain.Post{ID: 10, Title: "제목", Content: "본문", AuthorID: 1, LikeCount: tt.likes, CreatedAt: tt.createdAt}}
+            svc := NewPostWriteService(posts, nil, nil, nil, nil, nil)
+
+            post, err := svc.Update(tt.ctx, 10, &dto.UpdatePostRequest{Title: &title})
+            if !errors.Is(err, tt.update) {
+                t.Fatalf("Update() error = %v, want %v", err, tt.update)
+            }
+            if err == nil && (post.Title != title || post.Content != "본문") {
+                t.Errorf("Update() = %q/%q, want only title changed", post.Title, post.Content)
+            }
+
+            if err := svc.Delete(tt.ctx, 10); !errors.Is(err, tt.delete) {
+                t.Errorf("Delete() error = %v, want %v", err, tt.delete)
+            }
+        })
+    }
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+    "bytes"
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "net/url"
+    "strings"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/avatar"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/storage"
+)
+
+// ProfileConfig 프로필 정책
+type ProfileConfig struct {
+    AvatarSize     int   // 저장할 프로필 이미지 한 변(px)
+    MaxAvatarBytes int64 // 업로드 원본 최대 크기
+}
+
+// DefaultProfileConfig 기본 정책
+func DefaultProfileConfig() ProfileConfig {
+    return ProfileConfig{
+        AvatarSize:     avatar.DefaultSize,
+        MaxAvatarBytes: 5 << 20,
+    }
+}
+
+type ProfileService interface {
+    // GetMe 내 프로필
+    GetMe(ctx context.Context) (*domain.User, error)
+    // UpdateMe 내 프로필 수정
+    UpdateMe(ctx context.Context, req *dto.UpdateProfileRequest) (*domain.User, error)
+    // GetPublic 사용자 이름으로 공개 프로필 조회 (활동 통계 포함)
+    GetPublic(ctx context.Context, username string) (*dto.PublicProfileResponse, error)
+    // UploadAvatar 프로필 이미지 업로드 (서버에서 정사각형으로 자르고 축소)
+    UploadAvatar(ctx context.Context, r io.Reader) (*domain.User, error)
+    // DeleteAvatar 프로필 이미지 삭제
+    DeleteAvatar(ctx context.Context) error
+}
+
+type profileService struct {
+    userRepo    repository.UserRepository
+    postRepo    repository.PostRepository
+    commentRepo repository.CommentRepository
+    likeRepo    repository.LikeRepository
+    blockRepo   repository.UserBlockRepository
+    storage     storage.Storage
+    wordFilter  *WordFilterService
+    config      ProfileConfig
+}
+
+func NewProfileService(
+    userRepo repository.UserRepository,
+    postRepo repository.PostRepository,
+    commentRepo repository.CommentRepository,
+    likeRepo repository.LikeRepository,
+    blockRepo repository.UserBlockRepository,
+    storage storage.Storage,
+    wordFilter *WordFilterService,
+    config ProfileConfig,
+) ProfileService {
+    return &profileService{
+        userRepo:    userRepo,
+        postRepo:    postRepo,
+        commentRepo: commentRepo,
+        likeRepo:    likeRepo,
+        blockRepo:   blockRepo,
+        storage:     storage,
+        wordFilter:  wordFilter,
+        config:      config,
+    }
+}
+
+func (s *profileService) GetMe(ctx context.Context) (*domain.User, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    return s.userRepo.FindByID(ctx, claims.UserID)
+}
+
+func (s *profileService) UpdateMe(ctx context.Context, req *dto.UpdateProfileRequest) (*domain.User, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, claims.UserID)
+    if err != nil {
+        return nil, err
+    }
+
+    if req.DisplayName != nil {
+        displayName := strings.TrimSpace(*req.DisplayName)
+        if err := s.wordFilter.CheckName(ctx, FilterFieldDisplayName, displayName); err != nil {
+            return nil, err
+        }
+        user.DisplayName = displayName
+    }
+    if req.Bio != nil {
+        user.Bio = strings.TrimSpace(*req.Bio)
+    }
+    if req.Website != nil {
+        website, err := normalizeWebsite(*req.Website)
+        if err != nil {
+            return nil, err
+        }
+        user.Website = website
+    }
+
+    if err := s.userRepo.UpdateProfile(ctx, user); err != nil {
+        return nil, err
+    }
+
+    return user, nil
+}
+
+func (s *profileService) GetPublic(ctx context.Context, username string) (*dto.PublicProfileResponse, error) {
+    user, err := s.userRepo.FindByUsername(ctx, username)
+    if err != nil {
+        return nil, err
+    }
+
+    // 차단 관계면 서로의 프로필이 보이지 않는다
+    if claims, ok := middleware.GetUserFromContext(ctx); ok && claims.UserID != user.ID {
+        blocked, err := s.blockRepo.IsBlockedEither(ctx, claims.UserID, user.ID)
+        if err != nil {
+            return nil, err
+        }
+        if blocked {
+            return nil, repository.ErrUserNotFound
+        }
+    }
+
+    stats := dto.ProfileStats{
+        FollowerCount:  user.FollowerCount,
+        FollowingCount: user.FollowingCount,
+    }
+    if stats.PostCount, err = s.postRepo.CountByAuthorID(ctx, user.ID); err != nil {
+        return nil, err
+    }
+    if stats.CommentCount, err = s.commentRepo.CountByAuthorID(ctx, user.ID); err != nil {
+        return nil, err
+    }
+    if stats.LikeCount, err = s.likeRepo.CountByUserID(ctx, user.ID); err != nil {
+        return nil, err
+    }
+
+    return dto.ToPublicProfileResponse(user, stats), nil
+}
+
+func (s *profileService) UploadAvatar(ctx context.Context, r io.Reader) (*domain.User, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    data, err := io.ReadAll(io.LimitReader(r, s.config.MaxAvatarBytes+1))
+    if err != nil {
+        return nil, err
+    }
+    if int64(len(data)) > s.config.MaxAvatarBytes {
+        return nil, ErrAvatarTooLarge
+    }
+
+    img, err := avatar.Process(data, s.config.AvatarSize)
+    switch {
+    case errors.Is(err, avatar.ErrUnsupportedFormat):
+        return nil, ErrInvalidAvatar
+    case errors.Is(err, avatar.ErrImageTooLarge):
+        return nil, ErrAvatarTooLarge
+    case err != nil:
+        return nil, err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, claims.UserID)
+    if err != nil {
+        return nil, err
+    }
+
+    // 매번 새 키를 써서 CDN/브라우저 캐시가 이전 이미지를 보여주지 않게 한다
+    suffix, _, err := auth.GenerateToken()
+    if err != nil {
+        return nil, err
+    }
+    key := fmt.Sprintf("avatars/%d/%s.jpg", user.ID, suffix[:16])
+
+    avatarURL, err := s.storage.Put(ctx, key, bytes.NewReader(img), avatar.ContentType)
+    if err != nil {
+        return nil, err
+    }
+
+    if err := s.userRepo.UpdateAvatar(ctx, user.ID, key, avatarURL); err != nil {
+        s.removeAvatar(ctx, key)
+        return nil, err
+    }
+    s.removeAvatar(ctx, user.AvatarKey)
+
+    user.AvatarKey = key
+    user.AvatarURL = avatarURL
+    return user, nil
+}
+
+func (s *profileService) DeleteAvatar(ctx context.Context) error {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, claims.UserID)
+    if err != nil {
+        return err
+    }
+    if user.AvatarKey == "" {
+        return nil
+    }
+
+    if err := s.userRepo.UpdateAvatar(ctx, user.ID, "", ""); err != nil {
+        return err
+    }
+    s.removeAvatar(ctx, user.AvatarKey)
+    return nil
+}
+
+// removeAvatar 더 이상 참조되지 않는 이미지 삭제 (실패해도 요청은 성공 처리)
+func (s *profileService) removeAvatar(ctx context.Context, key string) {
+    if key == "" {
+        return
+    }
+    if err := s.storage.Delete(ctx, key); err != nil {
+        middleware.LoggerFromRequestContext(ctx).Warn("프로필 이미지 삭제 실패", "key", key, "error", err)
+    }
+}
+
+// normalizeWebsite 웹사이트 주소 검증 (스킴이 없으면 https로 간주)
+func normalizeWebsite(raw string) (string, error) {
+    raw = strings.TrimSpace(raw)
+    if raw == "" {
+        return "", nil
+    }
+    if !strings.Contains(raw, "://") {
+        raw = "https://" + raw
+    }
+
+    u, err := url.Parse(raw)
+    if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+        return "", ErrInvalidWebsite
+    }
+    return u.String(), nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+    "errors"
+    "testing"
+)
+
+func TestNormalizeWebsite(t *testing.T) {
+    tests := []struct {
+        input   string
+        want    string
+        wantErr bool
+    }{
+        {"", "", false},
+        {"  ", "", false},
+        {"example.com", "https://example.com", false},
+        {"http://example.com/blog", "http://example.com/blog", false},
+        {"javascript:alert(1)", "", true},
+        {"ftp://example.com", "", true},
+        {"https://user:pw@example.com", "", true},
+        {"https://", "", true},
+    }
+
+    for _, tt := range tests {
+        got, err := normalizeWebsite(tt.input)
+        if tt.wantErr {
+            if !errors.Is(err, ErrInvalidWebsite) {
+                t.Errorf("normalizeWebsite(%q) error = %v, want ErrInvalidWebsite", tt.input, err)
+            }
+            continue
+        }
+        if err != nil || got != tt.want {
+            t.Errorf("normalizeWebsite(%q) = %q, %v, want %q", tt.input, got, err, tt.want)
+        }
+    }
+}
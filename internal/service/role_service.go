@@ -0,0 +1,288 @@
+package service
+
+import (
+    "context"
+    "regexp"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+)
+
+var (
+    roleNamePattern       = regexp.MustCompile(`^[a-z][a-z0-9_]{1,19}$`)
+    permissionNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*:[a-z][a-z0-9_]*$`)
+)
+
+// RoleService 역할/권한 관리 (role:manage 권한 필요)
+type RoleService interface {
+    ListRoles(ctx context.Context) ([]*dto.RoleResponse, error)
+    CreateRole(ctx context.Context, req *dto.CreateRoleRequest) (*dto.RoleResponse, error)
+    // DeleteRole 기본 역할이나 사용자가 있는 역할은 삭제 불가
+    DeleteRole(ctx context.Context, name string) error
+    ListPermissions(ctx context.Context) ([]*dto.PermissionResponse, error)
+    CreatePermission(ctx context.Context, req *dto.CreatePermissionRequest) (*dto.PermissionResponse, error)
+    // DeletePermission 코드에서 확인하는 기본 권한은 삭제 불가
+    DeletePermission(ctx context.Context, name string) error
+    Grant(ctx context.Context, role, permission string) error
+    Revoke(ctx context.Context, role, permission string) error
+    // AssignUser 사용자 역할 변경 (새 역할은 다음 토큰 갱신부터 적용)
+    AssignUser(ctx context.Context, userID uint, role string) error
+
+    // ListBoardRoles 게시판에 한정해 부여된 역할
+    ListBoardRoles(ctx context.Context, boardID uint) ([]*dto.RoleAssignmentResponse, error)
+    // AssignBoardRole 게시판 안에서만 유효한 역할 부여 (바로 반영)
+    AssignBoardRole(ctx context.Context, boardID uint, req *dto.AssignBoardRoleRequest) (*dto.RoleAssignmentResponse, error)
+    RemoveBoardRole(ctx context.Context, boardID, assignmentID uint) error
+}
+
+type roleService struct {
+    roleRepo  repository.RoleRepository
+    userRepo  repository.UserRepository
+    boardRepo repository.BoardRepository
+    store     *PermissionStore
+}
+
+func NewRoleService(
+    roleRepo repository.RoleRepository,
+    userRepo repository.UserRepository,
+    boardRepo repository.BoardRepository,
+    store *PermissionStore,
+) RoleService {
+    return &roleService{roleRepo: roleRepo, userRepo: userRepo, boardRepo: boardRepo, store: store}
+}
+
+func (s *roleService) ListRoles(ctx context.Context) ([]*dto.RoleResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+
+    roles, err := s.roleRepo.ListRoles(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    resp := make([]*dto.RoleResponse, 0, len(roles))
+    for _, role := range roles {
+        permissions, err := s.store.Permissions(ctx, role.Name)
+        if err != nil {
+            return nil, err
+        }
+        resp = append(resp, dto.ToRoleResponse(role, permissions))
+    }
+    return resp, nil
+}
+
+func (s *roleService) CreateRole(ctx context.Context, req *dto.CreateRoleRequest) (*dto.RoleResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+    if !roleNamePattern.MatchString(req.Name) {
+        return nil, ErrInvalidRoleName
+    }
+
+    role := &domain.RoleDefinition{Name: domain.Role(req.Name), Description: req.Description}
+    if err := s.roleRepo.CreateRole(ctx, role); err != nil {
+        return nil, err
+    }
+    return dto.ToRoleResponse(role, nil), nil
+}
+
+func (s *roleService) DeleteRole(ctx context.Context, name string) error {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return err
+    }
+
+    role, err := s.roleRepo.FindRole(ctx, domain.Role(name))
+    if err != nil {
+        return err
+    }
+    if role.System {
+        return ErrSystemRole
+    }
+
+    count, err := s.roleRepo.CountUsers(ctx, role.Name)
+    if err != nil {
+        return err
+    }
+    if count > 0 {
+        return ErrRoleInUse
+    }
+
+    if err := s.roleRepo.DeleteRole(ctx, role.Name); err != nil {
+        return err
+    }
+    s.store.Invalidate(ctx)
+    return nil
+}
+
+func (s *roleService) ListPermissions(ctx context.Context) ([]*dto.PermissionResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+
+    permissions, err := s.roleRepo.ListPermissions(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    resp := make([]*dto.PermissionResponse, 0, len(permissions))
+    for _, p := range permissions {
+        resp = append(resp, dto.ToPermissionResponse(p))
+    }
+    return resp, nil
+}
+
+func (s *roleService) CreatePermission(ctx context.Context, req *dto.CreatePermissionRequest) (*dto.PermissionResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+    if !permissionNamePattern.MatchString(req.Name) {
+        return nil, ErrInvalidPermissionName
+    }
+
+    permission := &domain.PermissionDefinition{Name: domain.Permission(req.Name), Description: req.Description}
+    if err := s.roleRepo.CreatePermission(ctx, permission); err != nil {
+        return nil, err
+    }
+    return dto.ToPermissionResponse(permission), nil
+}
+
+func (s *roleService) DeletePermission(ctx context.Context, name string) error {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return err
+    }
+    if isBuiltinPermission(domain.Permission(name)) {
+        return ErrSystemPermission
+    }
+
+    if err := s.roleRepo.DeletePermission(ctx, domain.Permission(name)); err != nil {
+        return err
+    }
+    s.store.Invalidate(ctx)
+    return nil
+}
+
+func (s *roleService) Grant(ctx context.Context, role, permission string) error {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return err
+    }
+    if _, err := s.roleRepo.FindRole(ctx, domain.Role(role)); err != nil {
+        return err
+    }
+    if _, err := s.roleRepo.FindPermission(ctx, domain.Permission(permission)); err != nil {
+        return err
+    }
+
+    if err := s.roleRepo.Grant(ctx, domain.Role(role), domain.Permission(permission)); err != nil {
+        return err
+    }
+    s.store.Invalidate(ctx)
+    return nil
+}
+
+func (s *roleService) Revoke(ctx context.Context, role, permission string) error {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return err
+    }
+    // 관리자가 역할 관리 권한을 잃으면 API로 되돌릴 방법이 없다
+    if domain.Role(role) == domain.RoleAdmin && domain.Permission(permission) == domain.PermissionRoleManage {
+        return ErrProtectedGrant
+    }
+
+    if err := s.roleRepo.Revoke(ctx, domain.Role(role), domain.Permission(permission)); err != nil {
+        return err
+    }
+    s.store.Invalidate(ctx)
+    return nil
+}
+
+func (s *roleService) AssignUser(ctx context.Context, userID uint, role string) error {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return err
+    }
+    if _, err := s.roleRepo.FindRole(ctx, domain.Role(role)); err != nil {
+        return err
+    }
+    return s.roleRepo.AssignUser(ctx, userID, domain.Role(role))
+}
+
+func (s *roleService) ListBoardRoles(ctx context.Context, boardID uint) ([]*dto.RoleAssignmentResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+    if _, err := s.boardRepo.FindByID(ctx, boardID); err != nil {
+        return nil, err
+    }
+
+    assignments, err := s.roleRepo.FindAssignmentsByScope(ctx, domain.BoardScope(boardID))
+    if err != nil {
+        return nil, err
+    }
+
+    resp := make([]*dto.RoleAssignmentResponse, 0, len(assignments))
+    for _, assignment := range assignments {
+        resp = append(resp, dto.ToRoleAssignmentResponse(assignment))
+    }
+    return resp, nil
+}
+
+func (s *roleService) AssignBoardRole(ctx context.Context, boardID uint, req *dto.AssignBoardRoleRequest) (*dto.RoleAssignmentResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+    claims, _ := middleware.GetUserFromContext(ctx)
+
+    if _, err := s.boardRepo.FindByID(ctx, boardID); err != nil {
+        return nil, err
+    }
+    if _, err := s.roleRepo.FindRole(ctx, domain.Role(req.Role)); err != nil {
+        return nil, err
+    }
+    user, err := s.userRepo.FindByID(ctx, req.UserID)
+    if err != nil {
+        return nil, err
+    }
+
+    scope := domain.BoardScope(boardID)
+    assignment := &domain.RoleAssignment{
+        UserID:    req.UserID,
+        Role:      domain.Role(req.Role),
+        ScopeType: scope.Type,
+        ScopeID:   scope.ID,
+        GrantedBy: claims.UserID,
+    }
+    if err := s.roleRepo.CreateAssignment(ctx, assignment); err != nil {
+        return nil, err
+    }
+    s.store.Invalidate(ctx)
+
+    assignment.User = user
+    return dto.ToRoleAssignmentResponse(assignment), nil
+}
+
+func (s *roleService) RemoveBoardRole(ctx context.Context, boardID, assignmentID uint) error {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return err
+    }
+
+    if err := s.roleRepo.DeleteAssignment(ctx, assignmentID, domain.BoardScope(boardID)); err != nil {
+        return err
+    }
+    s.store.Invalidate(ctx)
+    return nil
+}
+
+// isBuiltinPermission 기본 매핑에 있는 권한 (코드에서 직접 확인하므로 삭제하면 기능이 막힌다)
+func isBuiltinPermission(permission domain.Permission) bool {
+    for _, permissions := range domain.RolePermissions {
+        for _, p := range permissions {
+            if p == permission {
+                return true
+            }
+        }
+    }
+    return false
+}
@@ -0,0 +1,438 @@
+package service
+
+import (
+    "context"
+    "fmt"
+    "log"
+    "strconv"
+    "strings"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+
+    "gorm.io/gorm"
+)
+
+// sanctionInvalidationChannel 제재가 바뀌면 모든 인스턴스에 알리는 채널
+const sanctionInvalidationChannel = "sanction:invalidate"
+
+// sanctionDisconnectChannel 제재가 시작된 사용자의 실시간 연결을 모든 인스턴스에서 끊는 채널
+const sanctionDisconnectChannel = "sanction:disconnect"
+
+// UserDisconnector 제재된 사용자의 실시간 연결 종료 (ws.Hub가 구현)
+type UserDisconnector interface {
+    CloseUsers(userIDs ...uint)
+}
+
+// ClusterDisconnector 연결 종료를 모든 인스턴스에 전달하는 UserDisconnector
+// ws.Hub는 자기 인스턴스의 연결만 알기 때문에, 현재 인스턴스에서 끊은 뒤 사용자 ID를 알려 다른 인스턴스의 Hub도 끊게 한다.
+type ClusterDisconnector struct {
+    invalidator cache.Invalidator
+    local       UserDisconnector
+}
+
+func NewClusterDisconnector(invalidator cache.Invalidator, local UserDisconnector) *ClusterDisconnector {
+    return &ClusterDisconnector{invalidator: invalidator, local: local}
+}
+
+// Listen 다른 인스턴스의 연결 종료 알림 구독 (ctx가 끝날 때까지)
+func (d *ClusterDisconnector) Listen(ctx context.Context) {
+    d.invalidator.Subscribe(ctx, sanctionDisconnectChannel, func(message string) {
+        var userIDs []uint
+        for _, field := range strings.Split(message, ",") {
+            id, err := strconv.ParseUint(field, 10, 64)
+            if err != nil {
+                log.Printf("연결 종료 알림 형식 오류: %q", message)
+                return
+            }
+            userIDs = append(userIDs, uint(id))
+        }
+        d.local.CloseUsers(userIDs...)
+    })
+}
+
+// CloseUsers 현재 인스턴스의 연결을 끊고 다른 인스턴스에 알림
+func (d *ClusterDisconnector) CloseUsers(userIDs ...uint) {
+    if len(userIDs) == 0 {
+        return
+    }
+    d.local.CloseUsers(userIDs...)
+
+    fields := make([]string, len(userIDs))
+    for i, id := range userIDs {
+        fields[i] = strconv.FormatUint(uint64(id), 10)
+    }
+    if err := d.invalidator.Publish(context.Background(), sanctionDisconnectChannel, strings.Join(fields, ",")); err != nil {
+        // 다른 인스턴스의 연결은 다음 요청의 제재 확인에서 막힌다
+        log.Printf("연결 종료 알림 실패: users=%v - %v", userIDs, err)
+    }
+}
+
+// SanctionError 이용 제재 때문에 거부함 (handler가 종료 시각을 함께 응답)
+type SanctionError struct {
+    Sanction *domain.Sanction
+}
+
+func (e *SanctionError) Error() string {
+    if e.Sanction.EndsAt == nil {
+        return fmt.Sprintf("user is %s", e.Sanction.Type)
+    }
+    return fmt.Sprintf("user is %s until %s", e.Sanction.Type, e.Sanction.EndsAt.Format(time.RFC3339))
+}
+
+// Is 제재 종류별 에러(ErrAccountBanned 등)로 errors.Is 확인
+func (e *SanctionError) Is(target error) bool {
+    switch e.Sanction.Type {
+    case domain.SanctionBanned:
+        return target == ErrAccountBanned
+    case domain.SanctionSuspended:
+        return target == ErrAccountSuspended
+    default:
+        return target == ErrWriteRestricted
+    }
+}
+
+// sanctionsByUser 사용자별로 해제되지 않았고 끝나지 않은 제재
+type sanctionsByUser map[uint][]*domain.Sanction
+
+// SanctionStore 지금 적용 중인 제재 조회 (인증 미들웨어와 서비스의 작성/로그인 확인)
+//
+// 끝나지 않은 제재 전체를 cache.SnapshotGroup에 두고, 제재를 내리거나 해제하면 Invalidate로 모든 인스턴스의 캐시를 비운다.
+// 기간은 확인할 때마다 비교하므로 예약 제재의 시작과 만료는 캐시를 다시 읽지 않아도 바로 적용된다.
+type SanctionStore struct {
+    sanctionRepo repository.SanctionRepository
+    snapshots    *cache.SnapshotGroup
+    snapshot     *cache.Snapshot[sanctionsByUser]
+    now          func() time.Time
+}
+
+func NewSanctionStore(sanctionRepo repository.SanctionRepository, invalidator cache.Invalidator, ttl time.Duration) *SanctionStore {
+    s := &SanctionStore{
+        sanctionRepo: sanctionRepo,
+        snapshots:    cache.NewSnapshotGroup(invalidator, sanctionInvalidationChannel),
+        now:          time.Now,
+    }
+    s.snapshot = cache.NewGroupSnapshot(s.snapshots, ttl, s.load)
+    return s
+}
+
+// Listen 다른 인스턴스의 변경 알림 구독 (ctx가 끝날 때까지)
+func (s *SanctionStore) Listen(ctx context.Context) {
+    s.snapshots.Listen(ctx)
+}
+
+// Invalidate 현재 인스턴스 캐시를 비우고 다른 인스턴스에 알림
+func (s *SanctionStore) Invalidate(ctx context.Context) {
+    s.snapshots.Invalidate(ctx)
+}
+
+func (s *SanctionStore) load(ctx context.Context) (sanctionsByUser, error) {
+    sanctions, err := s.sanctionRepo.ListCurrent(ctx, s.now())
+    if err != nil {
+        return nil, err
+    }
+
+    byUser := make(sanctionsByUser)
+    for _, sanction := range sanctions {
+        byUser[sanction.UserID] = append(byUser[sanction.UserID], sanction)
+    }
+    return byUser, nil
+}
+
+// Active 사용자에게 지금 적용 중인 가장 무거운 제재 (없으면 nil, middleware.SanctionChecker)
+// 같은 무게면 늦게 끝나는 제재를 돌려준다 (종료 시각 없는 제재가 가장 늦음).
+func (s *SanctionStore) Active(ctx context.Context, userID uint) (*domain.Sanction, error) {
+    if s == nil {
+        return nil, nil
+    }
+    byUser, err := s.snapshot.Get(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    now := s.now()
+    var strongest *domain.Sanction
+    for _, sanction := range byUser[userID] {
+        if !sanction.ActiveAt(now) {
+            continue
+        }
+        if strongest == nil || heavierSanction(sanction, strongest) {
+            strongest = sanction
+        }
+    }
+    return strongest, nil
+}
+
+func heavierSanction(a, b *domain.Sanction) bool {
+    if a.Type.Severity() != b.Type.Severity() {
+        return a.Type.Severity() > b.Type.Severity()
+    }
+    if a.EndsAt == nil || b.EndsAt == nil {
+        return a.EndsAt == nil && b.EndsAt != nil
+    }
+    return a.EndsAt.After(*b.EndsAt)
+}
+
+// CheckAccess 로그인과 토큰 갱신 전 확인 (이용 정지면 *SanctionError)
+func (s *SanctionStore) CheckAccess(ctx context.Context, userID uint) error {
+    sanction, err := s.Active(ctx, userID)
+    if err != nil {
+        return err
+    }
+    if sanction != nil && sanction.Type.BlocksAccess() {
+        return &SanctionError{Sanction: sanction}
+    }
+    return nil
+}
+
+// CheckWrite 글, 댓글, 메시지 작성 전 확인 (제재 중이면 종류와 관계없이 *SanctionError)
+// 이용 정지는 인증 미들웨어에서 먼저 걸러지고, 작성 제한은 요청 경로(HTTP, WebSocket 메시지)와 관계없이 여기서 막는다.
+func (s *SanctionStore) CheckWrite(ctx context.Context, userID uint) error {
+    sanction, err := s.Active(ctx, userID)
+    if err != nil {
+        return err
+    }
+    if sanction != nil {
+        return &SanctionError{Sanction: sanction}
+    }
+    return nil
+}
+
+// SanctionConfig 이용 제재 정책
+type SanctionConfig struct {
+    ApplyBatch int // 예약 제재 시작 처리를 한 번에 하는 건수
+}
+
+// DefaultSanctionConfig 기본 정책
+func DefaultSanctionConfig() SanctionConfig {
+    return SanctionConfig{
+        ApplyBatch: 100,
+    }
+}
+
+// SanctionService 사용자 이용 제재 (user:manage 권한 필요, 내 이력 조회 제외)
+type SanctionService interface {
+    // Issue 제재를 내리고 사용자에게 메일로 알린다. 시작된 제재면 바로 실시간 연결을 끊는다
+    Issue(ctx context.Context, userID uint, req *dto.SanctionRequest) (*dto.SanctionResponse, error)
+    // Revoke 기간 전에 해제 (이력은 남는다)
+    Revoke(ctx context.Context, id uint, req *dto.RevokeSanctionRequest) (*dto.SanctionResponse, error)
+    // ListByUser 사용자의 제재 이력 (해제/만료 포함)
+    ListByUser(ctx context.Context, userID uint) ([]*dto.SanctionResponse, error)
+    // ListMine 내 제재 이력
+    ListMine(ctx context.Context) ([]*dto.SanctionResponse, error)
+    // ApplyDue 예약했던 제재가 시작되면 세션과 실시간 연결 정리 (스케줄러에서 호출)
+    ApplyDue(ctx context.Context) (int, error)
+}
+
+type sanctionService struct {
+    db           *gorm.DB
+    sanctionRepo repository.SanctionRepository
+    userRepo     repository.UserRepository
+    store        *SanctionStore
+    emailService *EmailService
+    disconnector UserDisconnector
+    config       SanctionConfig
+    now          func() time.Time
+}
+
+func NewSanctionService(
+    db *gorm.DB,
+    sanctionRepo repository.SanctionRepository,
+    userRepo repository.UserRepository,
+    store *SanctionStore,
+    emailService *EmailService,
+    disconnector UserDisconnector,
+    config SanctionConfig,
+) SanctionService {
+    return &sanctionService{
+        db:           db,
+        sanctionRepo: sanctionRepo,
+        userRepo:     userRepo,
+        store:        store,
+        emailService: emailService,
+        disconnector: disconnector,
+        config:       config,
+        now:          time.Now,
+    }
+}
+
+// validateSanctionPeriod 끝이 있으면 시작보다 뒤이고 미래여야 한다. 기간 정지는 끝이 있어야 한다
+func validateSanctionPeriod(sanctionType domain.SanctionType, startsAt time.Time, endsAt *time.Time, now time.Time) error {
+    if endsAt == nil {
+        if sanctionType == domain.SanctionSuspended {
+            return ErrInvalidSanctionPeriod
+        }
+        return nil
+    }
+    if !endsAt.After(startsAt) || !endsAt.After(now) {
+        return ErrInvalidSanctionPeriod
+    }
+    return nil
+}
+
+func (s *sanctionService) Issue(ctx context.Context, userID uint, req *dto.SanctionRequest) (*dto.SanctionResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionUserManage); err != nil {
+        return nil, err
+    }
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+    if !req.Type.IsValid() {
+        return nil, ErrInvalidSanctionType
+    }
+
+    now := s.now()
+    startsAt := now
+    if req.StartsAt != nil && req.StartsAt.After(now) {
+        startsAt = *req.StartsAt
+    }
+    if err := validateSanctionPeriod(req.Type, startsAt, req.EndsAt, now); err != nil {
+        return nil, err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+    // 관리자 제재는 역할을 먼저 바꿔야 한다 (관리자끼리 서로 잠그는 것 방지)
+    if user.ID == claims.UserID || user.Role == domain.RoleAdmin {
+        return nil, ErrCannotSanctionUser
+    }
+
+    sanction := &domain.Sanction{
+        UserID:   user.ID,
+        Type:     req.Type,
+        Reason:   req.Reason,
+        IssuedBy: claims.UserID,
+        StartsAt: startsAt,
+        EndsAt:   req.EndsAt,
+    }
+    if err := s.sanctionRepo.Create(ctx, sanction); err != nil {
+        return nil, err
+    }
+    s.store.Invalidate(ctx)
+
+    if !sanction.StartsAt.After(now) {
+        // 실패하면 예약 제재와 같이 ApplyDue가 다시 처리한다
+        if err := s.apply(ctx, sanction); err != nil {
+            log.Printf("제재 적용 실패: sanction=%d - %v", sanction.ID, err)
+        }
+    }
+
+    // 메일 발송 실패는 제재 자체를 막지 않는다
+    if err := s.emailService.SendSanctionNotice(ctx, user.Email, user.Username, sanctionLabel(sanction.Type), sanction.Reason, sanction.StartsAt, sanction.EndsAt); err != nil {
+        log.Printf("제재 안내 메일 발송 실패: user=%d - %v", user.ID, err)
+    }
+
+    return dto.ToSanctionResponse(sanction, now), nil
+}
+
+// apply 시작된 제재의 세션 정리
+// 이용 정지는 모든 세션과 리프레시 토큰을 폐기하고, 작성 제한도 실시간 연결은 끊어 다시 연결할 때 제한이 적용되게 한다.
+func (s *sanctionService) apply(ctx context.Context, sanction *domain.Sanction) error {
+    now := s.now()
+    if sanction.Type.BlocksAccess() {
+        err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+            if _, err := revokeUserSessions(ctx, tx, sanction.UserID, 0, now); err != nil {
+                return err
+            }
+            return repository.NewRefreshTokenRepository(tx).RevokeAllByUserID(ctx, sanction.UserID, now)
+        })
+        if err != nil {
+            return err
+        }
+    }
+
+    s.disconnector.CloseUsers(sanction.UserID)
+    return s.sanctionRepo.MarkApplied(ctx, sanction.ID, now)
+}
+
+func (s *sanctionService) Revoke(ctx context.Context, id uint, req *dto.RevokeSanctionRequest) (*dto.SanctionResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionUserManage); err != nil {
+        return nil, err
+    }
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    now := s.now()
+    sanction, err := s.sanctionRepo.Revoke(ctx, id, claims.UserID, req.Reason, now)
+    if err != nil {
+        return nil, err
+    }
+    s.store.Invalidate(ctx)
+
+    if user, err := s.userRepo.FindByID(ctx, sanction.UserID); err == nil {
+        if err := s.emailService.SendSanctionRevoked(ctx, user.Email, user.Username, sanctionLabel(sanction.Type)); err != nil {
+            log.Printf("제재 해제 안내 메일 발송 실패: user=%d - %v", user.ID, err)
+        }
+    }
+
+    return dto.ToSanctionResponse(sanction, now), nil
+}
+
+func (s *sanctionService) ListByUser(ctx context.Context, userID uint) ([]*dto.SanctionResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionUserManage); err != nil {
+        return nil, err
+    }
+    return s.list(ctx, userID)
+}
+
+func (s *sanctionService) ListMine(ctx context.Context) ([]*dto.SanctionResponse, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+    return s.list(ctx, claims.UserID)
+}
+
+func (s *sanctionService) list(ctx context.Context, userID uint) ([]*dto.SanctionResponse, error) {
+    sanctions, err := s.sanctionRepo.FindByUser(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+
+    now := s.now()
+    resp := make([]*dto.SanctionResponse, 0, len(sanctions))
+    for _, sanction := range sanctions {
+        resp = append(resp, dto.ToSanctionResponse(sanction, now))
+    }
+    return resp, nil
+}
+
+func (s *sanctionService) ApplyDue(ctx context.Context) (int, error) {
+    due, err := s.sanctionRepo.FindUnapplied(ctx, s.now(), s.config.ApplyBatch)
+    if err != nil {
+        return 0, err
+    }
+
+    applied := 0
+    for _, sanction := range due {
+        if err := s.apply(ctx, sanction); err != nil {
+            // 다음 실행에서 다시 시도
+            log.Printf("제재 적용 실패: sanction=%d - %v", sanction.ID, err)
+            continue
+        }
+        applied++
+    }
+    return applied, nil
+}
+
+// sanctionLabel 안내 메일에 쓰는 제재 이름
+func sanctionLabel(sanctionType domain.SanctionType) string {
+    switch sanctionType {
+    case domain.SanctionBanned:
+        return "이용 정지"
+    case domain.SanctionSuspended:
+        return "기간 이용 정지"
+    default:
+        return "글 작성 제한"
+    }
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+)
+
+type fakeSanctionRepository struct {
+    repository.SanctionRepository
+    sanctions []*domain.Sanction
+}
+
+func (r *fakeSanctionRepository) ListCurrent(ctx context.Context, now time.Time) ([]*domain.Sanction, error) {
+    return r.sanctions, nil
+}
+
+func TestSanctionStoreActive(t *testing.T) {
+    ctx := context.Background()
+    now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+    at := func(d time.Duration) *time.Time {
+        t := now.Add(d)
+        return &t
+    }
+
+    repo := &fakeSanctionRepository{sanctions: []*domain.Sanction{
+        {ID: 1, UserID: 1, Type: domain.SanctionWriteRestricted, StartsAt: now.Add(-time.Hour)},
+        {ID: 2, UserID: 1, Type: domain.SanctionSuspended, StartsAt: now.Add(-time.Hour), EndsAt: at(time.Hour)},
+        {ID: 3, UserID: 1, Type: domain.SanctionSuspended, StartsAt: now.Add(-time.Hour), EndsAt: at(48 * time.Hour)},
+        // 해제됨, 시작 전, 끝남
+        {ID: 4, UserID: 1, Type: domain.SanctionBanned, StartsAt: now.Add(-time.Hour), RevokedAt: at(-time.Minute)},
+        {ID: 5, UserID: 2, Type: domain.SanctionBanned, StartsAt: now.Add(time.Hour)},
+        {ID: 6, UserID: 2, Type: domain.SanctionWriteRestricted, StartsAt: now.Add(-2 * time.Hour), EndsAt: at(-time.Hour)},
+        {ID: 7, UserID: 3, Type: domain.SanctionWriteRestricted, StartsAt: now.Add(-time.Hour), EndsAt: at(time.Hour)},
+    }}
+    store := NewSanctionStore(repo, cache.NewInvalidator(), time.Minute)
+    store.now = func() time.Time { return now }
+
+    // 가장 무거운 종류 중 늦게 끝나는 제재
+    got, err := store.Active(ctx, 1)
+    if err != nil || got == nil || got.ID != 3 {
+        t.Fatalf("Active(1) = %+v, %v, want sanction 3", got, err)
+    }
+    if got, _ := store.Active(ctx, 2); got != nil {
+        t.Errorf("Active(2) = %+v, want nil", got)
+    }
+
+    err = store.CheckAccess(ctx, 1)
+    var sanctioned *SanctionError
+    if !errors.As(err, &sanctioned) || !errors.Is(err, ErrAccountSuspended) || !sanctioned.Sanction.EndsAt.Equal(*at(48 * time.Hour)) {
+        t.Errorf("CheckAccess(1) error = %v, want suspended until +48h", err)
+    }
+
+    // 작성 제한은 로그인은 되고 작성만 막는다
+    if err := store.CheckAccess(ctx, 3); err != nil {
+        t.Errorf("CheckAccess(3) error = %v", err)
+    }
+    if err := store.CheckWrite(ctx, 3); !errors.Is(err, ErrWriteRestricted) || errors.Is(err, ErrAccountSuspended) {
+        t.Errorf("CheckWrite(3) error = %v, want ErrWriteRestricted", err)
+    }
+
+    // 예약 제재는 캐시를 다시 읽지 않아도 시작 시각부터 적용
+    store.now = func() time.Time { return now.Add(2 * time.Hour) }
+    if err := store.CheckAccess(ctx, 2); !errors.Is(err, ErrAccountBanned) {
+        t.Errorf("CheckAccess(2) after start error = %v, want ErrAccountBanned", err)
+    }
+
+    var nilStore *SanctionStore
+    if err := nilStore.CheckWrite(ctx, 1); err != nil {
+        t.Errorf("nil store CheckWrite() error = %v", err)
+    }
+}
+
+func TestValidateSanctionPeriod(t *testing.T) {
+    now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+    past := now.Add(-time.Hour)
+    future := now.Add(time.Hour)
+
+    tests := []struct {
+        name     string
+        typ      domain.SanctionType
+        startsAt time.Time
+        endsAt   *time.Time
+        wantErr  bool
+    }{
+        {"영구 정지", domain.SanctionBanned, now, nil, false},
+        {"기간 정지는 종료 필수", domain.SanctionSuspended, now, nil, true},
+        {"기간 정지", domain.SanctionSuspended, now, &future, false},
+        {"이미 끝남", domain.SanctionWriteRestricted, now, &past, true},
+        {"시작보다 먼저 끝남", domain.SanctionSuspended, now.Add(2 * time.Hour), &future, true},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            err := validateSanctionPeriod(tt.typ, tt.startsAt, tt.endsAt, now)
+            if (err != nil) != tt.wantErr {
+                t.Errorf("validateSanctionPeriod() error = %v, wantErr %v", err, tt.wantErr)
+            }
+        })
+    }
+}
+
+type recordingDisconnector struct {
+    closed []uint
+}
+
+func (d *recordingDisconnector) CloseUsers(userIDs ...uint) {
+    d.closed = append(d.closed, userIDs...)
+}
+
+func TestClusterDisconnector(t *testing.T) {
+    // 두 인스턴스가 같은 알림 채널을 쓴다
+    invalidator := cache.NewLocalInvalidator()
+    localA, localB := &recordingDisconnector{}, &recordingDisconnector{}
+    a := NewClusterDisconnector(invalidator, localA)
+    b := NewClusterDisconnector(invalidator, localB)
+    a.Listen(context.Background())
+    b.Listen(context.Background())
+
+    // 제재를 처리한 인스턴스뿐 아니라 다른 인스턴스의 연결도 끊긴다
+    a.CloseUsers(3, 12)
+    if len(localB.closed) != 2 || localB.closed[0] != 3 || localB.closed[1] != 12 {
+        t.Errorf("other instance closed = %v, want [3 12]", localB.closed)
+    }
+    if len(localA.closed) == 0 || localA.closed[0] != 3 {
+        t.Errorf("issuing instance closed = %v, want 3 first", localA.closed)
+    }
+}
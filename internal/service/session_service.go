@@ -0,0 +1,210 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "strings"
+    "time"
+    "unicode/utf8"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+
+    "gorm.io/gorm"
+)
+
+// SessionTerminator 폐기된 세션의 실시간 연결 종료 (ws.Hub가 구현)
+type SessionTerminator interface {
+    CloseSessions(sessionIDs ...uint)
+}
+
+// SessionConfig 세션 정책
+type SessionConfig struct {
+    TouchInterval time.Duration // 마지막 접속 시각을 DB에 반영하는 최소 간격
+}
+
+// DefaultSessionConfig 기본 정책
+func DefaultSessionConfig() SessionConfig {
+    return SessionConfig{
+        TouchInterval: time.Minute,
+    }
+}
+
+type SessionService interface {
+    // List 내 활성 세션 목록 (현재 요청의 세션 포함)
+    List(ctx context.Context) ([]*domain.Session, error)
+    // Revoke 세션 하나 로그아웃
+    Revoke(ctx context.Context, sessionID uint) error
+    // RevokeOthers 현재 세션을 제외한 모든 세션 로그아웃
+    RevokeOthers(ctx context.Context) error
+    // Validate 세션 유효성 확인 (middleware.RequireActiveSession용)
+    Validate(ctx context.Context, sessionID uint, ip string) (bool, error)
+}
+
+type sessionService struct {
+    db          *gorm.DB
+    sessionRepo repository.SessionRepository
+    terminator  SessionTerminator
+    config      SessionConfig
+    now         func() time.Time
+}
+
+func NewSessionService(
+    db *gorm.DB,
+    sessionRepo repository.SessionRepository,
+    terminator SessionTerminator,
+    config SessionConfig,
+) SessionService {
+    return &sessionService{
+        db:          db,
+        sessionRepo: sessionRepo,
+        terminator:  terminator,
+        config:      config,
+        now:         time.Now,
+    }
+}
+
+func (s *sessionService) List(ctx context.Context) ([]*domain.Session, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    return s.sessionRepo.FindActiveByUserID(ctx, claims.UserID, s.now())
+}
+
+func (s *sessionService) Revoke(ctx context.Context, sessionID uint) error {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return err
+    }
+
+    now := s.now()
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        // 다른 사용자의 세션 ID는 없는 것으로 취급
+        if err := repository.NewSessionRepository(tx).Revoke(ctx, sessionID, claims.UserID, now); err != nil {
+            if errors.Is(err, repository.ErrSessionNotFound) {
+                return ErrSessionNotFound
+            }
+            return err
+        }
+        return repository.NewRefreshTokenRepository(tx).RevokeBySessionIDs(ctx, []uint{sessionID}, now)
+    })
+    if err != nil {
+        return err
+    }
+
+    s.terminator.CloseSessions(sessionID)
+    return nil
+}
+
+func (s *sessionService) RevokeOthers(ctx context.Context) error {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return err
+    }
+
+    ids, err := revokeUserSessions(ctx, s.db, claims.UserID, claims.SessionID, s.now())
+    if err != nil {
+        return err
+    }
+
+    s.terminator.CloseSessions(ids...)
+    return nil
+}
+
+func (s *sessionService) Validate(ctx context.Context, sessionID uint, ip string) (bool, error) {
+    session, err := s.sessionRepo.FindByID(ctx, sessionID)
+    if err != nil {
+        if errors.Is(err, repository.ErrSessionNotFound) {
+            return false, nil
+        }
+        return false, err
+    }
+
+    now := s.now()
+    if !session.IsActive(now) {
+        return false, nil
+    }
+
+    // 요청마다 쓰지 않도록 일정 간격이 지났을 때만 갱신
+    if now.Sub(session.LastSeenAt) >= s.config.TouchInterval {
+        if err := s.sessionRepo.Touch(ctx, session.ID, ip, now, now.Add(-s.config.TouchInterval)); err != nil {
+            return false, err
+        }
+    }
+
+    return true, nil
+}
+
+// revokeUserSessions 사용자의 세션과 리프레시 토큰을 함께 폐기하고 폐기된 세션 ID 반환
+// db에 트랜잭션을 넘기면 호출자의 트랜잭션 안에서 실행된다.
+func revokeUserSessions(ctx context.Context, db *gorm.DB, userID, exceptSessionID uint, now time.Time) ([]uint, error) {
+    var ids []uint
+    err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        var err error
+        ids, err = repository.NewSessionRepository(tx).RevokeAllByUserID(ctx, userID, exceptSessionID, now)
+        if err != nil {
+            return err
+        }
+        return repository.NewRefreshTokenRepository(tx).RevokeBySessionIDs(ctx, ids, now)
+    })
+    return ids, err
+}
+
+// truncateRunes 글자 수 기준으로 자르기 (바이트로 자르면 한글이 중간에 잘려 DB가 거부한다)
+func truncateRunes(s string, max int) string {
+    if utf8.RuneCountInString(s) <= max {
+        return s
+    }
+    return string([]rune(s)[:max])
+}
+
+// deviceLabel User-Agent로 "Chrome on Windows" 형태의 기기 이름 생성
+func deviceLabel(userAgent string) string {
+    ua := strings.ToLower(userAgent)
+    if ua == "" {
+        return "알 수 없는 기기"
+    }
+
+    var browser string
+    switch {
+    case strings.Contains(ua, "edg/"):
+        browser = "Edge"
+    case strings.Contains(ua, "opr/") || strings.Contains(ua, "opera"):
+        browser = "Opera"
+    case strings.Contains(ua, "samsungbrowser"):
+        browser = "Samsung Internet"
+    case strings.Contains(ua, "firefox/"):
+        browser = "Firefox"
+    case strings.Contains(ua, "chrome/") || strings.Contains(ua, "crios/"):
+        browser = "Chrome"
+    case strings.Contains(ua, "safari/"):
+        browser = "Safari"
+    case strings.Contains(ua, "okhttp"), strings.Contains(ua, "dalvik"):
+        browser = "Android 앱"
+    case strings.Contains(ua, "cfnetwork"):
+        browser = "iOS 앱"
+    default:
+        browser = "기타 클라이언트"
+    }
+
+    var platform string
+    switch {
+    case strings.Contains(ua, "android"):
+        platform = "Android"
+    case strings.Contains(ua, "iphone") || strings.Contains(ua, "ipad") || strings.Contains(ua, "cfnetwork"):
+        platform = "iOS"
+    case strings.Contains(ua, "windows"):
+        platform = "Windows"
+    case strings.Contains(ua, "mac os x") || strings.Contains(ua, "macintosh"):
+        platform = "macOS"
+    case strings.Contains(ua, "linux"):
+        platform = "Linux"
+    }
+
+    if platform == "" || strings.HasSuffix(browser, "앱") {
+        return browser
+    }
+    return browser + " on " + platform
+}
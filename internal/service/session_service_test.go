@@ -0,0 +1,47 @@
+package service
+
+import "testing"
+
+func TestDeviceLabel(t *testing.T) {
+    tests := []struct {
+        name      string
+        userAgent string
+        want      string
+    }{
+        {"빈 값", "", "알 수 없는 기기"},
+        {"Windows Chrome", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36", "Chrome on Windows"},
+        {"Windows Edge", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.0.0", "Edge on Windows"},
+        {"macOS Safari", "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_1) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15", "Safari on macOS"},
+        {"iPhone Safari", "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1", "Safari on iOS"},
+        {"Android Samsung", "Mozilla/5.0 (Linux; Android 13; SM-S918N) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36", "Samsung Internet on Android"},
+        {"Linux Firefox", "Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0", "Firefox on Linux"},
+        {"Android 앱", "okhttp/4.12.0", "Android 앱"},
+        {"curl", "curl/8.4.0", "기타 클라이언트"},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := deviceLabel(tt.userAgent); got != tt.want {
+                t.Errorf("deviceLabel() = %q, want %q", got, tt.want)
+            }
+        })
+    }
+}
+
+func TestTruncateRunes(t *testing.T) {
+    tests := []struct {
+        s    string
+        max  int
+        want string
+    }{
+        {"내 노트북", 10, "내 노트북"},
+        {"내 노트북", 3, "내 노"},
+        {"Chrome", 3, "Chr"},
+    }
+
+    for _, tt := range tests {
+        if got := truncateRunes(tt.s, tt.max); got != tt.want {
+            t.Errorf("truncateRunes(%q, %d) = %q, want %q", tt.s, tt.max, got, tt.want)
+        }
+    }
+}
@@ -0,0 +1,376 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "log"
+    "regexp"
+    "strings"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/oidc"
+    "goboardapi/internal/repository"
+
+    "gorm.io/gorm"
+)
+
+// SocialLoginConfig 외부 로그인 정책
+type SocialLoginConfig struct {
+    StateTTL time.Duration // 인가 요청 후 콜백까지 허용 시간
+}
+
+// DefaultSocialLoginConfig 기본 정책
+func DefaultSocialLoginConfig() SocialLoginConfig {
+    return SocialLoginConfig{
+        StateTTL: 10 * time.Minute,
+    }
+}
+
+type SocialLoginService interface {
+    // StartLogin 로그인용 인가 URL 생성
+    StartLogin(ctx context.Context, provider string) (string, error)
+    // StartLink 로그인한 사용자의 계정 연결용 인가 URL 생성
+    StartLink(ctx context.Context, provider string) (string, error)
+    // Callback 인가 코드를 교환하고 로그인 또는 계정 연결을 완료
+    Callback(ctx context.Context, provider, state, code string) (*dto.SocialLoginResponse, error)
+    ListIdentities(ctx context.Context) ([]*domain.ExternalIdentity, error)
+    // Unlink 연결 해제 (비밀번호가 없는 계정은 로그인 수단이 사라지므로 거부)
+    Unlink(ctx context.Context, provider string) error
+}
+
+type socialLoginService struct {
+    db           *gorm.DB
+    providers    map[string]*oidc.Provider
+    identityRepo repository.ExternalIdentityRepository
+    userRepo     repository.UserRepository
+    authSvc      AuthService
+    wordFilter   *WordFilterService
+    config       SocialLoginConfig
+    now          func() time.Time
+}
+
+func NewSocialLoginService(
+    db *gorm.DB,
+    providers []*oidc.Provider,
+    identityRepo repository.ExternalIdentityRepository,
+    userRepo repository.UserRepository,
+    authSvc AuthService,
+    wordFilter *WordFilterService,
+    config SocialLoginConfig,
+) SocialLoginService {
+    byName := make(map[string]*oidc.Provider, len(providers))
+    for _, p := range providers {
+        byName[p.Name()] = p
+    }
+
+    return &socialLoginService{
+        db:           db,
+        providers:    byName,
+        identityRepo: identityRepo,
+        userRepo:     userRepo,
+        authSvc:      authSvc,
+        wordFilter:   wordFilter,
+        config:       config,
+        now:          time.Now,
+    }
+}
+
+func (s *socialLoginService) StartLogin(ctx context.Context, provider string) (string, error) {
+    return s.start(ctx, provider, nil)
+}
+
+func (s *socialLoginService) StartLink(ctx context.Context, provider string) (string, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return "", err
+    }
+    return s.start(ctx, provider, &claims.UserID)
+}
+
+// start state/nonce/PKCE verifier를 저장하고 인가 URL 생성
+// state는 해시만 저장하고, nonce와 verifier는 콜백에서 그대로 써야 하므로 원문을 저장한다.
+func (s *socialLoginService) start(ctx context.Context, name string, linkUserID *uint) (string, error) {
+    provider, ok := s.providers[name]
+    if !ok {
+        return "", ErrUnknownProvider
+    }
+
+    state, stateHash, err := auth.GenerateToken()
+    if err != nil {
+        return "", err
+    }
+    nonce, err := oidc.RandomString(32)
+    if err != nil {
+        return "", err
+    }
+    verifier, err := oidc.RandomString(64)
+    if err != nil {
+        return "", err
+    }
+
+    loginState := &domain.OIDCLoginState{
+        StateHash:    stateHash,
+        Provider:     name,
+        Nonce:        nonce,
+        CodeVerifier: verifier,
+        LinkUserID:   linkUserID,
+        ExpiresAt:    s.now().Add(s.config.StateTTL),
+    }
+    if err := s.identityRepo.CreateState(ctx, loginState); err != nil {
+        return "", err
+    }
+
+    return provider.AuthCodeURL(state, nonce, verifier), nil
+}
+
+func (s *socialLoginService) Callback(ctx context.Context, name, state, code string) (*dto.SocialLoginResponse, error) {
+    provider, ok := s.providers[name]
+    if !ok {
+        return nil, ErrUnknownProvider
+    }
+
+    loginState, err := s.identityRepo.ConsumeState(ctx, auth.HashToken(state))
+    if err != nil {
+        if errors.Is(err, repository.ErrLoginStateNotFound) {
+            return nil, ErrInvalidOIDCState
+        }
+        return nil, err
+    }
+    // 다른 제공자의 state를 재사용하는 경우도 거부
+    if loginState.Provider != name || s.now().After(loginState.ExpiresAt) {
+        return nil, ErrInvalidOIDCState
+    }
+
+    token, err := provider.Exchange(ctx, code, loginState.CodeVerifier)
+    if err != nil {
+        log.Printf("OIDC 토큰 교환 실패: provider=%s - %v", name, err)
+        return nil, ErrOIDCAuthenticationFailed
+    }
+
+    idToken, err := provider.VerifyIDToken(ctx, token.IDToken, loginState.Nonce)
+    if err != nil {
+        log.Printf("OIDC ID 토큰 검증 실패: provider=%s - %v", name, err)
+        return nil, ErrOIDCAuthenticationFailed
+    }
+
+    if loginState.LinkUserID != nil {
+        if err := s.link(ctx, *loginState.LinkUserID, name, idToken); err != nil {
+            return nil, err
+        }
+        return &dto.SocialLoginResponse{Provider: name, Linked: true}, nil
+    }
+
+    user, err := s.resolveUser(ctx, name, idToken)
+    if err != nil {
+        return nil, err
+    }
+
+    // 2단계 인증 사용자는 여기서도 challenge_token만 받는다
+    tokens, err := s.authSvc.CompleteLogin(ctx, user)
+    if err != nil {
+        return nil, err
+    }
+
+    return &dto.SocialLoginResponse{Provider: name, TokenResponse: tokens}, nil
+}
+
+// link 로그인한 사용자에게 외부 계정 연결
+func (s *socialLoginService) link(ctx context.Context, userID uint, provider string, idToken *oidc.IDToken) error {
+    existing, err := s.identityRepo.FindByProviderSubject(ctx, provider, idToken.Subject)
+    if err == nil {
+        if existing.UserID == userID {
+            return nil
+        }
+        return ErrIdentityLinkedToOtherUser
+    }
+    if !errors.Is(err, repository.ErrExternalIdentityNotFound) {
+        return err
+    }
+
+    return s.createIdentity(ctx, s.identityRepo, userID, provider, idToken)
+}
+
+// resolveUser 외부 계정에 해당하는 사용자 찾기
+// 1) 이미 연결된 계정 2) 이메일이 같고 이메일 인증을 마친 기존 계정에 연결 3) 새 계정 생성
+func (s *socialLoginService) resolveUser(ctx context.Context, provider string, idToken *oidc.IDToken) (*domain.User, error) {
+    identity, err := s.identityRepo.FindByProviderSubject(ctx, provider, idToken.Subject)
+    if err == nil {
+        return s.userRepo.FindByID(ctx, identity.UserID)
+    }
+    if !errors.Is(err, repository.ErrExternalIdentityNotFound) {
+        return nil, err
+    }
+
+    // 제공자가 검증하지 않은 이메일로 연결하면 남의 계정을 가로챌 수 있다
+    if idToken.Email == "" || !idToken.EmailVerified {
+        return nil, ErrOIDCEmailNotVerified
+    }
+
+    var user *domain.User
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        userRepo := repository.NewUserRepository(tx)
+        identityRepo := repository.NewExternalIdentityRepository(tx)
+
+        existing, err := linkableAccount(ctx, userRepo, repository.NewDormantAccountRepository(tx), idToken.Email)
+        if err != nil {
+            return err
+        }
+        user = existing
+        if user == nil {
+            user, err = s.createUser(ctx, userRepo, idToken)
+            if err != nil {
+                return err
+            }
+        }
+
+        return s.createIdentity(ctx, identityRepo, user.ID, provider, idToken)
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    return user, nil
+}
+
+// linkableAccount 외부 계정을 자동으로 연결할 기존 계정 (같은 이메일 계정이 없으면 nil)
+// 이메일 인증을 마치지 않은 계정은 다른 사람이 피해자의 이메일로 먼저 가입해 둔 것일 수 있다.
+// 그대로 연결하면 가입한 사람의 비밀번호가 계속 통하므로, 본인이 비밀번호로 로그인한 뒤 StartLink로 연결하게 한다.
+// 휴면 계정의 이메일은 분리 보관 테이블에만 있으므로 새 계정을 만들기 전에 따로 확인한다 (Signup과 같음).
+func linkableAccount(ctx context.Context, userRepo repository.UserRepository, dormantRepo repository.DormantAccountRepository, email string) (*domain.User, error) {
+    user, err := userRepo.FindByEmail(ctx, email)
+    if errors.Is(err, repository.ErrUserNotFound) {
+        _, err := dormantRepo.FindByEmail(ctx, email)
+        if err == nil {
+            return nil, ErrAccountDormant
+        }
+        if !errors.Is(err, repository.ErrDormantAccountNotFound) {
+            return nil, err
+        }
+        return nil, nil
+    }
+    if err != nil {
+        return nil, err
+    }
+    if !user.IsEmailVerified() {
+        return nil, ErrLinkRequiresLogin
+    }
+    return user, nil
+}
+
+// createUser 비밀번호 없는 계정 생성 (이메일은 제공자가 인증)
+func (s *socialLoginService) createUser(ctx context.Context, userRepo repository.UserRepository, idToken *oidc.IDToken) (*domain.User, error) {
+    username, err := s.availableUsername(ctx, userRepo, idToken)
+    if err != nil {
+        return nil, err
+    }
+
+    now := s.now()
+    user := &domain.User{
+        Email:           idToken.Email,
+        Username:        username,
+        Role:            domain.RoleUser,
+        EmailVerifiedAt: &now,
+    }
+    if err := userRepo.Create(ctx, user); err != nil {
+        return nil, err
+    }
+
+    return user, nil
+}
+
+// createIdentity 외부 계정 연결 저장 (사용자당 제공자별 하나)
+func (s *socialLoginService) createIdentity(ctx context.Context, identityRepo repository.ExternalIdentityRepository, userID uint, provider string, idToken *oidc.IDToken) error {
+    identities, err := identityRepo.FindByUserID(ctx, userID)
+    if err != nil {
+        return err
+    }
+    for _, identity := range identities {
+        if identity.Provider == provider {
+            // 같은 제공자의 다른 계정이 이미 연결되어 있음
+            return ErrProviderAlreadyLinked
+        }
+    }
+
+    return identityRepo.Create(ctx, &domain.ExternalIdentity{
+        UserID:   userID,
+        Provider: provider,
+        Subject:  idToken.Subject,
+        Email:    idToken.Email,
+    })
+}
+
+var usernameDisallowed = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// availableUsername 이름 또는 이메일 앞부분으로 중복되지 않는 사용자명 생성
+// 금칙어가 들어간 이름은 가입 화면과 같이 쓰지 않고 "user"로 대신한다.
+func (s *socialLoginService) availableUsername(ctx context.Context, userRepo repository.UserRepository, idToken *oidc.IDToken) (string, error) {
+    base := usernameDisallowed.ReplaceAllString(strings.SplitN(idToken.Email, "@", 2)[0], "")
+    if len(base) > 40 {
+        base = base[:40]
+    }
+    if len(base) < 2 || s.wordFilter.CheckName(ctx, FilterFieldUsername, base) != nil {
+        base = "user"
+    }
+
+    candidate := base
+    for i := 0; i < 5; i++ {
+        if i > 0 {
+            suffix, err := oidc.RandomString(4)
+            if err != nil {
+                return "", err
+            }
+            candidate = base + "_" + strings.ToLower(usernameDisallowed.ReplaceAllString(suffix, ""))
+        }
+        // 임의로 붙인 접미사로 금칙어가 만들어질 수도 있다
+        if s.wordFilter.CheckName(ctx, FilterFieldUsername, candidate) != nil {
+            continue
+        }
+
+        _, err := userRepo.FindByUsername(ctx, candidate)
+        if errors.Is(err, repository.ErrUserNotFound) {
+            return candidate, nil
+        }
+        if err != nil {
+            return "", err
+        }
+    }
+
+    return "", ErrUsernameUnavailable
+}
+
+func (s *socialLoginService) ListIdentities(ctx context.Context) ([]*domain.ExternalIdentity, error) {
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+
+    return s.identityRepo.FindByUserID(ctx, claims.UserID)
+}
+
+func (s *socialLoginService) Unlink(ctx context.Context, provider string) error {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, claims.UserID)
+    if err != nil {
+        return err
+    }
+    if !user.HasPassword() {
+        return ErrPasswordRequiredToUnlink
+    }
+
+    if err := s.identityRepo.Delete(ctx, user.ID, provider); err != nil {
+        if errors.Is(err, repository.ErrExternalIdentityNotFound) {
+            return ErrIdentityNotLinked
+        }
+        return err
+    }
+
+    return nil
+}
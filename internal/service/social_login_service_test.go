@@ -0,0 +1,95 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/oidc"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/wordfilter"
+)
+
+func (r *fakeUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+    for _, user := range r.users {
+        if user.Email == email {
+            return user, nil
+        }
+    }
+    return nil, repository.ErrUserNotFound
+}
+
+func (r *fakeUserRepository) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
+    for _, user := range r.users {
+        if user.Username == username {
+            return user, nil
+        }
+    }
+    return nil, repository.ErrUserNotFound
+}
+
+type fakeDormantAccountRepository struct {
+    repository.DormantAccountRepository
+    emails map[string]bool
+}
+
+func (r *fakeDormantAccountRepository) FindByEmail(ctx context.Context, email string) (*domain.DormantAccount, error) {
+    if !r.emails[email] {
+        return nil, repository.ErrDormantAccountNotFound
+    }
+    return &domain.DormantAccount{Email: email}, nil
+}
+
+func TestLinkableAccount(t *testing.T) {
+    ctx := context.Background()
+    verifiedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        1: {ID: 1, Email: "verified@example.com", EmailVerifiedAt: &verifiedAt},
+        2: {ID: 2, Email: "victim@example.com"},
+    }}
+    dormant := &fakeDormantAccountRepository{emails: map[string]bool{"dormant@example.com": true}}
+
+    user, err := linkableAccount(ctx, users, dormant, "verified@example.com")
+    if err != nil || user == nil || user.ID != 1 {
+        t.Errorf("linkableAccount(verified) = %+v, %v, want user 1", user, err)
+    }
+
+    // 미인증 계정은 다른 사람이 먼저 가입했을 수 있으므로 자동 연결하지 않는다
+    user, err = linkableAccount(ctx, users, dormant, "victim@example.com")
+    if !errors.Is(err, ErrLinkRequiresLogin) || user != nil {
+        t.Errorf("linkableAccount(unverified) = %+v, %v, want ErrLinkRequiresLogin", user, err)
+    }
+
+    user, err = linkableAccount(ctx, users, dormant, "new@example.com")
+    if err != nil || user != nil {
+        t.Errorf("linkableAccount(new) = %+v, %v, want nil, nil", user, err)
+    }
+
+    // 휴면 계정의 이메일로 두 번째 계정을 만들지 않는다
+    if _, err := linkableAccount(ctx, users, dormant, "dormant@example.com"); !errors.Is(err, ErrAccountDormant) {
+        t.Errorf("linkableAccount(dormant) error = %v, want ErrAccountDormant", err)
+    }
+}
+
+func TestAvailableUsernameBannedWord(t *testing.T) {
+    ctx := context.Background()
+    words := &fakeBannedWordRepository{words: []*domain.BannedWord{
+        {Word: "casino", Action: wordfilter.ActionMask},
+    }}
+    s := &socialLoginService{wordFilter: NewWordFilterService(words, nil, cache.NewInvalidator(), time.Minute)}
+    users := &fakeUserRepository{users: map[uint]*domain.User{}}
+
+    // 이메일 앞부분에 금칙어가 있으면 중립적인 이름으로 대신한다
+    got, err := s.availableUsername(ctx, users, &oidc.IDToken{Email: "casino_king@example.com"})
+    if err != nil || got != "user" {
+        t.Errorf("availableUsername() = %q, %v, want user", got, err)
+    }
+
+    got, err = s.availableUsername(ctx, users, &oidc.IDToken{Email: "gopher@example.com"})
+    if err != nil || got != "gopher" {
+        t.Errorf("availableUsername() = %q, %v, want gopher", got, err)
+    }
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "log"
+    "strings"
+    "time"
+    "unicode/utf8"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/spam"
+)
+
+// 휴리스틱 신호 하나가 더하는 점수 (여러 신호가 겹쳐야 보류 기준을 넘도록 정함)
+const (
+    spamLinkDensityWeight = 0.6
+    spamVelocityWeight    = 0.6
+    spamDuplicateWeight   = 0.7
+
+    // spamFingerprintMinRunes 이보다 짧은 본문은 중복 확인에서 뺀다 ("감사합니다" 같은 짧은 댓글)
+    spamFingerprintMinRunes = 20
+    spamReasonsMax          = 255
+)
+
+// SpamConfig 스팸 판정 정책
+type SpamConfig struct {
+    HoldThreshold      float64       // 이 점수 이상이면 보류 큐로 보냄
+    MinTrainingSamples int           // 스팸/정상 판정이 각각 이만큼 쌓여야 베이즈 점수를 씀
+    TrainingLimit      int           // 학습에 쓰는 최근 판정 수 (게시글/댓글 각각)
+    MinLinks           int           // 링크가 이만큼 이상일 때만 링크 밀도를 봄
+    LinkDensity        float64       // 단어 대비 링크 비율이 이 이상이면 의심
+    NewAccountAge      time.Duration // 가입 후 이 기간 안의 계정만 작성 속도를 봄
+    VelocityWindow     time.Duration
+    VelocityLimit      int // 새 계정이 VelocityWindow 안에 이만큼 이상 작성하면 의심
+    DuplicateWindow    time.Duration
+    DuplicateLimit     int // 같은 본문이 DuplicateWindow 안에 이만큼 이상 올라오면 의심
+}
+
+// DefaultSpamConfig 기본 정책
+func DefaultSpamConfig() SpamConfig {
+    return SpamConfig{
+        HoldThreshold:      0.8,
+        MinTrainingSamples: 20,
+        TrainingLimit:      5000,
+        MinLinks:           2,
+        LinkDensity:        0.2,
+        NewAccountAge:      72 * time.Hour,
+        VelocityWindow:     10 * time.Minute,
+        VelocityLimit:      5,
+        DuplicateWindow:    24 * time.Hour,
+        DuplicateLimit:     3,
+    }
+}
+
+// SpamCandidate 스팸 판정 대상 (작성 직전의 게시글/댓글)
+type SpamCandidate struct {
+    AuthorID   uint
+    TargetType domain.ReportTargetType
+    Title      string
+    Content    string
+}
+
+// SpamVerdict 판정 결과
+type SpamVerdict struct {
+    Score      float64
+    Suspicious bool
+    Reasons    []string
+}
+
+// add 신호 하나를 합친다 (noisy-or: 1 - Π(1 - s))
+func (v *SpamVerdict) add(score float64, reason string) {
+    v.Score = 1 - (1-v.Score)*(1-score)
+    v.Reasons = append(v.Reasons, reason)
+}
+
+// SpamClassifier 게시글/댓글 스팸 판정 (BuiltinSpamClassifier가 구현)
+type SpamClassifier interface {
+    Classify(ctx context.Context, candidate *SpamCandidate) (*SpamVerdict, error)
+}
+
+// BuiltinSpamClassifier 모더레이터 판정으로 학습한 나이브 베이즈 모델과
+// 링크 밀도, 새 계정 작성 속도, 중복 본문 휴리스틱을 합친 기본 분류기
+type BuiltinSpamClassifier struct {
+    model    *spam.Model
+    spamRepo repository.SpamRepository
+    userRepo repository.UserRepository
+    config   SpamConfig
+    now      func() time.Time
+}
+
+func NewBuiltinSpamClassifier(spamRepo repository.SpamRepository, userRepo repository.UserRepository, config SpamConfig) *BuiltinSpamClassifier {
+    return &BuiltinSpamClassifier{
+        model:    spam.NewModel(config.MinTrainingSamples),
+        spamRepo: spamRepo,
+        userRepo: userRepo,
+        config:   config,
+        now:      time.Now,
+    }
+}
+
+// Classify 판정하고 중복 확인용 본문 지문을 남긴다
+func (c *BuiltinSpamClassifier) Classify(ctx context.Context, candidate *SpamCandidate) (*SpamVerdict, error) {
+    now := c.now()
+    text := strings.TrimSpace(candidate.Title + "\n" + candidate.Content)
+    verdict := &SpamVerdict{}
+
+    if p, ok := c.model.Score(text); ok {
+        verdict.add(p, fmt.Sprintf("bayes=%.2f", p))
+    }
+
+    if len(spam.Links(text)) >= c.config.MinLinks {
+        if density := spam.LinkDensity(text); density >= c.config.LinkDensity {
+            verdict.add(spamLinkDensityWeight, fmt.Sprintf("link_density=%.2f", density))
+        }
+    }
+
+    author, err := c.userRepo.FindByID(ctx, candidate.AuthorID)
+    if err != nil {
+        return nil, err
+    }
+    if now.Sub(author.CreatedAt) < c.config.NewAccountAge {
+        recent, err := c.spamRepo.CountRecentContent(ctx, author.ID, now.Add(-c.config.VelocityWindow))
+        if err != nil {
+            return nil, err
+        }
+        // 지금 작성하는 것까지 센다
+        if recent+1 >= int64(c.config.VelocityLimit) {
+            verdict.add(spamVelocityWeight, fmt.Sprintf("new_account_velocity=%d", recent+1))
+        }
+    }
+
+    if hash, ok := contentFingerprint(candidate.Content); ok {
+        seen, err := c.spamRepo.CountFingerprints(ctx, hash, now.Add(-c.config.DuplicateWindow))
+        if err != nil {
+            return nil, err
+        }
+        if seen+1 >= int64(c.config.DuplicateLimit) {
+            verdict.add(spamDuplicateWeight, fmt.Sprintf("duplicate=%d", seen+1))
+        }
+        if err := c.spamRepo.AddFingerprint(ctx, &domain.SpamFingerprint{Hash: hash, AuthorID: author.ID, CreatedAt: now}); err != nil {
+            return nil, err
+        }
+    }
+
+    verdict.Suspicious = verdict.Score >= c.config.HoldThreshold
+    return verdict, nil
+}
+
+// contentFingerprint 대소문자와 공백 차이를 무시한 본문 해시 (짧은 본문은 ok=false)
+func contentFingerprint(content string) (string, bool) {
+    normalized := strings.Join(strings.Fields(strings.ToLower(content)), " ")
+    if utf8.RuneCountInString(normalized) < spamFingerprintMinRunes {
+        return "", false
+    }
+    sum := sha256.Sum256([]byte(normalized))
+    return hex.EncodeToString(sum[:]), true
+}
+
+// Train 모더레이션 큐의 최근 판정으로 모델을 다시 학습 (스케줄러에서 호출, 인스턴스마다 같은 데이터로 학습)
+func (c *BuiltinSpamClassifier) Train(ctx context.Context) (int, error) {
+    rows, err := c.spamRepo.FindTrainingSamples(ctx, c.config.TrainingLimit)
+    if err != nil {
+        return 0, err
+    }
+
+    samples := make([]spam.Sample, 0, len(rows))
+    for _, row := range rows {
+        samples = append(samples, spam.Sample{Text: row.Text, Spam: row.Spam})
+    }
+    c.model.Fit(samples)
+    return len(samples), nil
+}
+
+// Cleanup 중복 확인 기간이 지난 지문 정리 (스케줄러에서 호출)
+func (c *BuiltinSpamClassifier) Cleanup(ctx context.Context) (int64, error) {
+    return c.spamRepo.DeleteFingerprintsBefore(ctx, c.now().Add(-c.config.DuplicateWindow))
+}
+
+// SpamGate 게시글/댓글 작성 단계의 스팸 확인
+// 의심스러운 콘텐츠는 거부하지 않고 숨긴 채 저장한 뒤 보류 큐(spam_filter 처리 건)에 넣는다.
+// 분류기 오류는 작성을 막지 않는다.
+type SpamGate struct {
+    classifier SpamClassifier
+    reportRepo repository.ReportRepository
+    now        func() time.Time
+}
+
+func NewSpamGate(classifier SpamClassifier, reportRepo repository.ReportRepository) *SpamGate {
+    return &SpamGate{
+        classifier: classifier,
+        reportRepo: reportRepo,
+        now:        time.Now,
+    }
+}
+
+// Screen 저장 전에 호출. 보류해야 하면 판정 결과를, 아니면 nil을 돌려준다
+func (g *SpamGate) Screen(ctx context.Context, candidate *SpamCandidate) *SpamVerdict {
+    if g == nil || g.classifier == nil {
+        return nil
+    }
+
+    verdict, err := g.classifier.Classify(ctx, candidate)
+    if err != nil {
+        log.Printf("스팸 판정 실패 (통과 처리): author=%d - %v", candidate.AuthorID, err)
+        return nil
+    }
+    if !verdict.Suspicious {
+        return nil
+    }
+    return verdict
+}
+
+// Hold 숨긴 채 저장한 콘텐츠를 보류 큐에 넣는다
+// 실패하면 숨김을 풀어 콘텐츠가 어느 큐에도 없이 사라지지 않게 한다.
+func (g *SpamGate) Hold(ctx context.Context, targetType domain.ReportTargetType, targetID, authorID uint, boardID *uint, verdict *SpamVerdict) error {
+    reasons := strings.Join(verdict.Reasons, ", ")
+    if r := []rune(reasons); len(r) > spamReasonsMax {
+        reasons = string(r[:spamReasonsMax])
+    }
+
+    c := &domain.ModerationCase{
+        TargetType:  targetType,
+        TargetID:    targetID,
+        BoardID:     boardID,
+        AuthorID:    authorID,
+        Status:      domain.ModerationOpen,
+        Source:      domain.ModerationSourceSpamFilter,
+        AutoHidden:  true,
+        OpenedAt:    g.now(),
+        SpamScore:   verdict.Score,
+        SpamReasons: reasons,
+    }
+    if err := g.reportRepo.CreateHold(ctx, c); err != nil {
+        if unhideErr := g.reportRepo.UnhideTarget(ctx, targetType, targetID); unhideErr != nil {
+            log.Printf("보류 실패 후 숨김 해제 실패: %s %d - %v", targetType, targetID, unhideErr)
+        }
+        return err
+    }
+    return nil
+}
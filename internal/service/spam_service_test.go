@@ -0,0 +1,219 @@
+package service
+
+import (
+    "context"
+    "strings"
+    "testing"
+    "time"
+
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+)
+
+type fakeSpamRepository struct {
+    repository.SpamRepository
+    recent       int64
+    fingerprints map[string]int64
+    samples      []repository.SpamSample
+}
+
+func (r *fakeSpamRepository) CountRecentContent(ctx context.Context, authorID uint, since time.Time) (int64, error) {
+    return r.recent, nil
+}
+
+func (r *fakeSpamRepository) CountFingerprints(ctx context.Context, hash string, since time.Time) (int64, error) {
+    return r.fingerprints[hash], nil
+}
+
+func (r *fakeSpamRepository) AddFingerprint(ctx context.Context, fingerprint *domain.SpamFingerprint) error {
+    r.fingerprints[fingerprint.Hash]++
+    return nil
+}
+
+func (r *fakeSpamRepository) FindTrainingSamples(ctx context.Context, limit int) ([]repository.SpamSample, error) {
+    return r.samples, nil
+}
+
+type fakeUserRepository struct {
+    repository.UserRepository
+    users map[uint]*domain.User
+}
+
+func (r *fakeUserRepository) FindByID(ctx context.Context, id uint) (*domain.User, error) {
+    user, ok := r.users[id]
+    if !ok {
+        return nil, repository.ErrUserNotFound
+    }
+    return user, nil
+}
+
+func TestBuiltinSpamClassifier(t *testing.T) {
+    ctx := context.Background()
+    now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+    users := &fakeUserRepository{users: map[uint]*domain.User{
+        1: {ID: 1, CreatedAt: now.Add(-time.Hour)},
+        2: {ID: 2, CreatedAt: now.Add(-365 * 24 * time.Hour)},
+    }}
+    newClassifier := func(repo *fakeSpamRepository) *BuiltinSpamClassifier {
+        config := DefaultSpamConfig()
+        config.MinTrainingSamples = 2
+        classifier := NewBuiltinSpamClassifier(repo, users, config)
+        classifier.now = func() time.Time { return now }
+        return classifier
+    }
+    links := "최저가 http://a.example http://b.example 지금 확인"
+
+    tests := []struct {
+        name    string
+        repo    *fakeSpamRepository
+        author  uint
+        content string
+        want    bool
+    }{
+        {"평범한 글", &fakeSpamRepository{}, 2, "오늘 모임 장소는 2층 회의실입니다", false},
+        {"링크만 많음", &fakeSpamRepository{}, 2, links, false},
+        {"새 계정이 빠르게 링크 글 작성", &fakeSpamRepository{recent: 4}, 1, links, true},
+        {"오래된 계정은 작성 속도를 보지 않음", &fakeSpamRepository{recent: 10}, 2, links, false},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            tt.repo.fingerprints = map[string]int64{}
+            verdict, err := newClassifier(tt.repo).Classify(ctx, &SpamCandidate{AuthorID: tt.author, Content: tt.content})
+            if err != nil {
+                t.Fatalf("Classify() error = %v", err)
+            }
+            if verdict.Suspicious != tt.want {
+                t.Errorf("Suspicious = %v (score %.2f, %v), want %v", verdict.Suspicious, verdict.Score, verdict.Reasons, tt.want)
+            }
+        })
+    }
+
+    t.Run("같은 링크 글 반복", func(t *testing.T) {
+        classifier := newClassifier(&fakeSpamRepository{fingerprints: map[string]int64{}})
+        content := links + " 한정 수량 이벤트 진행 중"
+        var verdict *SpamVerdict
+        for i := 0; i < 3; i++ {
+            v, err := classifier.Classify(ctx, &SpamCandidate{AuthorID: 2, Content: content})
+            if err != nil {
+                t.Fatalf("Classify() error = %v", err)
+            }
+            verdict = v
+        }
+        if !verdict.Suspicious || !strings.Contains(strings.Join(verdict.Reasons, ","), "duplicate=3") {
+            t.Errorf("third duplicate = %+v, want suspicious with duplicate=3", verdict)
+        }
+    })
+
+    t.Run("모더레이터 판정으로 학습", func(t *testing.T) {
+        repo := &fakeSpamRepository{fingerprints: map[string]int64{}, samples: []repository.SpamSample{
+            {Text: "카지노 무료 머니 지급", Spam: true},
+            {Text: "카지노 가입 머니 즉시 지급", Spam: true},
+            {Text: "스터디 모집 공지", Spam: false},
+            {Text: "스터디 일정 변경 공지", Spam: false},
+        }}
+        classifier := newClassifier(repo)
+        if n, err := classifier.Train(ctx); err != nil || n != 4 {
+            t.Fatalf("Train() = %d, %v", n, err)
+        }
+        verdict, err := classifier.Classify(ctx, &SpamCandidate{AuthorID: 2, Content: "카지노 머니 지급 이벤트"})
+        if err != nil {
+            t.Fatalf("Classify() error = %v", err)
+        }
+        if !verdict.Suspicious {
+            t.Errorf("trained spam = %+v, want suspicious", verdict)
+        }
+    })
+}
+
+type failingHoldRepository struct {
+    repository.ReportRepository
+    unhidden bool
+}
+
+func (r *failingHoldRepository) CreateHold(ctx context.Context, c *domain.ModerationCase) error {
+    return context.DeadlineExceeded
+}
+
+func (r *failingHoldRepository) UnhideTarget(ctx context.Context, targetType domain.ReportTargetType, targetID uint) error {
+    r.unhidden = true
+    return nil
+}
+
+func TestSpamGateHoldFailureUnhides(t *testing.T) {
+    repo := &failingHoldRepository{}
+    gate := NewSpamGate(nil, repo)
+
+    if verdict := gate.Screen(context.Background(), &SpamCandidate{AuthorID: 1}); verdict != nil {
+        t.Errorf("Screen() without classifier = %+v, want nil", verdict)
+    }
+    if err := gate.Hold(context.Background(), domain.ReportTargetComment, 5, 1, nil, &SpamVerdict{Score: 0.9}); err == nil {
+        t.Fatal("Hold() should return the repository error")
+    }
+    if !repo.unhidden {
+        t.Error("content should be unhidden when it cannot be queued")
+    }
+}
+
+type fixedSpamClassifier struct {
+    verdict *SpamVerdict
+}
+
+func (c *fixedSpamClassifier) Classify(ctx context.Context, candidate *SpamCandidate) (*SpamVerdict, error) {
+    return c.verdict, nil
+}
+
+type recordingHoldRepository struct {
+    repository.ReportRepository
+    holds []*domain.ModerationCase
+}
+
+func (r *recordingHoldRepository) CreateHold(ctx context.Context, c *domain.ModerationCase) error {
+    r.holds = append(r.holds, c)
+    return nil
+}
+
+type recordingFeedService struct {
+    FeedService
+    posts []uint
+}
+
+func (f *recordingFeedService) OnPostCreated(ctx context.Context, post *domain.Post) error {
+    f.posts = append(f.posts, post.ID)
+    return nil
+}
+
+func TestPostWriteSpamHold(t *testing.T) {
+    verifiedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+    users := &fakeUserRepository{users: map[uint]*domain.User{1: {ID: 1, EmailVerifiedAt: &verifiedAt}}}
+    classifier := &fixedSpamClassifier{verdict: &SpamVerdict{Score: 0.95, Suspicious: true, Reasons: []string{"links"}}}
+    holds := &recordingHoldRepository{}
+    feed := &recordingFeedService{}
+    svc := NewPostWriteService(&fakePostRepository{}, users, feed, NewSpamGate(classifier, holds), nil, nil)
+    ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: 1})
+
+    // 스팸 의심 글은 숨긴 채 저장하고 보류 큐에 넣으며 피드에는 올리지 않는다
+    post, err := svc.Create(ctx, &dto.CreatePostRequest{Title: "싸게 팝니다", Content: "http://spam.example"})
+    if err != nil {
+        t.Fatalf("Create() error = %v", err)
+    }
+    if post.HiddenAt == nil {
+        t.Error("suspicious post should be saved hidden")
+    }
+    if len(holds.holds) != 1 || holds.holds[0].TargetType != domain.ReportTargetPost || holds.holds[0].TargetID != post.ID || !holds.holds[0].AutoHidden {
+        t.Fatalf("holds = %+v, want one auto-hidden hold for post %d", holds.holds, post.ID)
+    }
+    if len(feed.posts) != 0 {
+        t.Errorf("held post fanned out to feeds: %v", feed.posts)
+    }
+
+    classifier.verdict = &SpamVerdict{Score: 0.1}
+    post, err = svc.Create(ctx, &dto.CreatePostRequest{Title: "안녕하세요", Content: "반갑습니다"})
+    if err != nil || post.HiddenAt != nil {
+        t.Fatalf("Create(clean) = hidden %v, %v, want visible", post.HiddenAt, err)
+    }
+    if len(holds.holds) != 1 || len(feed.posts) != 1 {
+        t.Errorf("clean post holds = %d, fan-outs = %d, want 1 and 1", len(holds.holds), len(feed.posts))
+    }
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+    "context"
+    "log"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+)
+
+// TemporaryGrantConfig 기간 한정 부여 정책
+type TemporaryGrantConfig struct {
+    MaxDuration time.Duration // 한 번에 부여할 수 있는 최대 기간
+    Retention   time.Duration // 끝난 부여 기록 보관 기간
+    ExpireBatch int           // 정리 작업이 한 번에 처리하는 만료 건수
+}
+
+// DefaultTemporaryGrantConfig 기본 정책
+func DefaultTemporaryGrantConfig() TemporaryGrantConfig {
+    return TemporaryGrantConfig{
+        MaxDuration: 30 * 24 * time.Hour,
+        Retention:   90 * 24 * time.Hour,
+        ExpireBatch: 100,
+    }
+}
+
+// TemporaryGrantService 기간 한정 역할/권한 부여 (role:manage 권한 필요)
+type TemporaryGrantService interface {
+    // Grant 부여 후 바로 모든 인스턴스에 반영하고 사용자에게 알림
+    Grant(ctx context.Context, req *dto.TemporaryGrantRequest) (*dto.TemporaryGrantResponse, error)
+    ListByUser(ctx context.Context, userID uint) ([]*dto.TemporaryGrantResponse, error)
+    // Revoke 기간 전에 끝낸다 (만료 알림은 정리 작업이 보냄)
+    Revoke(ctx context.Context, id uint) error
+    // ExpireDue 끝난 부여의 사용자에게 만료를 알리고 보관 기간이 지난 기록 삭제 (스케줄러에서 호출)
+    ExpireDue(ctx context.Context) (int, error)
+}
+
+type temporaryGrantService struct {
+    grantRepo       repository.TemporaryGrantRepository
+    roleRepo        repository.RoleRepository
+    userRepo        repository.UserRepository
+    boardRepo       repository.BoardRepository
+    store           *PermissionStore
+    notificationSvc *NotificationService
+    config          TemporaryGrantConfig
+    now             func() time.Time
+}
+
+func NewTemporaryGrantService(
+    grantRepo repository.TemporaryGrantRepository,
+    roleRepo repository.RoleRepository,
+    userRepo repository.UserRepository,
+    boardRepo repository.BoardRepository,
+    store *PermissionStore,
+    notificationSvc *NotificationService,
+    config TemporaryGrantConfig,
+) TemporaryGrantService {
+    return &temporaryGrantService{
+        grantRepo:       grantRepo,
+        roleRepo:        roleRepo,
+        userRepo:        userRepo,
+        boardRepo:       boardRepo,
+        store:           store,
+        notificationSvc: notificationSvc,
+        config:          config,
+        now:             time.Now,
+    }
+}
+
+// validateGrantPeriod 시작이 끝보다 앞서고, 끝이 미래이며, 최대 기간을 넘지 않아야 한다
+func validateGrantPeriod(startsAt, endsAt, now time.Time, maxDuration time.Duration) error {
+    if !endsAt.After(startsAt) || !endsAt.After(now) {
+        return ErrInvalidGrantPeriod
+    }
+    if endsAt.Sub(startsAt) > maxDuration {
+        return ErrInvalidGrantPeriod
+    }
+    return nil
+}
+
+func (s *temporaryGrantService) Grant(ctx context.Context, req *dto.TemporaryGrantRequest) (*dto.TemporaryGrantResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+    claims, _ := middleware.GetUserFromContext(ctx)
+
+    if (req.Role == "") == (req.Permission == "") {
+        return nil, ErrInvalidGrantTarget
+    }
+
+    now := s.now()
+    startsAt := now
+    if req.StartsAt != nil && req.StartsAt.After(now) {
+        startsAt = *req.StartsAt
+    }
+    if err := validateGrantPeriod(startsAt, req.EndsAt, now, s.config.MaxDuration); err != nil {
+        return nil, err
+    }
+
+    if _, err := s.userRepo.FindByID(ctx, req.UserID); err != nil {
+        return nil, err
+    }
+    if req.Role != "" {
+        if _, err := s.roleRepo.FindRole(ctx, domain.Role(req.Role)); err != nil {
+            return nil, err
+        }
+    } else if _, err := s.roleRepo.FindPermission(ctx, domain.Permission(req.Permission)); err != nil {
+        return nil, err
+    }
+
+    grant := &domain.TemporaryGrant{
+        UserID:     req.UserID,
+        Role:       domain.Role(req.Role),
+        Permission: domain.Permission(req.Permission),
+        Reason:     req.Reason,
+        GrantedBy:  claims.UserID,
+        StartsAt:   startsAt,
+        EndsAt:     req.EndsAt,
+    }
+    if req.BoardID > 0 {
+        if _, err := s.boardRepo.FindByID(ctx, req.BoardID); err != nil {
+            return nil, err
+        }
+        scope := domain.BoardScope(req.BoardID)
+        grant.ScopeType, grant.ScopeID = scope.Type, scope.ID
+    }
+
+    if err := s.grantRepo.Create(ctx, grant); err != nil {
+        return nil, err
+    }
+    s.store.Invalidate(ctx)
+
+    if err := s.notificationSvc.NotifyTemporaryGrant(ctx, grant); err != nil {
+        log.Printf("임시 권한 부여 알림 실패: grant=%d - %v", grant.ID, err)
+    }
+    return dto.ToTemporaryGrantResponse(grant, now), nil
+}
+
+func (s *temporaryGrantService) ListByUser(ctx context.Context, userID uint) ([]*dto.TemporaryGrantResponse, error) {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return nil, err
+    }
+
+    grants, err := s.grantRepo.FindByUser(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+
+    now := s.now()
+    resp := make([]*dto.TemporaryGrantResponse, 0, len(grants))
+    for _, grant := range grants {
+        resp = append(resp, dto.ToTemporaryGrantResponse(grant, now))
+    }
+    return resp, nil
+}
+
+func (s *temporaryGrantService) Revoke(ctx context.Context, id uint) error {
+    if err := auth.RequirePermission(ctx, domain.PermissionRoleManage); err != nil {
+        return err
+    }
+
+    if _, err := s.grantRepo.End(ctx, id, s.now()); err != nil {
+        return err
+    }
+    // 캐시에 남아 있어도 기간 비교로 바로 적용되지 않지만, 다른 인스턴스의 목록도 정리한다
+    s.store.Invalidate(ctx)
+    return nil
+}
+
+func (s *temporaryGrantService) ExpireDue(ctx context.Context) (int, error) {
+    now := s.now()
+    notified := 0
+
+    for {
+        grants, err := s.grantRepo.FindExpiredUnnotified(ctx, now, s.config.ExpireBatch)
+        if err != nil {
+            return notified, err
+        }
+        if len(grants) == 0 {
+            break
+        }
+
+        for _, grant := range grants {
+            // 알림이 실패해도 표시는 남긴다 (같은 만료를 반복해서 처리하지 않도록)
+            if err := s.notificationSvc.NotifyTemporaryGrantExpired(ctx, grant); err != nil {
+                log.Printf("임시 권한 만료 알림 실패: grant=%d - %v", grant.ID, err)
+            }
+            if err := s.grantRepo.MarkExpiryNotified(ctx, grant.ID, now); err != nil {
+                return notified, err
+            }
+            notified++
+        }
+    }
+
+    if notified > 0 {
+        // 캐시 ttl을 기다리지 않고 끝난 부여를 목록에서 비운다
+        s.store.Invalidate(ctx)
+    }
+
+    if _, err := s.grantRepo.DeleteEndedBefore(ctx, now.Add(-s.config.Retention)); err != nil {
+        return notified, err
+    }
+    return notified, nil
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/repository"
+)
+
+// TwoFactorConfig 2단계 인증 정책
+type TwoFactorConfig struct {
+    Issuer            string // 인증 앱에 표시될 서비스 이름
+    RequireForAdmin   bool   // true면 관리자는 2단계 인증 없이 관리 기능을 쓸 수 없다
+    RecoveryCodeCount int
+}
+
+// DefaultTwoFactorConfig 기본 정책
+func DefaultTwoFactorConfig(issuer string) TwoFactorConfig {
+    return TwoFactorConfig{
+        Issuer:            issuer,
+        RequireForAdmin:   true,
+        RecoveryCodeCount: 10,
+    }
+}
+
+type TwoFactorService interface {
+    // Setup 새 비밀키를 발급한다 (Confirm 전까지는 로그인에 적용되지 않음)
+    Setup(ctx context.Context) (*dto.TwoFactorSetupResponse, error)
+    // Confirm 인증 앱의 코드로 등록을 확정하고 복구 코드를 발급한다
+    Confirm(ctx context.Context, code string) ([]string, error)
+    // Disable 비밀번호와 코드를 확인한 뒤 2단계 인증을 해제한다
+    Disable(ctx context.Context, password, code string) error
+    // RegenerateRecoveryCodes 복구 코드를 새로 발급한다 (기존 코드는 폐기)
+    RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error)
+
+    // Verify 로그인 2단계에서 TOTP 코드 또는 복구 코드를 검증한다
+    Verify(ctx context.Context, userID uint, code string) error
+    // IsEnabled 사용자의 2단계 인증 사용 여부
+    IsEnabled(ctx context.Context, userID uint) (bool, error)
+    // IsRequired 역할에 2단계 인증이 의무인지 여부
+    IsRequired(role domain.Role) bool
+}
+
+type twoFactorService struct {
+    twoFactorRepo  repository.TwoFactorRepository
+    userRepo       repository.UserRepository
+    passwordHasher auth.PasswordHasher
+    config         TwoFactorConfig
+    now            func() time.Time
+}
+
+func NewTwoFactorService(
+    twoFactorRepo repository.TwoFactorRepository,
+    userRepo repository.UserRepository,
+    passwordHasher auth.PasswordHasher,
+    config TwoFactorConfig,
+) TwoFactorService {
+    return &twoFactorService{
+        twoFactorRepo:  twoFactorRepo,
+        userRepo:       userRepo,
+        passwordHasher: passwordHasher,
+        config:         config,
+        now:            time.Now,
+    }
+}
+
+func (s *twoFactorService) Setup(ctx context.Context) (*dto.TwoFactorSetupResponse, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    twoFactor, err := s.twoFactorRepo.FindByUserID(ctx, claims.UserID)
+    if err != nil && !errors.Is(err, repository.ErrTwoFactorNotFound) {
+        return nil, err
+    }
+    if twoFactor != nil && twoFactor.IsEnabled() {
+        return nil, ErrTwoFactorAlreadyEnabled
+    }
+
+    secret, err := auth.GenerateTOTPSecret()
+    if err != nil {
+        return nil, err
+    }
+
+    // 확인 전 등록은 새 비밀키로 덮어쓴다
+    if twoFactor == nil {
+        twoFactor = &domain.TwoFactor{UserID: claims.UserID}
+    }
+    twoFactor.Secret = secret
+    twoFactor.LastUsedStep = 0
+    if err := s.twoFactorRepo.Save(ctx, twoFactor); err != nil {
+        return nil, err
+    }
+
+    return &dto.TwoFactorSetupResponse{
+        Secret:     secret,
+        OTPAuthURI: auth.TOTPURI(s.config.Issuer, claims.Email, secret),
+    }, nil
+}
+
+func (s *twoFactorService) Confirm(ctx context.Context, code string) ([]string, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    twoFactor, err := s.twoFactorRepo.FindByUserID(ctx, claims.UserID)
+    if err != nil {
+        if errors.Is(err, repository.ErrTwoFactorNotFound) {
+            return nil, ErrTwoFactorSetupNotStarted
+        }
+        return nil, err
+    }
+    if twoFactor.IsEnabled() {
+        return nil, ErrTwoFactorAlreadyEnabled
+    }
+
+    now := s.now()
+    step, ok := auth.ValidateTOTP(twoFactor.Secret, code, now, twoFactor.LastUsedStep)
+    if !ok {
+        return nil, ErrInvalidTwoFactorCode
+    }
+
+    if err := s.twoFactorRepo.Enable(ctx, claims.UserID, step, now); err != nil {
+        return nil, err
+    }
+
+    return s.issueRecoveryCodes(ctx, claims.UserID)
+}
+
+func (s *twoFactorService) Disable(ctx context.Context, password, code string) error {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return err
+    }
+
+    if s.IsRequired(domain.Role(claims.Role)) {
+        return ErrTwoFactorRequiredForRole
+    }
+
+    user, err := s.userRepo.FindByID(ctx, claims.UserID)
+    if err != nil {
+        return err
+    }
+    if !s.passwordHasher.Compare(user.Password, password) {
+        return ErrWrongPassword
+    }
+
+    if err := s.Verify(ctx, claims.UserID, code); err != nil {
+        return err
+    }
+
+    return s.twoFactorRepo.DeleteByUserID(ctx, claims.UserID)
+}
+
+func (s *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error) {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    if err := s.Verify(ctx, claims.UserID, code); err != nil {
+        return nil, err
+    }
+
+    return s.issueRecoveryCodes(ctx, claims.UserID)
+}
+
+func (s *twoFactorService) Verify(ctx context.Context, userID uint, code string) error {
+    twoFactor, err := s.twoFactorRepo.FindByUserID(ctx, userID)
+    if err != nil {
+        if errors.Is(err, repository.ErrTwoFactorNotFound) {
+            return ErrTwoFactorNotEnabled
+        }
+        return err
+    }
+    if !twoFactor.IsEnabled() {
+        return ErrTwoFactorNotEnabled
+    }
+
+    now := s.now()
+
+    // 1. TOTP 코드
+    if step, ok := auth.ValidateTOTP(twoFactor.Secret, code, now, twoFactor.LastUsedStep); ok {
+        // 같은 코드가 동시에 두 번 쓰이지 않도록 조건부 업데이트
+        if err := s.twoFactorRepo.UpdateLastUsedStep(ctx, userID, step); err != nil {
+            if errors.Is(err, repository.ErrTOTPStepReused) {
+                return ErrInvalidTwoFactorCode
+            }
+            return err
+        }
+        return nil
+    }
+
+    // 2. 복구 코드 (일회용)
+    codeHash := auth.HashToken(auth.NormalizeRecoveryCode(code))
+    if err := s.twoFactorRepo.UseRecoveryCode(ctx, userID, codeHash, now); err != nil {
+        if errors.Is(err, repository.ErrRecoveryCodeNotFound) {
+            return ErrInvalidTwoFactorCode
+        }
+        return err
+    }
+
+    return nil
+}
+
+func (s *twoFactorService) IsEnabled(ctx context.Context, userID uint) (bool, error) {
+    twoFactor, err := s.twoFactorRepo.FindByUserID(ctx, userID)
+    if err != nil {
+        if errors.Is(err, repository.ErrTwoFactorNotFound) {
+            return false, nil
+        }
+        return false, err
+    }
+    return twoFactor.IsEnabled(), nil
+}
+
+func (s *twoFactorService) IsRequired(role domain.Role) bool {
+    return s.config.RequireForAdmin && role == domain.RoleAdmin
+}
+
+// issueRecoveryCodes 복구 코드를 발급하고 해시만 저장
+func (s *twoFactorService) issueRecoveryCodes(ctx context.Context, userID uint) ([]string, error) {
+    codes, err := auth.GenerateRecoveryCodes(s.config.RecoveryCodeCount)
+    if err != nil {
+        return nil, err
+    }
+
+    hashes := make([]string, len(codes))
+    for i, code := range codes {
+        hashes[i] = auth.HashToken(code)
+    }
+
+    if err := s.twoFactorRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+        return nil, err
+    }
+
+    return codes, nil
+}
@@ -10,7 +10,7 @@ func (s *userService) RestoreAccount(ctx context.Context, email, password string
     }
 
     // 삭제된 지 30일 이내인지 확인
-    if time.Since(user.DeletedAt.Time) > 30*24*time.Hour {
+    if time.Since(user.DeletedAt.Time) > domain.WithdrawRestoreWindow {
         return errors.New("복구 기간이 만료되었습니다")
     }
 
@@ -19,6 +19,11 @@ func (s *userService) RestoreAccount(ctx context.Context, email, password string
         return ErrWrongPassword
     }
 
-    // 복구 처리
-    return s.db.Unscoped().Model(&user).Update("deleted_at", nil).Error
+    // 복구 처리 (영구 삭제 대상에서 제외)
+    return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        if err := tx.Unscoped().Model(&user).Update("deleted_at", nil).Error; err != nil {
+            return err
+        }
+        return repository.NewWithdrawalRepository(tx).MarkRestored(ctx, user.ID, time.Now())
+    })
 }
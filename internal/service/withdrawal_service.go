@@ -0,0 +1,203 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/storage"
+
+    "gorm.io/gorm"
+)
+
+// WithdrawalConfig 회원 탈퇴 정책
+//
+// 탈퇴하면 계정을 소프트 삭제하고 세션을 모두 끊는다. domain.WithdrawRestoreWindow 동안은
+// RestoreAccount로 되살릴 수 있고, 그 뒤 Purge가 선택한 ContentPolicy를 적용하고 개인 정보를 지운다.
+type WithdrawalConfig struct {
+    DefaultContentPolicy domain.ContentPolicy // 요청에 처리 방식이 없을 때
+    SystemUsername       string               // transfer 방식에서 콘텐츠를 넘겨받는 계정
+    PurgeBatch           int
+}
+
+// DefaultWithdrawalConfig 기본 정책
+func DefaultWithdrawalConfig() WithdrawalConfig {
+    return WithdrawalConfig{
+        DefaultContentPolicy: domain.ContentAnonymize,
+        SystemUsername:       "system",
+        PurgeBatch:           100,
+    }
+}
+
+type WithdrawalService interface {
+    // Withdraw 현재 사용자 탈퇴 (관리자는 ErrCannotWithdrawAdmin)
+    Withdraw(ctx context.Context, req *dto.WithdrawRequest) error
+    // Purge 복구 기간이 지난 탈퇴 계정 영구 삭제 (스케줄러에서 호출)
+    Purge(ctx context.Context) (int, error)
+}
+
+type withdrawalService struct {
+    db             *gorm.DB
+    userRepo       repository.UserRepository
+    withdrawRepo   repository.WithdrawalRepository
+    storage        storage.Storage
+    passwordHasher auth.PasswordHasher
+    terminator     SessionTerminator
+    config         WithdrawalConfig
+    now            func() time.Time
+}
+
+func NewWithdrawalService(
+    db *gorm.DB,
+    userRepo repository.UserRepository,
+    withdrawRepo repository.WithdrawalRepository,
+    storage storage.Storage,
+    passwordHasher auth.PasswordHasher,
+    terminator SessionTerminator,
+    config WithdrawalConfig,
+) WithdrawalService {
+    return &withdrawalService{
+        db:             db,
+        userRepo:       userRepo,
+        withdrawRepo:   withdrawRepo,
+        storage:        storage,
+        passwordHasher: passwordHasher,
+        terminator:     terminator,
+        config:         config,
+        now:            time.Now,
+    }
+}
+
+func (s *withdrawalService) Withdraw(ctx context.Context, req *dto.WithdrawRequest) error {
+    claims, err := currentInteractiveUser(ctx)
+    if err != nil {
+        return err
+    }
+
+    user, err := s.userRepo.FindByID(ctx, claims.UserID)
+    if err != nil {
+        return err
+    }
+    if user.Role == domain.RoleAdmin {
+        return ErrCannotWithdrawAdmin
+    }
+    // 외부 로그인으로만 가입한 계정은 비밀번호를 먼저 설정해야 한다
+    if !user.HasPassword() || !s.passwordHasher.Compare(user.Password, req.Password) {
+        return ErrWrongPassword
+    }
+
+    policy := domain.ContentPolicy(req.Content)
+    if policy == "" {
+        policy = s.config.DefaultContentPolicy
+    }
+    if !policy.IsValid() {
+        return repository.ErrUnknownContentPolicy
+    }
+
+    now := s.now()
+    var sessionIDs []uint
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        err := repository.NewWithdrawalRepository(tx).CreateLog(ctx, &domain.WithdrawLog{
+            UserID:        user.ID,
+            Email:         user.Email,
+            Reason:        req.Reason,
+            ContentPolicy: policy,
+            PurgeAfter:    now.Add(domain.WithdrawRestoreWindow),
+        })
+        if err != nil {
+            return err
+        }
+
+        if err := tx.Delete(&domain.User{}, user.ID).Error; err != nil {
+            return err
+        }
+
+        sessionIDs, err = revokeUserSessions(ctx, tx, user.ID, 0, now)
+        return err
+    })
+    if err != nil {
+        return err
+    }
+
+    s.terminator.CloseSessions(sessionIDs...)
+    return nil
+}
+
+func (s *withdrawalService) Purge(ctx context.Context) (int, error) {
+    log := middleware.LoggerFromRequestContext(ctx)
+
+    logs, err := s.withdrawRepo.FindDue(ctx, s.now(), s.config.PurgeBatch)
+    if err != nil {
+        return 0, err
+    }
+
+    var systemUser *domain.User
+    purged := 0
+    for _, withdrawLog := range logs {
+        if withdrawLog.ContentPolicy == domain.ContentTransfer && systemUser == nil {
+            systemUser, err = s.withdrawRepo.EnsureSystemUser(ctx, s.config.SystemUsername)
+            if err != nil {
+                return purged, err
+            }
+        }
+
+        if err := s.purgeOne(ctx, withdrawLog, systemUser); err != nil {
+            // 한 계정의 실패로 나머지를 막지 않는다 (다음 실행에서 다시 시도)
+            log.Warn("탈퇴 계정 영구 삭제 실패", "user_id", withdrawLog.UserID, "error", err)
+            if err := s.withdrawRepo.RecordPurgeFailure(ctx, withdrawLog.ID, s.now()); err != nil {
+                log.Warn("탈퇴 계정 영구 삭제 실패 기록 실패", "user_id", withdrawLog.UserID, "error", err)
+            }
+            continue
+        }
+        purged++
+    }
+
+    return purged, nil
+}
+
+func (s *withdrawalService) purgeOne(ctx context.Context, withdrawLog *domain.WithdrawLog, systemUser *domain.User) error {
+    now := s.now()
+
+    user, err := s.withdrawRepo.FindWithdrawnUser(ctx, withdrawLog.UserID)
+    if errors.Is(err, repository.ErrUserNotFound) {
+        // 복구 기록 없이 되살아난 계정 (관리자 복구 등)
+        return s.withdrawRepo.MarkRestored(ctx, withdrawLog.UserID, now)
+    }
+    if err != nil {
+        return err
+    }
+
+    var systemUserID uint
+    if systemUser != nil {
+        systemUserID = systemUser.ID
+    }
+
+    err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        repo := repository.NewWithdrawalRepository(tx)
+        if err := repo.ApplyContentPolicy(ctx, user.ID, withdrawLog.ContentPolicy, systemUserID); err != nil {
+            return err
+        }
+        if err := repo.DeleteUserData(ctx, user.ID); err != nil {
+            return err
+        }
+        if err := repo.ScrubUser(ctx, user.ID); err != nil {
+            return err
+        }
+        return repo.MarkPurged(ctx, withdrawLog.ID, now)
+    })
+    if err != nil {
+        return err
+    }
+
+    if user.AvatarKey != "" {
+        if err := s.storage.Delete(ctx, user.AvatarKey); err != nil {
+            middleware.LoggerFromRequestContext(ctx).Warn("탈퇴 계정 프로필 이미지 삭제 실패", "user_id", user.ID, "error", err)
+        }
+    }
+    return nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+
+    "golang.org/x/crypto/bcrypt"
+    "gorm.io/driver/sqlite"
+    "gorm.io/gorm"
+)
+
+type fakeSessionTerminator struct {
+    closed []uint
+}
+
+func (t *fakeSessionTerminator) CloseSessions(sessionIDs ...uint) {
+    t.closed = append(t.closed, sessionIDs...)
+}
+
+// newWithdrawalTestDB 탈퇴와 영구 삭제가 건드리는 테이블만 만든 인메모리 DB
+func newWithdrawalTestDB(t *testing.T) *gorm.DB {
+    t.Helper()
+    db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+    if err != nil {
+        t.Fatal(err)
+    }
+    err = db.AutoMigrate(
+        &domain.User{}, &domain.Post{}, &domain.Comment{}, &domain.Like{}, &domain.Message{},
+        &domain.Follow{}, &domain.FeedItem{}, &domain.Notification{}, &domain.UserBlock{},
+        &domain.RoleAssignment{}, &domain.TemporaryGrant{}, &domain.Report{}, &domain.SpamFingerprint{},
+        &domain.ConversationMember{}, &domain.Session{}, &domain.RefreshToken{}, &domain.PersonalAccessToken{},
+        &domain.TwoFactor{}, &domain.RecoveryCode{}, &domain.ExternalIdentity{}, &domain.EmailVerification{},
+        &domain.PasswordReset{}, &domain.WithdrawLog{},
+    )
+    if err != nil {
+        t.Fatal(err)
+    }
+    return db
+}
+
+func newWithdrawalTestUser(t *testing.T, db *gorm.DB, hasher auth.PasswordHasher, user *domain.User) *domain.User {
+    t.Helper()
+    hashed, err := hasher.Hash("password123")
+    if err != nil {
+        t.Fatal(err)
+    }
+    user.Password = hashed
+    if err := db.Create(user).Error; err != nil {
+        t.Fatal(err)
+    }
+    return user
+}
+
+func TestWithdrawAndRestore(t *testing.T) {
+    db := newWithdrawalTestDB(t)
+    hasher := auth.NewBcryptHasher(bcrypt.MinCost)
+    terminator := &fakeSessionTerminator{}
+    userRepo := repository.NewUserRepository(db)
+    svc := NewWithdrawalService(db, userRepo, repository.NewWithdrawalRepository(db), nil, hasher, terminator, DefaultWithdrawalConfig())
+
+    user := newWithdrawalTestUser(t, db, hasher, &domain.User{Email: "leaver@example.com", Username: "leaver"})
+    admin := newWithdrawalTestUser(t, db, hasher, &domain.User{Email: "admin@example.com", Username: "admin", Role: domain.RoleAdmin})
+    session := &domain.Session{UserID: user.ID, LastSeenAt: time.Now(), ExpiresAt: time.Now().Add(time.Hour)}
+    if err := db.Create(session).Error; err != nil {
+        t.Fatal(err)
+    }
+
+    ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: user.ID})
+    if err := svc.Withdraw(ctx, &dto.WithdrawRequest{Password: "wrong"}); !errors.Is(err, ErrWrongPassword) {
+        t.Errorf("Withdraw(wrong password) error = %v, want ErrWrongPassword", err)
+    }
+    adminCtx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: admin.ID})
+    if err := svc.Withdraw(adminCtx, &dto.WithdrawRequest{Password: "password123"}); !errors.Is(err, ErrCannotWithdrawAdmin) {
+        t.Errorf("Withdraw(admin) error = %v, want ErrCannotWithdrawAdmin", err)
+    }
+
+    if err := svc.Withdraw(ctx, &dto.WithdrawRequest{Password: "password123", Reason: "bye"}); err != nil {
+        t.Fatalf("Withdraw() error = %v", err)
+    }
+
+    // 계정은 소프트 삭제되고 세션은 폐기되며 실시간 연결도 끊긴다
+    if _, err := userRepo.FindByID(context.Background(), user.ID); !errors.Is(err, repository.ErrUserNotFound) {
+        t.Errorf("FindByID() after withdraw error = %v, want ErrUserNotFound", err)
+    }
+    var revoked domain.Session
+    db.First(&revoked, session.ID)
+    if revoked.RevokedAt == nil || len(terminator.closed) != 1 || terminator.closed[0] != session.ID {
+        t.Errorf("session revoked = %v, closed = %v, want session %d closed", revoked.RevokedAt, terminator.closed, session.ID)
+    }
+    var withdrawLog domain.WithdrawLog
+    db.Where("user_id = ?", user.ID).First(&withdrawLog)
+    if withdrawLog.ContentPolicy != domain.ContentAnonymize || withdrawLog.Email != user.Email {
+        t.Errorf("withdraw log = %+v, want anonymize with email", withdrawLog)
+    }
+
+    // 복구 기간 안에는 되살릴 수 있고, 되살린 계정은 영구 삭제 대상에서 빠진다
+    users := &userService{db: db, passwordHasher: hasher}
+    if err := users.RestoreAccount(context.Background(), user.Email, "wrong"); !errors.Is(err, ErrWrongPassword) {
+        t.Errorf("RestoreAccount(wrong password) error = %v, want ErrWrongPassword", err)
+    }
+    if err := users.RestoreAccount(context.Background(), user.Email, "password123"); err != nil {
+        t.Fatalf("RestoreAccount() error = %v", err)
+    }
+    if _, err := userRepo.FindByID(context.Background(), user.ID); err != nil {
+        t.Errorf("FindByID() after restore error = %v", err)
+    }
+
+    svc.(*withdrawalService).now = func() time.Time { return time.Now().Add(domain.WithdrawRestoreWindow + time.Hour) }
+    if purged, err := svc.Purge(context.Background()); err != nil || purged != 0 {
+        t.Errorf("Purge() after restore = %d, %v, want 0", purged, err)
+    }
+}
+
+func TestWithdrawalPurge(t *testing.T) {
+    db := newWithdrawalTestDB(t)
+    hasher := auth.NewBcryptHasher(bcrypt.MinCost)
+    svc := NewWithdrawalService(db, repository.NewUserRepository(db), repository.NewWithdrawalRepository(db), nil, hasher, &fakeSessionTerminator{}, DefaultWithdrawalConfig())
+    withdrawnAt := time.Now()
+    svc.(*withdrawalService).now = func() time.Time { return withdrawnAt }
+
+    mover := newWithdrawalTestUser(t, db, hasher, &domain.User{Email: "mover@example.com", Username: "mover", FollowerCount: 1})
+    deleter := newWithdrawalTestUser(t, db, hasher, &domain.User{Email: "deleter@example.com", Username: "deleter"})
+    moved := &domain.Post{Title: "옮길 글", Content: "본문", AuthorID: mover.ID}
+    deleted := &domain.Post{Title: "지울 글", Content: "본문", AuthorID: deleter.ID}
+    db.Create(moved)
+    db.Create(deleted)
+    db.Create(&domain.Follow{FollowerID: deleter.ID, TargetType: domain.FollowTargetUser, TargetID: mover.ID})
+
+    for _, leaver := range []struct {
+        user   *domain.User
+        policy domain.ContentPolicy
+    }{{mover, domain.ContentTransfer}, {deleter, domain.ContentDelete}} {
+        ctx := middleware.WithUser(context.Background(), &middleware.Claims{UserID: leaver.user.ID})
+        if err := svc.Withdraw(ctx, &dto.WithdrawRequest{Password: "password123", Content: string(leaver.policy)}); err != nil {
+            t.Fatalf("Withdraw(%s) error = %v", leaver.policy, err)
+        }
+    }
+
+    // 복구 기간 안에는 지우지 않는다
+    if purged, err := svc.Purge(context.Background()); err != nil || purged != 0 {
+        t.Fatalf("Purge() within window = %d, %v, want 0", purged, err)
+    }
+
+    svc.(*withdrawalService).now = func() time.Time { return withdrawnAt.Add(domain.WithdrawRestoreWindow + time.Minute) }
+    if purged, err := svc.Purge(context.Background()); err != nil || purged != 2 {
+        t.Fatalf("Purge() = %d, %v, want 2", purged, err)
+    }
+
+    var system domain.User
+    if err := db.Where("username = ?", "system").First(&system).Error; err != nil {
+        t.Fatalf("system user not created: %v", err)
+    }
+    var post domain.Post
+    db.First(&post, moved.ID)
+    if post.AuthorID != system.ID {
+        t.Errorf("transferred post author = %d, want system user %d", post.AuthorID, system.ID)
+    }
+    if err := db.First(&domain.Post{}, deleted.ID).Error; !errors.Is(err, gorm.ErrRecordNotFound) {
+        t.Errorf("deleted post lookup error = %v, want not found", err)
+    }
+
+    // 개인 정보는 지우고 행은 소프트 삭제 상태로 남긴다
+    var scrubbed domain.User
+    db.Unscoped().First(&scrubbed, mover.ID)
+    if scrubbed.Email == mover.Email || scrubbed.Password != "" || scrubbed.FollowerCount != 0 || !scrubbed.DeletedAt.Valid {
+        t.Errorf("scrubbed user = %+v, want PII removed and still deleted", scrubbed)
+    }
+    var follows int64
+    db.Model(&domain.Follow{}).Count(&follows)
+    if follows != 0 {
+        t.Errorf("follows = %d, want 0", follows)
+    }
+
+    var logs []domain.WithdrawLog
+    db.Find(&logs)
+    for _, l := range logs {
+        if l.PurgedAt == nil || l.Email != "" {
+            t.Errorf("withdraw log %d = %+v, want purged with email cleared", l.ID, l)
+        }
+    }
+    if purged, err := svc.Purge(context.Background()); err != nil || purged != 0 {
+        t.Errorf("Purge() again = %d, %v, want 0", purged, err)
+    }
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+    "context"
+    "fmt"
+    "log"
+    "strings"
+    "time"
+
+    "goboardapi/internal/auth"
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/dto"
+    "goboardapi/internal/middleware"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/wordfilter"
+)
+
+// wordFilterInvalidationChannel 금칙어 목록이 바뀌면 모든 인스턴스에 알리는 채널
+const wordFilterInvalidationChannel = "wordfilter:invalidate"
+
+// 금칙어를 검사하는 입력 항목 (응답의 field)
+const (
+    FilterFieldTitle       = "title"
+    FilterFieldContent     = "content"
+    FilterFieldUsername    = "username"
+    FilterFieldDisplayName = "display_name"
+)
+
+// WordFilterError 금칙어 때문에 저장을 거부함 (handler가 Hits를 ErrorResponse.Details로 응답)
+type WordFilterError struct {
+    Hits []dto.WordFilterHit
+}
+
+func (e *WordFilterError) Error() string {
+    words := make([]string, 0, len(e.Hits))
+    for _, hit := range e.Hits {
+        words = append(words, hit.Field+":"+hit.Word)
+    }
+    return fmt.Sprintf("contains banned words: %s", strings.Join(words, ", "))
+}
+
+// Is errors.Is(err, ErrBannedWord)로 확인할 수 있게 한다
+func (e *WordFilterError) Is(target error) bool {
+    return target == ErrBannedWord
+}
+
+// FilterInput 검사할 항목 하나
+type FilterInput struct {
+    Field string
+    Text  string
+}
+
+// FilterOutcome 저장할 수 있는 경우의 검사 결과
+type FilterOutcome struct {
+    Texts   []string            // 입력 순서대로, mask 처리한 부분을 가린 글
+    Flagged []dto.WordFilterHit // 검토 대상 금칙어 (있으면 저장 후 Flag로 큐에 올림)
+}
+
+// WordFilterService 금칙어 목록 관리와 작성 단계 검사
+//
+// 목록 전체로 만든 검사기를 cache.SnapshotGroup에 두고, 관리자가 목록을 바꾸면 Invalidate로 모든 인스턴스의 캐시를 비운다.
+// 처음 읽기부터 실패하면 검사 없이 통과시킨다 (금칙어 저장소 장애로 글쓰기 전체가 막히지 않도록).
+type WordFilterService struct {
+    wordRepo   repository.BannedWordRepository
+    reportRepo repository.ReportRepository
+    snapshots  *cache.SnapshotGroup
+    filter     *cache.Snapshot[*wordfilter.Filter]
+    now        func() time.Time
+}
+
+func NewWordFilterService(
+    wordRepo repository.BannedWordRepository,
+    reportRepo repository.ReportRepository,
+    invalidator cache.Invalidator,
+    ttl time.Duration,
+) *WordFilterService {
+    s := &WordFilterService{
+        wordRepo:   wordRepo,
+        reportRepo: reportRepo,
+        snapshots:  cache.NewSnapshotGroup(invalidator, wordFilterInvalidationChannel),
+        now:        time.Now,
+    }
+    s.filter = cache.NewGroupSnapshot(s.snapshots, ttl, s.load)
+    return s
+}
+
+// Listen 다른 인스턴스의 변경 알림 구독 (ctx가 끝날 때까지)
+func (s *WordFilterService) Listen(ctx context.Context) {
+    s.snapshots.Listen(ctx)
+}
+
+// Invalidate 현재 인스턴스 캐시를 비우고 다른 인스턴스에 알림
+func (s *WordFilterService) Invalidate(ctx context.Context) {
+    s.snapshots.Invalidate(ctx)
+}
+
+func (s *WordFilterService) load(ctx context.Context) (*wordfilter.Filter, error) {
+    words, err := s.wordRepo.List(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    entries := make([]wordfilter.Entry, 0, len(words))
+    for _, word := range words {
+        entries = append(entries, wordfilter.Entry{Word: word.Word, Action: word.Action})
+    }
+    return wordfilter.New(entries), nil
+}
+
+func (s *WordFilterService) current(ctx context.Context) *wordfilter.Filter {
+    if s == nil {
+        return nil
+    }
+    filter, err := s.filter.Get(ctx)
+    if err != nil {
+        log.Printf("금칙어 목록 조회 실패 (검사 생략): %v", err)
+        return nil
+    }
+    return filter
+}
+
+// Screen 게시글/댓글 저장 전에 호출
+// reject 금칙어가 하나라도 있으면 걸린 부분 전체를 담은 *WordFilterError를 돌려준다.
+func (s *WordFilterService) Screen(ctx context.Context, inputs ...FilterInput) (*FilterOutcome, error) {
+    filter := s.current(ctx)
+    outcome := &FilterOutcome{Texts: make([]string, len(inputs))}
+    var hits []dto.WordFilterHit
+    rejected := false
+
+    for i, input := range inputs {
+        result := filter.Check(input.Text)
+        outcome.Texts[i] = result.Masked
+        for _, hit := range result.Hits {
+            h := toWordFilterHit(input.Field, hit)
+            hits = append(hits, h)
+            if hit.Action == wordfilter.ActionFlag {
+                outcome.Flagged = append(outcome.Flagged, h)
+            }
+        }
+        if result.Action == wordfilter.ActionReject {
+            rejected = true
+        }
+    }
+    if rejected {
+        return nil, &WordFilterError{Hits: hits}
+    }
+    return outcome, nil
+}
+
+// CheckName 사용자 이름 검사. 이름은 가리거나 검토 후 공개할 수 없으므로 처리와 상관없이 거부한다
+func (s *WordFilterService) CheckName(ctx context.Context, field, name string) error {
+    result := s.current(ctx).Check(name)
+    if len(result.Hits) == 0 {
+        return nil
+    }
+
+    hits := make([]dto.WordFilterHit, 0, len(result.Hits))
+    for _, hit := range result.Hits {
+        hits = append(hits, toWordFilterHit(field, hit))
+    }
+    return &WordFilterError{Hits: hits}
+}
+
+// Flag 검토 대상 금칙어가 포함된 채 저장된 콘텐츠를 모더레이션 큐에 올린다
+// 콘텐츠는 공개 상태로 두고, 인정하면 숨긴다. 큐에 올리지 못해도 작성은 되돌리지 않는다.
+func (s *WordFilterService) Flag(ctx context.Context, targetType domain.ReportTargetType, targetID, authorID uint, boardID *uint, hits []dto.WordFilterHit) {
+    if s == nil || len(hits) == 0 {
+        return
+    }
+
+    words := make([]string, 0, len(hits))
+    seen := make(map[string]bool, len(hits))
+    for _, hit := range hits {
+        if !seen[hit.Word] {
+            seen[hit.Word] = true
+            words = append(words, hit.Word)
+        }
+    }
+    filtered := strings.Join(words, ", ")
+    if r := []rune(filtered); len(r) > spamReasonsMax {
+        filtered = string(r[:spamReasonsMax])
+    }
+
+    c := &domain.ModerationCase{
+        TargetType:    targetType,
+        TargetID:      targetID,
+        BoardID:       boardID,
+        AuthorID:      authorID,
+        Status:        domain.ModerationOpen,
+        Source:        domain.ModerationSourceWordFilter,
+        OpenedAt:      s.now(),
+        FilteredWords: filtered,
+    }
+    if err := s.reportRepo.CreateFlag(ctx, c); err != nil {
+        log.Printf("금칙어 검토 등록 실패: %s %d - %v", targetType, targetID, err)
+    }
+}
+
+func toWordFilterHit(field string, hit wordfilter.Hit) dto.WordFilterHit {
+    return dto.WordFilterHit{
+        Field:  field,
+        Word:   hit.Word,
+        Action: hit.Action,
+        Text:   hit.Text,
+        Start:  hit.Start,
+        End:    hit.End,
+    }
+}
+
+// List 금칙어 목록 (관리자)
+func (s *WordFilterService) List(ctx context.Context) ([]*domain.BannedWord, error) {
+    if err := auth.RequireAdmin(ctx); err != nil {
+        return nil, err
+    }
+    return s.wordRepo.List(ctx)
+}
+
+// Create 금칙어 등록 (관리자)
+func (s *WordFilterService) Create(ctx context.Context, req *dto.BannedWordRequest) (*domain.BannedWord, error) {
+    if err := auth.RequireAdmin(ctx); err != nil {
+        return nil, err
+    }
+    claims, ok := middleware.GetUserFromContext(ctx)
+    if !ok {
+        return nil, ErrUnauthorized
+    }
+    if err := validateBannedWord(req); err != nil {
+        return nil, err
+    }
+
+    word := &domain.BannedWord{
+        Word:      strings.TrimSpace(req.Word),
+        Action:    req.Action,
+        CreatedBy: claims.UserID,
+    }
+    if err := s.wordRepo.Create(ctx, word); err != nil {
+        return nil, err
+    }
+    s.Invalidate(ctx)
+    return word, nil
+}
+
+// Update 금칙어 수정 (관리자)
+func (s *WordFilterService) Update(ctx context.Context, id uint, req *dto.BannedWordRequest) (*domain.BannedWord, error) {
+    if err := auth.RequireAdmin(ctx); err != nil {
+        return nil, err
+    }
+    if err := validateBannedWord(req); err != nil {
+        return nil, err
+    }
+
+    word := &domain.BannedWord{ID: id, Word: strings.TrimSpace(req.Word), Action: req.Action}
+    if err := s.wordRepo.Update(ctx, word); err != nil {
+        return nil, err
+    }
+    s.Invalidate(ctx)
+    return word, nil
+}
+
+// Delete 금칙어 삭제 (관리자)
+func (s *WordFilterService) Delete(ctx context.Context, id uint) error {
+    if err := auth.RequireAdmin(ctx); err != nil {
+        return err
+    }
+    if err := s.wordRepo.Delete(ctx, id); err != nil {
+        return err
+    }
+    s.Invalidate(ctx)
+    return nil
+}
+
+func validateBannedWord(req *dto.BannedWordRequest) error {
+    if !req.Action.Valid() || !wordfilter.Valid(req.Word) {
+        return ErrInvalidBannedWord
+    }
+    return nil
+}
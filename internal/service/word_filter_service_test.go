@@ -0,0 +1,93 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "goboardapi/internal/cache"
+    "goboardapi/internal/domain"
+    "goboardapi/internal/repository"
+    "goboardapi/internal/wordfilter"
+)
+
+type fakeBannedWordRepository struct {
+    repository.BannedWordRepository
+    words []*domain.BannedWord
+    err   error
+}
+
+func (r *fakeBannedWordRepository) List(ctx context.Context) ([]*domain.BannedWord, error) {
+    return r.words, r.err
+}
+
+type flagRecordingRepository struct {
+    repository.ReportRepository
+    flagged []*domain.ModerationCase
+}
+
+func (r *flagRecordingRepository) CreateFlag(ctx context.Context, c *domain.ModerationCase) error {
+    r.flagged = append(r.flagged, c)
+    return nil
+}
+
+func TestWordFilterServiceScreen(t *testing.T) {
+    ctx := context.Background()
+    words := &fakeBannedWordRepository{words: []*domain.BannedWord{
+        {Word: "바보", Action: wordfilter.ActionMask},
+        {Word: "카지노", Action: wordfilter.ActionFlag},
+        {Word: "시발", Action: wordfilter.ActionReject},
+    }}
+    reports := &flagRecordingRepository{}
+    svc := NewWordFilterService(words, reports, cache.NewInvalidator(), time.Minute)
+
+    outcome, err := svc.Screen(ctx, FilterInput{Field: FilterFieldContent, Text: "바 보 같은 카.지.노 광고"})
+    if err != nil {
+        t.Fatalf("Screen() error = %v", err)
+    }
+    if outcome.Texts[0] != "* * 같은 카.지.노 광고" {
+        t.Errorf("masked = %q", outcome.Texts[0])
+    }
+    if len(outcome.Flagged) != 1 || outcome.Flagged[0].Word != "카지노" {
+        t.Fatalf("Flagged = %+v, want 카지노", outcome.Flagged)
+    }
+
+    svc.Flag(ctx, domain.ReportTargetComment, 7, 3, nil, outcome.Flagged)
+    if len(reports.flagged) != 1 || reports.flagged[0].Source != domain.ModerationSourceWordFilter || reports.flagged[0].AutoHidden {
+        t.Errorf("flag case = %+v, want visible word_filter case", reports.flagged)
+    }
+
+    // reject는 다른 항목에 걸린 부분까지 모두 돌려준다
+    _, err = svc.Screen(ctx,
+        FilterInput{Field: FilterFieldTitle, Text: "바보"},
+        FilterInput{Field: FilterFieldContent, Text: "십알"})
+    var filtered *WordFilterError
+    if !errors.As(err, &filtered) || !errors.Is(err, ErrBannedWord) {
+        t.Fatalf("Screen() error = %v, want WordFilterError", err)
+    }
+    if len(filtered.Hits) != 2 || filtered.Hits[0].Field != FilterFieldTitle || filtered.Hits[1].Field != FilterFieldContent {
+        t.Errorf("Hits = %+v", filtered.Hits)
+    }
+
+    // 이름은 mask 금칙어도 거부
+    if err := svc.CheckName(ctx, FilterFieldUsername, "Ba_바보"); !errors.Is(err, ErrBannedWord) {
+        t.Errorf("CheckName() error = %v, want ErrBannedWord", err)
+    }
+    if err := svc.CheckName(ctx, FilterFieldUsername, "gopher"); err != nil {
+        t.Errorf("CheckName() error = %v", err)
+    }
+}
+
+func TestWordFilterServiceFailOpen(t *testing.T) {
+    svc := NewWordFilterService(&fakeBannedWordRepository{err: context.DeadlineExceeded}, nil, cache.NewInvalidator(), time.Minute)
+    outcome, err := svc.Screen(context.Background(), FilterInput{Field: FilterFieldContent, Text: "아무 내용"})
+    if err != nil || outcome.Texts[0] != "아무 내용" {
+        t.Errorf("Screen() = %+v, %v, want unchanged text", outcome, err)
+    }
+
+    var nilSvc *WordFilterService
+    if _, err := nilSvc.Screen(context.Background(), FilterInput{Text: "바보"}); err != nil {
+        t.Errorf("nil service Screen() error = %v", err)
+    }
+}
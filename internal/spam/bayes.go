@@ -0,0 +1,88 @@
+package spam
+
+import (
+    "math"
+    "sync"
+)
+
+// Sample 학습 데이터 하나 (모더레이터 판정)
+type Sample struct {
+    Text string
+    Spam bool
+}
+
+// counts 한 번의 학습 결과 (교체만 하고 수정하지 않음)
+type counts struct {
+    spamDocs, hamDocs int
+    spam, ham         map[string]int // 토큰이 나온 문서 수
+}
+
+// Model 나이브 베이즈 분류기 (동시 사용 가능)
+// 토큰이 문서에 있는지만 보고 (베르누이), 학습 때 본 토큰만 점수에 반영한다.
+type Model struct {
+    minSamples int
+
+    mu     sync.RWMutex
+    counts *counts
+}
+
+// NewModel 스팸/정상 문서가 각각 minSamples 이상 학습되어야 점수를 낸다
+func NewModel(minSamples int) *Model {
+    return &Model{minSamples: minSamples, counts: &counts{spam: map[string]int{}, ham: map[string]int{}}}
+}
+
+// Fit 학습 데이터로 모델을 새로 만들어 교체 (이전 학습 결과는 버림)
+func (m *Model) Fit(samples []Sample) {
+    next := &counts{spam: map[string]int{}, ham: map[string]int{}}
+    for _, sample := range samples {
+        target := next.ham
+        if sample.Spam {
+            next.spamDocs++
+            target = next.spam
+        } else {
+            next.hamDocs++
+        }
+        for _, token := range Tokenize(sample.Text) {
+            target[token]++
+        }
+    }
+
+    m.mu.Lock()
+    m.counts = next
+    m.mu.Unlock()
+}
+
+// Trained 점수를 낼 만큼 학습되었는지
+func (m *Model) Trained() bool {
+    m.mu.RLock()
+    defer m.mu.RUnlock()
+    return m.trained(m.counts)
+}
+
+func (m *Model) trained(c *counts) bool {
+    return c.spamDocs >= m.minSamples && c.hamDocs >= m.minSamples
+}
+
+// Score 스팸일 확률 (0~1). 학습이 부족하면 ok=false
+func (m *Model) Score(text string) (p float64, ok bool) {
+    m.mu.RLock()
+    c := m.counts
+    m.mu.RUnlock()
+    if !m.trained(c) {
+        return 0, false
+    }
+
+    // 로그 공간에서 계산하고 라플라스 보정으로 0 확률을 피한다
+    logSpam := math.Log(float64(c.spamDocs) / float64(c.spamDocs+c.hamDocs))
+    logHam := math.Log(float64(c.hamDocs) / float64(c.spamDocs+c.hamDocs))
+    for _, token := range Tokenize(text) {
+        spamCount, inSpam := c.spam[token]
+        hamCount, inHam := c.ham[token]
+        if !inSpam && !inHam {
+            continue
+        }
+        logSpam += math.Log(float64(spamCount+1) / float64(c.spamDocs+2))
+        logHam += math.Log(float64(hamCount+1) / float64(c.hamDocs+2))
+    }
+    return 1 / (1 + math.Exp(logHam-logSpam)), true
+}
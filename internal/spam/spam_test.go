@@ -0,0 +1,59 @@
+package spam
+
+import (
+    "slices"
+    "testing"
+)
+
+func TestTokenize(t *testing.T) {
+    got := Tokenize("무료 쿠폰 받으세요 https://WWW.Spam.example/x?y=1 무료!! a 1")
+    want := []string{"url:spam.example", "무료", "쿠폰", "받으세요"}
+    if !slices.Equal(got, want) {
+        t.Errorf("Tokenize() = %v, want %v", got, want)
+    }
+}
+
+func TestLinkDensity(t *testing.T) {
+    tests := []struct {
+        text string
+        want float64
+    }{
+        {"링크 없음", 0},
+        {"http://a.example http://b.example", 2},
+        {"여기 보세요 http://a.example 감사합니다", 1.0 / 3},
+    }
+    for _, tt := range tests {
+        if got := LinkDensity(tt.text); got != tt.want {
+            t.Errorf("LinkDensity(%q) = %v, want %v", tt.text, got, tt.want)
+        }
+    }
+}
+
+func TestModelScore(t *testing.T) {
+    model := NewModel(2)
+    if _, ok := model.Score("anything"); ok {
+        t.Fatal("untrained model should not score")
+    }
+
+    model.Fit([]Sample{
+        {Text: "무료 쿠폰 지급 http://casino.example", Spam: true},
+        {Text: "카지노 무료 쿠폰 http://casino.example", Spam: true},
+        {Text: "오늘 회의 자료 공유합니다", Spam: false},
+        {Text: "회의 시간 변경 공유드려요", Spam: false},
+    })
+    if !model.Trained() {
+        t.Fatal("model should be trained")
+    }
+
+    spamScore, _ := model.Score("무료 쿠폰 드려요 http://casino.example")
+    hamScore, _ := model.Score("회의 자료 확인 부탁드립니다")
+    if spamScore < 0.9 {
+        t.Errorf("spam score = %v, want >= 0.9", spamScore)
+    }
+    if hamScore > 0.2 {
+        t.Errorf("ham score = %v, want <= 0.2", hamScore)
+    }
+    if unknown, _ := model.Score("전혀 다른 내용"); unknown != 0.5 {
+        t.Errorf("unknown tokens should keep the prior, got %v", unknown)
+    }
+}
@@ -0,0 +1,79 @@
+// Package spam 게시글/댓글 스팸 판정에 쓰는 토큰화와 나이브 베이즈 모델
+//
+// DB나 설정에 의존하지 않는다. 학습 데이터 수집과 휴리스틱 조합은 service.SpamClassifier 구현이 맡는다.
+package spam
+
+import (
+    "net/url"
+    "regexp"
+    "strings"
+    "unicode"
+    "unicode/utf8"
+)
+
+const (
+    minTokenRunes = 2
+    maxTokenRunes = 30
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"']+|\bwww\.[^\s<>"']+`)
+
+// Links 본문의 링크 (http(s)://, www.)
+func Links(text string) []string {
+    return linkPattern.FindAllString(text, -1)
+}
+
+// Tokenize 스팸 판정용 토큰 (중복 제거)
+// 링크는 "url:<호스트>" 하나로 바꾸고, 나머지는 글자/숫자 단위로 잘라 소문자로 만든다.
+func Tokenize(text string) []string {
+    seen := make(map[string]struct{})
+    var tokens []string
+    add := func(token string) {
+        if _, ok := seen[token]; ok {
+            return
+        }
+        seen[token] = struct{}{}
+        tokens = append(tokens, token)
+    }
+
+    for _, link := range Links(text) {
+        add("url:" + linkHost(link))
+    }
+    text = linkPattern.ReplaceAllString(text, " ")
+
+    words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+        return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+    })
+    for _, word := range words {
+        n := utf8.RuneCountInString(word)
+        if n < minTokenRunes || n > maxTokenRunes {
+            continue
+        }
+        add(word)
+    }
+    return tokens
+}
+
+// LinkDensity 단어 수 대비 링크 수 (단어가 없으면 링크 수 그대로)
+func LinkDensity(text string) float64 {
+    links := len(Links(text))
+    if links == 0 {
+        return 0
+    }
+    words := len(strings.Fields(linkPattern.ReplaceAllString(text, " ")))
+    if words == 0 {
+        return float64(links)
+    }
+    return float64(links) / float64(words)
+}
+
+func linkHost(link string) string {
+    if !strings.Contains(link, "://") {
+        link = "http://" + link
+    }
+    u, err := url.Parse(link)
+    if err != nil || u.Host == "" {
+        return "invalid"
+    }
+    return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
@@ -0,0 +1,108 @@
+// Package storage 업로드 파일 저장소
+package storage
+
+import (
+    "context"
+    "errors"
+    "io"
+    "os"
+    "path"
+    "path/filepath"
+    "strings"
+)
+
+var (
+    ErrInvalidKey = errors.New("storage: invalid key")
+    ErrNotFound   = errors.New("storage: not found")
+)
+
+// Storage 파일 저장소 (로컬 디스크, 오브젝트 스토리지 등)
+type Storage interface {
+    // Put key 위치에 저장하고 공개 URL 반환
+    Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error)
+    // Open 저장된 파일 읽기 (없으면 ErrNotFound)
+    Open(ctx context.Context, key string) (io.ReadCloser, error)
+    // Delete 삭제 (없는 키는 무시)
+    Delete(ctx context.Context, key string) error
+}
+
+// LocalStorage 로컬 디스크 저장소 (정적 파일 경로로 서빙)
+type LocalStorage struct {
+    dir     string
+    baseURL string
+}
+
+// NewLocalStorage dir 아래에 저장하고 baseURL/key 로 노출
+func NewLocalStorage(dir, baseURL string) *LocalStorage {
+    return &LocalStorage{
+        dir:     dir,
+        baseURL: strings.TrimRight(baseURL, "/"),
+    }
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error) {
+    target, err := s.path(key)
+    if err != nil {
+        return "", err
+    }
+
+    if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+        return "", err
+    }
+
+    // 임시 파일에 쓴 뒤 rename (읽는 쪽이 쓰다 만 파일을 보지 않도록)
+    tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+    if err != nil {
+        return "", err
+    }
+    defer os.Remove(tmp.Name())
+
+    if _, err := io.Copy(tmp, r); err != nil {
+        tmp.Close()
+        return "", err
+    }
+    if err := tmp.Close(); err != nil {
+        return "", err
+    }
+    if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+        return "", err
+    }
+    if err := os.Rename(tmp.Name(), target); err != nil {
+        return "", err
+    }
+
+    return s.baseURL + "/" + key, nil
+}
+
+func (s *LocalStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+    target, err := s.path(key)
+    if err != nil {
+        return nil, err
+    }
+
+    f, err := os.Open(target)
+    if errors.Is(err, os.ErrNotExist) {
+        return nil, ErrNotFound
+    }
+    return f, err
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+    target, err := s.path(key)
+    if err != nil {
+        return err
+    }
+
+    if err := os.Remove(target); err != nil && !errors.Is(err, os.ErrNotExist) {
+        return err
+    }
+    return nil
+}
+
+// path 키를 디렉터리 밖으로 벗어나지 않는 파일 경로로 변환
+func (s *LocalStorage) path(key string) (string, error) {
+    if key == "" || path.IsAbs(key) || path.Clean(key) != key || strings.HasPrefix(key, "..") {
+        return "", ErrInvalidKey
+    }
+    return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
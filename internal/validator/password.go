@@ -19,8 +19,11 @@ func RegisterPasswordValidators(v *validator.Validate) {
 // - 숫자 1개 이상
 // - 특수문자 1개 이상
 func validatePassword(fl validator.FieldLevel) bool {
-    password := fl.Field().String()
+    return IsValidPassword(fl.Field().String())
+}
 
+// IsValidPassword 비밀번호 정책 검증 (바인딩 태그 없이 서비스에서 사용)
+func IsValidPassword(password string) bool {
     if len(password) < 8 {
         return false
     }
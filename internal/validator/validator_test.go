@@ -28,3 +28,26 @@ func TestValidateEmail(t *testing.T) {
         })
     }
 }
+
+func TestIsValidPassword(t *testing.T) {
+    tests := []struct {
+        name     string
+        password string
+        want     bool
+    }{
+        {"valid password", "Passw0rd!", true},
+        {"too short", "Pa0!", false},
+        {"no upper", "passw0rd!", false},
+        {"no lower", "PASSW0RD!", false},
+        {"no digit", "Password!", false},
+        {"no special", "Passw0rdd", false},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := IsValidPassword(tt.password); got != tt.want {
+                t.Errorf("IsValidPassword(%q) = %v, want %v", tt.password, got, tt.want)
+            }
+        })
+    }
+}
@@ -0,0 +1,82 @@
+package wordfilter
+
+// automaton 여러 패턴을 본문 길이에 비례하는 시간에 한 번에 찾는 Aho-Corasick 오토마톤
+type automaton struct {
+    nodes []acNode
+}
+
+type acNode struct {
+    next map[rune]int32
+    fail int32
+    // out 이 노드에서 끝나는 패턴 (실패 링크를 따라 도달하는 패턴 포함)
+    out []int
+}
+
+// acMatch 패턴 번호와 본문에서 끝난 위치 (마지막 글자의 인덱스)
+type acMatch struct {
+    pattern int
+    end     int
+}
+
+func buildAutomaton(patterns [][]rune) *automaton {
+    a := &automaton{nodes: []acNode{{next: map[rune]int32{}}}}
+
+    for i, pattern := range patterns {
+        var cur int32
+        for _, r := range pattern {
+            next, ok := a.nodes[cur].next[r]
+            if !ok {
+                next = int32(len(a.nodes))
+                a.nodes = append(a.nodes, acNode{next: map[rune]int32{}})
+                a.nodes[cur].next[r] = next
+            }
+            cur = next
+        }
+        a.nodes[cur].out = append(a.nodes[cur].out, i)
+    }
+
+    // 너비 우선으로 실패 링크 연결
+    queue := make([]int32, 0, len(a.nodes))
+    for _, child := range a.nodes[0].next {
+        queue = append(queue, child)
+    }
+    for len(queue) > 0 {
+        cur := queue[0]
+        queue = queue[1:]
+        for r, child := range a.nodes[cur].next {
+            fail := a.nodes[cur].fail
+            for fail != 0 {
+                if _, ok := a.nodes[fail].next[r]; ok {
+                    break
+                }
+                fail = a.nodes[fail].fail
+            }
+            if next, ok := a.nodes[fail].next[r]; ok && next != child {
+                a.nodes[child].fail = next
+            }
+            a.nodes[child].out = append(a.nodes[child].out, a.nodes[a.nodes[child].fail].out...)
+            queue = append(queue, child)
+        }
+    }
+    return a
+}
+
+func (a *automaton) find(text []rune) []acMatch {
+    var matches []acMatch
+    var cur int32
+    for i, r := range text {
+        for cur != 0 {
+            if _, ok := a.nodes[cur].next[r]; ok {
+                break
+            }
+            cur = a.nodes[cur].fail
+        }
+        if next, ok := a.nodes[cur].next[r]; ok {
+            cur = next
+        }
+        for _, pattern := range a.nodes[cur].out {
+            matches = append(matches, acMatch{pattern: pattern, end: i})
+        }
+    }
+    return matches
+}
@@ -0,0 +1,183 @@
+package wordfilter
+
+import (
+    "sort"
+    "strings"
+    "unicode"
+    "unicode/utf8"
+)
+
+// Action 금칙어가 걸렸을 때의 처리
+type Action string
+
+const (
+    ActionMask   Action = "mask"   // 해당 부분을 *로 가리고 저장
+    ActionFlag   Action = "flag"   // 그대로 저장하고 모더레이션 큐에 올림
+    ActionReject Action = "reject" // 저장 거부
+    // ActionAllow 예외 단어. 금칙어가 이 단어 안에서만 걸리면 무시한다
+    // (공백과 소리 없는 ㅇ을 지운 비교라 "다시 발견"의 "시발", "나이"의 "아이"처럼 생기는 오탐을 막는다)
+    ActionAllow Action = "allow"
+)
+
+// Valid 정의된 처리인지 확인
+func (a Action) Valid() bool {
+    switch a {
+    case ActionMask, ActionFlag, ActionReject, ActionAllow:
+        return true
+    }
+    return false
+}
+
+// severity 여러 금칙어가 걸리면 가장 강한 처리를 따른다 (reject > flag > mask)
+func (a Action) severity() int {
+    switch a {
+    case ActionReject:
+        return 3
+    case ActionFlag:
+        return 2
+    case ActionMask:
+        return 1
+    }
+    return 0
+}
+
+// Entry 금칙어 하나
+type Entry struct {
+    Word   string
+    Action Action
+}
+
+// Hit 본문에서 찾은 금칙어 (Start, End는 원문 바이트 위치)
+type Hit struct {
+    Word   string `json:"word"`
+    Action Action `json:"action"`
+    Text   string `json:"text"` // 원문에서 실제로 걸린 부분
+    Start  int    `json:"start"`
+    End    int    `json:"end"`
+}
+
+// Result 검사 결과
+type Result struct {
+    Hits   []Hit
+    Action Action // 걸린 것 중 가장 강한 처리 (없으면 "")
+    Masked string // mask 처리할 부분을 가린 본문
+}
+
+// Filter 금칙어 목록으로 만든 검사기 (만든 뒤에는 읽기만 하므로 동시에 써도 안전)
+type Filter struct {
+    entries   []Entry
+    lengths   []int // 정규화한 금칙어 길이 (글자 수)
+    automaton *automaton
+}
+
+// New 금칙어 목록으로 검사기를 만든다
+// 정규화 후 빈 금칙어는 무시한다 (기호만 있는 경우 등).
+func New(entries []Entry) *Filter {
+    f := &Filter{}
+    patterns := make([][]rune, 0, len(entries))
+    for _, entry := range entries {
+        pattern := normalize(entry.Word).runes
+        if len(pattern) == 0 || !entry.Action.Valid() {
+            continue
+        }
+        f.entries = append(f.entries, entry)
+        f.lengths = append(f.lengths, len(pattern))
+        patterns = append(patterns, pattern)
+    }
+    f.automaton = buildAutomaton(patterns)
+    return f
+}
+
+// Len 검사에 쓰는 금칙어 수
+func (f *Filter) Len() int {
+    if f == nil {
+        return 0
+    }
+    return len(f.entries)
+}
+
+// Check 본문을 검사한다. 같은 금칙어가 여러 번 나오면 모두 돌려준다
+// 예외 단어와 같은 정규화 글자열 위에서 비교하므로, 예외 단어 범위 안에 들어가는 금칙어는 걸리지 않는다.
+func (f *Filter) Check(text string) *Result {
+    result := &Result{Masked: text}
+    if f.Len() == 0 || text == "" {
+        return result
+    }
+
+    n := normalize(text)
+    matches := f.automaton.find(n.runes)
+    var allowed [][2]int // 예외 단어가 걸린 정규화 글자 범위 (처음, 끝)
+    for _, m := range matches {
+        if f.entries[m.pattern].Action == ActionAllow {
+            allowed = append(allowed, [2]int{m.end - f.lengths[m.pattern] + 1, m.end})
+        }
+    }
+
+    for _, m := range matches {
+        entry := f.entries[m.pattern]
+        first := m.end - f.lengths[m.pattern] + 1
+        if entry.Action == ActionAllow || within(allowed, first, m.end) {
+            continue
+        }
+        start, end := n.start[first], n.end[m.end]
+        result.Hits = append(result.Hits, Hit{
+            Word:   entry.Word,
+            Action: entry.Action,
+            Text:   text[start:end],
+            Start:  start,
+            End:    end,
+        })
+        if entry.Action.severity() > result.Action.severity() {
+            result.Action = entry.Action
+        }
+    }
+    sort.SliceStable(result.Hits, func(i, j int) bool {
+        return result.Hits[i].Start < result.Hits[j].Start
+    })
+    result.Masked = mask(text, result.Hits)
+    return result
+}
+
+// within 정규화 글자 범위 [first, last]가 예외 단어 범위 중 하나에 들어가는지
+func within(spans [][2]int, first, last int) bool {
+    for _, span := range spans {
+        if span[0] <= first && last <= span[1] {
+            return true
+        }
+    }
+    return false
+}
+
+// mask mask 처리 금칙어가 걸린 부분의 글자를 *로 바꾼다 (공백은 그대로 둠)
+func mask(text string, hits []Hit) string {
+    masked := make([]bool, len(text))
+    found := false
+    for _, hit := range hits {
+        if hit.Action != ActionMask {
+            continue
+        }
+        for i := hit.Start; i < hit.End; i++ {
+            masked[i] = true
+        }
+        found = true
+    }
+    if !found {
+        return text
+    }
+
+    var b strings.Builder
+    b.Grow(len(text))
+    for i, r := range text {
+        if masked[i] && !unicode.IsSpace(r) {
+            b.WriteByte('*')
+            continue
+        }
+        b.WriteRune(r)
+    }
+    return b.String()
+}
+
+// Valid 등록할 수 있는 금칙어인지 확인 (정규화 후 글자가 남아야 함)
+func Valid(word string) bool {
+    return strings.TrimSpace(word) != "" && utf8.RuneCountInString(word) <= 100 && len(normalize(word).runes) > 0
+}
@@ -0,0 +1,125 @@
+package wordfilter
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+    tests := []struct {
+        text string
+        want string
+    }{
+        {"시발", "ㅅㅣㅂㅏㄹ"},
+        {"시 . 발", "ㅅㅣㅂㅏㄹ"},
+        {"십알", "ㅅㅣㅂㅏㄹ"},
+        {"시\u200b발", "ㅅㅣㅂㅏㄹ"},
+        {"\u1109\u1175\u1107\u1161\u11af", "ㅅㅣㅂㅏㄹ"}, // 조합형 자모
+        {"닭", "ㄷㅏㄹㄱ"},
+        {"ＳＰＡＭ-Bot", "spambot"},
+    }
+    for _, tt := range tests {
+        if got := Normalize(tt.text); got != tt.want {
+            t.Errorf("Normalize(%q) = %q, want %q", tt.text, got, tt.want)
+        }
+    }
+}
+
+func TestFilterCheck(t *testing.T) {
+    f := New([]Entry{
+        {Word: "바보", Action: ActionMask},
+        {Word: "casino", Action: ActionFlag},
+        {Word: "시발", Action: ActionReject},
+        {Word: "!!!", Action: ActionReject}, // 정규화하면 비므로 무시
+    })
+    if f.Len() != 3 {
+        t.Fatalf("Len() = %d, want 3", f.Len())
+    }
+
+    tests := []struct {
+        name   string
+        text   string
+        action Action
+        hits   []string
+        masked string
+    }{
+        {"걸리지 않음", "오늘 날씨 좋네요", "", nil, "오늘 날씨 좋네요"},
+        {"띄어쓰기 우회", "이 바 보 야", ActionMask, []string{"바 보"}, "이 * * 야"},
+        {"받침 옮겨 쓰기", "밥오", ActionMask, []string{"밥오"}, "**"},
+        {"여러 번", "바보 바보", ActionMask, []string{"바보", "바보"}, "** **"},
+        {"대소문자와 기호", "C.A.S.I.N.O 가입", ActionFlag, []string{"C.A.S.I.N.O"}, "C.A.S.I.N.O 가입"},
+        {"가장 강한 처리", "바보 시1발", ActionMask, []string{"바보"}, "** 시1발"},
+        {"거부", "바보 시_발", ActionReject, []string{"바보", "시_발"}, "** 시_발"},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            result := f.Check(tt.text)
+            if result.Action != tt.action {
+                t.Errorf("Action = %q, want %q", result.Action, tt.action)
+            }
+            if len(result.Hits) != len(tt.hits) {
+                t.Fatalf("Hits = %+v, want %v", result.Hits, tt.hits)
+            }
+            for i, hit := range result.Hits {
+                if hit.Text != tt.hits[i] {
+                    t.Errorf("Hits[%d].Text = %q, want %q", i, hit.Text, tt.hits[i])
+                }
+            }
+            if result.Masked != tt.masked {
+                t.Errorf("Masked = %q, want %q", result.Masked, tt.masked)
+            }
+        })
+    }
+}
+
+func TestFilterOverlappingWords(t *testing.T) {
+    // 실패 링크로 겹치는 금칙어도 모두 찾는다
+    f := New([]Entry{{Word: "he", Action: ActionFlag}, {Word: "she", Action: ActionFlag}, {Word: "hers", Action: ActionFlag}})
+    result := f.Check("ushers")
+    if len(result.Hits) != 3 {
+        t.Errorf("Hits = %+v, want she, he, hers", result.Hits)
+    }
+}
+
+func TestFilterAllowedWords(t *testing.T) {
+    f := New([]Entry{
+        {Word: "시발", Action: ActionReject},
+        {Word: "아이", Action: ActionMask},
+        {Word: "다시 발견", Action: ActionAllow},
+        {Word: "나이", Action: ActionAllow},
+    })
+
+    tests := []struct {
+        name   string
+        text   string
+        action Action
+        hits   []string
+    }{
+        // 공백을 지우고 비교해도 예외 단어 안이면 걸리지 않는다
+        {"예외 단어 안의 금칙어", "오늘 다시 발견했다", "", nil},
+        {"소리 없는 ㅇ", "나이가 어떻게 되세요", "", nil},
+        {"예외 단어 밖의 금칙어", "다시 발견 시발", ActionReject, []string{"시발"}},
+        {"예외 단어 뒤에 이어짐", "나이아이", ActionMask, []string{"아이"}},
+        {"예외 없는 문장", "아이 시 발", ActionReject, []string{"아이", "시 발"}},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            result := f.Check(tt.text)
+            if result.Action != tt.action {
+                t.Errorf("Action = %q, want %q", result.Action, tt.action)
+            }
+            if len(result.Hits) != len(tt.hits) {
+                t.Fatalf("Hits = %+v, want %v", result.Hits, tt.hits)
+            }
+            for i, hit := range result.Hits {
+                if hit.Text != tt.hits[i] {
+                    t.Errorf("Hits[%d].Text = %q, want %q", i, hit.Text, tt.hits[i])
+                }
+            }
+        })
+    }
+}
+
+func TestNilFilter(t *testing.T) {
+    var f *Filter
+    if result := f.Check("바보"); result.Action != "" || result.Masked != "바보" {
+        t.Errorf("nil filter Check() = %+v", result)
+    }
+}
@@ -0,0 +1,98 @@
+package wordfilter
+
+import (
+    "unicode"
+    "unicode/utf8"
+)
+
+// 한글 음절 (가~힣) = 0xAC00 + (초성*21 + 중성)*28 + 종성
+const (
+    hangulBase     = 0xAC00
+    hangulLast     = 0xD7A3
+    jungCount      = 21
+    jongCount      = 28
+    choSilentIeung = 11 // 초성 ㅇ
+)
+
+var (
+    choseong  = []rune("ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ")
+    jungseong = []rune("ㅏㅐㅑㅒㅓㅔㅕㅖㅗㅘㅙㅚㅛㅜㅝㅞㅟㅠㅡㅢㅣ")
+    // jongseong[0]은 받침 없음
+    jongseong = []rune(" ㄱㄲㄳㄴㄵㄶㄷㄹㄺㄻㄼㄽㄾㄿㅀㅁㅂㅄㅅㅆㅇㅈㅊㅋㅌㅍㅎ")
+
+    // compoundJamo 겹받침과 이중모음은 구성 자모로 나눈다 ("닭" = "달ㄱ", "괜" = "고ㅐㄴ")
+    compoundJamo = map[rune][]rune{
+        'ㄳ': []rune("ㄱㅅ"), 'ㄵ': []rune("ㄴㅈ"), 'ㄶ': []rune("ㄴㅎ"), 'ㄺ': []rune("ㄹㄱ"),
+        'ㄻ': []rune("ㄹㅁ"), 'ㄼ': []rune("ㄹㅂ"), 'ㄽ': []rune("ㄹㅅ"), 'ㄾ': []rune("ㄹㅌ"),
+        'ㄿ': []rune("ㄹㅍ"), 'ㅀ': []rune("ㄹㅎ"), 'ㅄ': []rune("ㅂㅅ"),
+        'ㅘ': []rune("ㅗㅏ"), 'ㅙ': []rune("ㅗㅐ"), 'ㅚ': []rune("ㅗㅣ"), 'ㅝ': []rune("ㅜㅓ"),
+        'ㅞ': []rune("ㅜㅔ"), 'ㅟ': []rune("ㅜㅣ"), 'ㅢ': []rune("ㅡㅣ"),
+    }
+)
+
+// normalized 정규화한 글자와 각 글자가 나온 원문 위치 (바이트)
+type normalized struct {
+    runes []rune
+    start []int
+    end   []int
+}
+
+func (n *normalized) add(r rune, start, end int) {
+    if parts, ok := compoundJamo[r]; ok {
+        for _, part := range parts {
+            n.add(part, start, end)
+        }
+        return
+    }
+    n.runes = append(n.runes, r)
+    n.start = append(n.start, start)
+    n.end = append(n.end, end)
+}
+
+// normalize 우회 표기를 같은 글자열로 만든다
+//   - 대소문자, 전각 영숫자 통일
+//   - 공백, 문장부호, 기호, 보이지 않는 문자 제거 ("시 발", "시.발", "시​발")
+//   - 한글 음절과 조합형 자모를 호환 자모로 분해 ("시발" = "ㅅㅣㅂㅏㄹ")
+//   - 소리 나지 않는 초성 ㅇ 제거 (받침을 옮겨 쓰는 "십알"도 "시발"과 같아짐)
+func normalize(text string) *normalized {
+    n := &normalized{}
+    for i, r := range text {
+        end := i + utf8.RuneLen(r)
+        if r == utf8.RuneError {
+            continue
+        }
+        if r >= 0xFF01 && r <= 0xFF5E {
+            r -= 0xFEE0 // 전각 → 반각
+        }
+        if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.In(r, unicode.Cc, unicode.Cf) {
+            continue
+        }
+
+        switch {
+        case r >= hangulBase && r <= hangulLast:
+            offset := int(r - hangulBase)
+            cho, jung, jong := offset/(jungCount*jongCount), offset/jongCount%jungCount, offset%jongCount
+            if cho != choSilentIeung {
+                n.add(choseong[cho], i, end)
+            }
+            n.add(jungseong[jung], i, end)
+            if jong > 0 {
+                n.add(jongseong[jong], i, end)
+            }
+        case r >= 0x1100 && r < 0x1100+rune(len(choseong)):
+            n.add(choseong[r-0x1100], i, end)
+        case r >= 0x1161 && r < 0x1161+rune(len(jungseong)):
+            n.add(jungseong[r-0x1161], i, end)
+        case r >= 0x11A8 && r < 0x11A8+rune(len(jongseong)-1):
+            n.add(jongseong[r-0x11A8+1], i, end)
+        default:
+            n.add(unicode.ToLower(r), i, end)
+        }
+    }
+    return n
+}
+
+// Normalize 금칙어 비교에 쓰는 형태 (관리 화면에서 등록 결과를 확인하는 용도)
+func Normalize(text string) string {
+    return string(normalize(text).runes)
+}
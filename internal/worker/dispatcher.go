@@ -0,0 +1,48 @@
+package worker
+
+import (
+    "context"
+    "errors"
+    "log"
+)
+
+// Dispatcher 큐에서 꺼낸 태스크를 종류별 핸들러로 실행 (실패하면 RetryableHandler가 다시 넣는다)
+type Dispatcher struct {
+    queue    Queue
+    handlers map[TaskType]*RetryableHandler
+}
+
+func NewDispatcher(queue Queue) *Dispatcher {
+    return &Dispatcher{
+        queue:    queue,
+        handlers: make(map[TaskType]*RetryableHandler),
+    }
+}
+
+// Register 태스크 종류에 핸들러 등록 (Run 전에 호출)
+func (d *Dispatcher) Register(taskType TaskType, handler TaskHandler) {
+    d.handlers[taskType] = NewRetryableHandler(handler, d.queue)
+}
+
+// Run ctx가 끝나거나 큐가 닫힐 때까지 태스크 처리
+func (d *Dispatcher) Run(ctx context.Context) {
+    for {
+        task, err := d.queue.Dequeue(ctx)
+        if err != nil {
+            if errors.Is(err, ErrQueueClosed) || ctx.Err() != nil {
+                return
+            }
+            log.Printf("태스크 조회 실패: %v", err)
+            continue
+        }
+
+        handler, ok := d.handlers[task.Type]
+        if !ok {
+            log.Printf("등록되지 않은 태스크 %s (%s) 건너뜀", task.ID, task.Type)
+            continue
+        }
+        if err := handler.Handle(ctx, task); err != nil {
+            log.Printf("태스크 %s (%s) 실패: %v", task.ID, task.Type, err)
+        }
+    }
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+    "context"
+    "encoding/json"
+)
+
+type DataExportPayload struct {
+    ExportID uint `json:"export_id"`
+}
+
+// DataExportBuilder 내보내기 ZIP 생성 (service.DataExportService가 구현)
+type DataExportBuilder interface {
+    Build(ctx context.Context, exportID uint) error
+}
+
+type DataExportHandler struct {
+    exports DataExportBuilder
+}
+
+func NewDataExportHandler(exports DataExportBuilder) *DataExportHandler {
+    return &DataExportHandler{exports: exports}
+}
+
+func (h *DataExportHandler) Handle(ctx context.Context, payload json.RawMessage) error {
+    var data DataExportPayload
+    if err := json.Unmarshal(payload, &data); err != nil {
+        return err
+    }
+
+    return h.exports.Build(ctx, data.ExportID)
+}
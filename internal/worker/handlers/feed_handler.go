@@ -0,0 +1,32 @@
+package handlers
+
+import (
+    "context"
+    "encoding/json"
+)
+
+type FanOutPayload struct {
+    PostID uint `json:"post_id"`
+}
+
+// PostFanOuter 게시글을 팔로워 피드에 반영 (service.FeedService가 구현)
+type PostFanOuter interface {
+    FanOut(ctx context.Context, postID uint) error
+}
+
+type FeedHandler struct {
+    feed PostFanOuter
+}
+
+func NewFeedHandler(feed PostFanOuter) *FeedHandler {
+    return &FeedHandler{feed: feed}
+}
+
+func (h *FeedHandler) Handle(ctx context.Context, payload json.RawMessage) error {
+    var data FanOutPayload
+    if err := json.Unmarshal(payload, &data); err != nil {
+        return err
+    }
+
+    return h.feed.FanOut(ctx, data.PostID)
+}
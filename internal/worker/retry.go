@@ -9,6 +9,9 @@ import (
 
 const MaxRetries = 3
 
+// TaskHandler 태스크 종류별 처리 함수 (payload는 Task.Payload 그대로)
+type TaskHandler func(ctx context.Context, payload json.RawMessage) error
+
 type RetryableHandler struct {
     handler    TaskHandler
     queue      Queue
@@ -12,6 +12,8 @@ const (
     TaskSendEmail     TaskType = "send_email"
     TaskSendPush      TaskType = "send_push"
     TaskGenerateThumb TaskType = "generate_thumbnail"
+    TaskFanOutPost    TaskType = "fan_out_post"
+    TaskExportData    TaskType = "export_user_data"
 )
 
 type Task struct {
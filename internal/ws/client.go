@@ -1,7 +1,9 @@
 package ws
 
 import (
+    "context"
     "encoding/json"
+    "errors"
     "log"
     "time"
 
@@ -13,15 +15,16 @@ const (
     writeWait      = 10 * time.Second
     pongWait       = 60 * time.Second
     pingPeriod     = (pongWait * 9) / 10
-    maxMessageSize = 512
+    maxMessageSize = 8192
 )
 
-func ServeWS(hub *Hub, conn *websocket.Conn, userID uint) {
+func ServeWS(hub *Hub, conn *websocket.Conn, userID, sessionID uint) {
     client := &Client{
-        ID:     uuid.New().String(),
-        UserID: userID,
-        Conn:   conn,
-        Send:   make(chan []byte, 256),
+        ID:        uuid.New().String(),
+        UserID:    userID,
+        SessionID: sessionID,
+        Conn:      conn,
+        Send:      make(chan []byte, 256),
     }
 
     hub.register <- client
@@ -86,15 +89,74 @@ func (c *Client) writePump() {
     }
 }
 
+// inboundMessage 클라이언트가 보내는 메시지
+type inboundMessage struct {
+    Type string          `json:"type"`
+    Ref  string          `json:"ref,omitempty"` // 응답을 요청과 짝짓기 위해 클라이언트가 붙이는 값
+    Data json.RawMessage `json:"data,omitempty"`
+}
+
+// reply 요청한 클라이언트에게 보내는 응답
+type reply struct {
+    Type  string        `json:"type"`
+    Ref   string        `json:"ref,omitempty"`
+    Data  interface{}   `json:"data,omitempty"`
+    Error *HandlerError `json:"error,omitempty"`
+}
+
+const handlerTimeout = 10 * time.Second
+
 func (c *Client) handleMessage(hub *Hub, message []byte) {
     // 메시지 타입에 따라 처리
-    var msg map[string]interface{}
+    var msg inboundMessage
     if err := json.Unmarshal(message, &msg); err != nil {
         return
     }
 
-    // 예: 채팅 메시지
-    if msg["type"] == "chat" {
+    ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
+    defer cancel()
+
+    // 예: 채팅 메시지 (처리기를 거치지 않으므로 제재 확인을 여기서 한다)
+    if msg.Type == "chat" {
+        if err := hub.checkWrite(ctx, c.UserID); err != nil {
+            c.replyError(hub, msg, err)
+            return
+        }
         hub.broadcast <- message
+        return
+    }
+
+    handler, ok := hub.handler(msg.Type)
+    if !ok {
+        c.reply(hub, reply{Type: "error", Ref: msg.Ref, Error: &HandlerError{Code: "UNKNOWN_TYPE", Message: "지원하지 않는 메시지입니다"}})
+        return
     }
+
+    result, err := handler(ctx, c, msg.Data)
+    if err != nil {
+        c.replyError(hub, msg, err)
+        return
+    }
+
+    c.reply(hub, reply{Type: msg.Type + ".ok", Ref: msg.Ref, Data: result})
+}
+
+// replyError 처리 실패 응답 (*HandlerError가 아니면 서버 오류로 감춘다)
+func (c *Client) replyError(hub *Hub, msg inboundMessage, err error) {
+    var handlerErr *HandlerError
+    if !errors.As(err, &handlerErr) {
+        log.Printf("메시지 처리 실패: type=%s user=%d - %v", msg.Type, c.UserID, err)
+        handlerErr = &HandlerError{Code: "INTERNAL", Message: "서버 오류"}
+    }
+    c.reply(hub, reply{Type: "error", Ref: msg.Ref, Error: handlerErr})
+}
+
+// reply 이 클라이언트에게만 응답 (버퍼가 가득 차면 버림)
+func (c *Client) reply(hub *Hub, r reply) {
+    data, err := json.Marshal(r)
+    if err != nil {
+        return
+    }
+
+    hub.sendToClient(c, data)
 }
@@ -1,23 +1,52 @@
 package ws
 
 import (
+    "context"
+    "encoding/json"
     "sync"
 )
 
 type Client struct {
-    ID     string
-    UserID uint
-    Conn   *websocket.Conn
-    Send   chan []byte
+    ID        string
+    UserID    uint
+    SessionID uint // 연결에 사용한 로그인 세션 (세션 폐기 시 연결 종료)
+    Conn      *websocket.Conn
+    Send      chan []byte
+}
+
+// DeliveryFilter actor가 일으킨 메시지를 수신자에게 보내도 되는지 판단 (차단/뮤트)
+type DeliveryFilter interface {
+    CanDeliver(recipientID, actorID uint) bool
+}
+
+// WriteGuard 사용자가 지금 메시지를 보낼 수 있는지 확인 (이용 제재)
+// *HandlerError를 돌려주면 클라이언트에게 그대로 전달된다.
+type WriteGuard func(ctx context.Context, userID uint) error
+
+// MessageHandler 클라이언트가 보낸 type별 메시지 처리
+// 반환값은 요청한 클라이언트에게 "<type>.ok" 응답의 data로 전달된다.
+type MessageHandler func(ctx context.Context, client *Client, data json.RawMessage) (interface{}, error)
+
+// HandlerError 클라이언트에게 그대로 전달할 처리 실패 사유
+type HandlerError struct {
+    Code    string `json:"code"`
+    Message string `json:"message"`
+}
+
+func (e *HandlerError) Error() string {
+    return e.Code + ": " + e.Message
 }
 
 type Hub struct {
-    clients    map[string]*Client
+    clients     map[string]*Client
     userClients map[uint][]*Client // 사용자별 클라이언트
-    register   chan *Client
-    unregister chan *Client
-    broadcast  chan []byte
-    mu         sync.RWMutex
+    register    chan *Client
+    unregister  chan *Client
+    broadcast   chan []byte
+    filter      DeliveryFilter
+    writeGuard  WriteGuard
+    handlers    map[string]MessageHandler
+    mu          sync.RWMutex
 }
 
 func NewHub() *Hub {
@@ -27,6 +56,7 @@ func NewHub() *Hub {
         register:    make(chan *Client),
         unregister:  make(chan *Client),
         broadcast:   make(chan []byte),
+        handlers:    make(map[string]MessageHandler),
     }
 }
 
@@ -57,6 +87,11 @@ func (h *Hub) removeClient(client *Client) {
     h.mu.Lock()
     defer h.mu.Unlock()
 
+    h.removeClientLocked(client)
+}
+
+// removeClientLocked 호출 전에 h.mu를 잡고 있어야 한다
+func (h *Hub) removeClientLocked(client *Client) {
     if _, ok := h.clients[client.ID]; ok {
         delete(h.clients, client.ID)
         close(client.Send)
@@ -85,8 +120,41 @@ func (h *Hub) broadcastMessage(message []byte) {
     }
 }
 
+// SetDeliveryFilter SendToUser에 적용할 필터 설정 (Run 전에 호출)
+func (h *Hub) SetDeliveryFilter(filter DeliveryFilter) {
+    h.filter = filter
+}
+
+// SetWriteGuard 전체 채팅(chat) 전에 적용할 확인 설정 (Run 전에 호출)
+// type별 처리기는 서비스에서 직접 확인하므로 적용하지 않는다.
+func (h *Hub) SetWriteGuard(guard WriteGuard) {
+    h.writeGuard = guard
+}
+
+func (h *Hub) checkWrite(ctx context.Context, userID uint) error {
+    if h.writeGuard == nil {
+        return nil
+    }
+    return h.writeGuard(ctx, userID)
+}
+
+// HandleFunc 클라이언트 메시지 type별 처리기 등록 (Run 전에 호출)
+func (h *Hub) HandleFunc(msgType string, handler MessageHandler) {
+    h.handlers[msgType] = handler
+}
+
+func (h *Hub) handler(msgType string) (MessageHandler, bool) {
+    handler, ok := h.handlers[msgType]
+    return handler, ok
+}
+
 // 특정 사용자에게 메시지 전송
-func (h *Hub) SendToUser(userID uint, message []byte) {
+// actorID는 메시지를 일으킨 사용자 (시스템 메시지는 0). 수신자와 차단/뮤트 관계면 보내지 않는다.
+func (h *Hub) SendToUser(userID, actorID uint, message []byte) {
+    if h.filter != nil && actorID != 0 && !h.filter.CanDeliver(userID, actorID) {
+        return
+    }
+
     h.mu.RLock()
     defer h.mu.RUnlock()
 
@@ -98,3 +166,58 @@ func (h *Hub) SendToUser(userID uint, message []byte) {
         }
     }
 }
+
+// sendToClient 클라이언트 하나에게 전송
+// 처리 도중 CloseSessions 등으로 연결이 정리되어 Send가 닫혔을 수 있으므로 등록 여부를 잠금 안에서 확인한다.
+func (h *Hub) sendToClient(client *Client, message []byte) {
+    h.mu.RLock()
+    defer h.mu.RUnlock()
+
+    if h.clients[client.ID] != client {
+        return
+    }
+    select {
+    case client.Send <- message:
+    default:
+    }
+}
+
+// CloseSessions 폐기된 세션으로 연결된 클라이언트 종료
+// Send 채널을 닫으면 writePump가 Close 메시지를 보내고 연결을 끊는다.
+func (h *Hub) CloseSessions(sessionIDs ...uint) {
+    if len(sessionIDs) == 0 {
+        return
+    }
+
+    revoked := make(map[uint]bool, len(sessionIDs))
+    for _, id := range sessionIDs {
+        revoked[id] = true
+    }
+
+    h.mu.Lock()
+    defer h.mu.Unlock()
+
+    for _, client := range h.clients {
+        if client.SessionID != 0 && revoked[client.SessionID] {
+            h.removeClientLocked(client)
+        }
+    }
+}
+
+// CloseUsers 이용 제재 등으로 사용자의 모든 연결 종료 (세션 없는 토큰으로 연결한 클라이언트 포함)
+func (h *Hub) CloseUsers(userIDs ...uint) {
+    if len(userIDs) == 0 {
+        return
+    }
+
+    h.mu.Lock()
+    defer h.mu.Unlock()
+
+    for _, userID := range userIDs {
+        // removeClientLocked가 userClients를 고치므로 복사해서 순회
+        clients := append([]*Client(nil), h.userClients[userID]...)
+        for _, client := range clients {
+            h.removeClientLocked(client)
+        }
+    }
+}
@@ -0,0 +1,29 @@
+package ws
+
+import "testing"
+
+type fakeDeliveryFilter map[[2]uint]bool
+
+func (f fakeDeliveryFilter) CanDeliver(recipientID, actorID uint) bool {
+    return !f[[2]uint{recipientID, actorID}]
+}
+
+func TestSendToUserDeliveryFilter(t *testing.T) {
+    hub := NewHub()
+    // 1이 2를 차단
+    hub.SetDeliveryFilter(fakeDeliveryFilter{{1, 2}: true})
+    client := &Client{ID: "c1", UserID: 1, Send: make(chan []byte, 4)}
+    hub.addClient(client)
+
+    hub.SendToUser(1, 2, []byte("blocked"))
+    hub.SendToUser(1, 3, []byte("friend"))
+    hub.SendToUser(1, 0, []byte("system"))
+
+    var got []string
+    for len(client.Send) > 0 {
+        got = append(got, string(<-client.Send))
+    }
+    if len(got) != 2 || got[0] != "friend" || got[1] != "system" {
+        t.Errorf("delivered = %v, want [friend system]", got)
+    }
+}